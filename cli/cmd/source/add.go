@@ -40,7 +40,7 @@ func AddCmd(ver version.Version) *cobra.Command {
 				dataPath = relPath
 			}
 
-			app, err := local.NewApp(cmd.Context(), ver, verbose, olapDriver, olapDSN, projectPath)
+			app, err := local.NewApp(cmd.Context(), ver, verbose, olapDriver, olapDSN, projectPath, nil)
 			if err != nil {
 				return err
 			}
@@ -70,10 +70,11 @@ func AddCmd(ver version.Version) *cobra.Command {
 				Properties: propsPB,
 			}
 
-			repo, err := app.Runtime.Repo(cmd.Context(), app.Instance.ID)
+			repo, release, err := app.Runtime.Repo(cmd.Context(), app.Instance.ID)
 			if err != nil {
 				panic(err) // Should never happen
 			}
+			defer release()
 
 			c := rillv1beta.New(repo, app.Instance.ID)
 			sourcePath, err := c.PutSource(cmd.Context(), repo, app.Instance.ID, src, force)
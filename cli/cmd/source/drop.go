@@ -28,7 +28,7 @@ func DropCmd(ver version.Version) *cobra.Command {
 				return fmt.Errorf("not a valid source name: %s", sourceName)
 			}
 
-			app, err := local.NewApp(cmd.Context(), ver, verbose, olapDriver, olapDSN, projectPath)
+			app, err := local.NewApp(cmd.Context(), ver, verbose, olapDriver, olapDSN, projectPath, nil)
 			if err != nil {
 				return err
 			}
@@ -38,10 +38,11 @@ func DropCmd(ver version.Version) *cobra.Command {
 				return fmt.Errorf("not a valid Rill project")
 			}
 
-			repo, err := app.Runtime.Repo(cmd.Context(), app.Instance.ID)
+			repo, release, err := app.Runtime.Repo(cmd.Context(), app.Instance.ID)
 			if err != nil {
 				panic(err) // Should never happen
 			}
+			defer release()
 
 			c := rillv1beta.New(repo, app.Instance.ID)
 			sourcePath, err := c.DeleteSource(cmd.Context(), sourceName)
@@ -2,6 +2,7 @@ package start
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/rilldata/rill/cli/pkg/local"
 	"github.com/rilldata/rill/cli/pkg/version"
@@ -18,12 +19,19 @@ func StartCmd(ver version.Version) *cobra.Command {
 	var verbose bool
 	var noUI bool
 	var noOpen bool
+	var noWatch bool
+	var vars []string
 
 	startCmd := &cobra.Command{
 		Use:   "start",
 		Short: "Build project and start web app",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			app, err := local.NewApp(cmd.Context(), ver, verbose, olapDriver, olapDSN, projectPath)
+			variables, err := parseVariables(vars)
+			if err != nil {
+				return err
+			}
+
+			app, err := local.NewApp(cmd.Context(), ver, verbose, olapDriver, olapDSN, projectPath, variables)
 			if err != nil {
 				return err
 			}
@@ -42,7 +50,7 @@ func StartCmd(ver version.Version) *cobra.Command {
 				return fmt.Errorf("reconcile project: %w", err)
 			}
 
-			err = app.Serve(httpPort, grpcPort, !noUI, !noOpen)
+			err = app.Serve(httpPort, grpcPort, !noUI, !noOpen, !noWatch)
 			if err != nil {
 				return fmt.Errorf("serve: %w", err)
 			}
@@ -59,7 +67,22 @@ func StartCmd(ver version.Version) *cobra.Command {
 	startCmd.Flags().IntVar(&httpPort, "port", 9009, "Port for HTTP")
 	startCmd.Flags().IntVar(&grpcPort, "port-grpc", 9010, "Port for gRPC")
 	startCmd.Flags().BoolVar(&noUI, "no-ui", false, "Serve only the backend")
+	startCmd.Flags().BoolVar(&noWatch, "no-watch", false, "Do not reconcile the project when files change")
 	startCmd.Flags().BoolVar(&verbose, "verbose", false, "Sets the log level to debug")
+	startCmd.Flags().StringArrayVar(&vars, "var", nil, "Set a project variable (format: key=value), overriding its default in rill.yaml")
 
 	return startCmd
 }
+
+// parseVariables parses "key=value" pairs into a map
+func parseVariables(vars []string) (map[string]string, error) {
+	res := make(map[string]string, len(vars))
+	for _, v := range vars {
+		key, val, ok := strings.Cut(v, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid variable %q (expected format: key=value)", v)
+		}
+		res[key] = val
+	}
+	return res, nil
+}
@@ -16,11 +16,13 @@ import (
 	"github.com/rilldata/rill/cli/pkg/examples"
 	"github.com/rilldata/rill/cli/pkg/version"
 	"github.com/rilldata/rill/cli/pkg/web"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/compilers/rillv1beta"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/graceful"
 	runtimeserver "github.com/rilldata/rill/runtime/server"
+	"github.com/rilldata/rill/runtime/services/catalog"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"golang.org/x/sync/errgroup"
@@ -32,6 +34,7 @@ import (
 	_ "github.com/rilldata/rill/runtime/drivers/druid"
 	_ "github.com/rilldata/rill/runtime/drivers/duckdb"
 	_ "github.com/rilldata/rill/runtime/drivers/file"
+	_ "github.com/rilldata/rill/runtime/drivers/git"
 	_ "github.com/rilldata/rill/runtime/drivers/postgres"
 	_ "github.com/rilldata/rill/runtime/drivers/sqlite"
 )
@@ -57,7 +60,7 @@ type App struct {
 	ProjectPath string
 }
 
-func NewApp(ctx context.Context, ver version.Version, verbose bool, olapDriver, olapDSN, projectPath string) (*App, error) {
+func NewApp(ctx context.Context, ver version.Version, verbose bool, olapDriver, olapDSN, projectPath string, variables map[string]string) (*App, error) {
 	// Setup a friendly-looking colored logger
 	conf := zap.NewDevelopmentEncoderConfig()
 	conf.EncodeLevel = zapcore.CapitalColorLevelEncoder
@@ -109,6 +112,7 @@ func NewApp(ctx context.Context, ver version.Version, verbose bool, olapDriver,
 		RepoDriver:   "file",
 		RepoDSN:      projectPath,
 		EmbedCatalog: olapDriver == "duckdb",
+		Variables:    variables,
 	}
 	err = rt.CreateInstance(ctx, inst)
 	if err != nil {
@@ -134,20 +138,22 @@ func (a *App) Close() error {
 }
 
 func (a *App) IsProjectInit() bool {
-	repo, err := a.Runtime.Repo(a.Context, a.Instance.ID)
+	repo, release, err := a.Runtime.Repo(a.Context, a.Instance.ID)
 	if err != nil {
 		panic(err) // checks in New should ensure it never happens
 	}
+	defer release()
 
 	c := rillv1beta.New(repo, a.Instance.ID)
 	return c.IsInit(a.Context)
 }
 
 func (a *App) InitProject(exampleName string) error {
-	repo, err := a.Runtime.Repo(a.Context, a.Instance.ID)
+	repo, release, err := a.Runtime.Repo(a.Context, a.Instance.ID)
 	if err != nil {
 		panic(err) // checks in New should ensure it never happens
 	}
+	defer release()
 
 	c := rillv1beta.New(repo, a.Instance.ID)
 	if c.IsInit(a.Context) {
@@ -207,32 +213,26 @@ func (a *App) InitProject(exampleName string) error {
 
 func (a *App) Reconcile() error {
 	a.Logger.Infof("Hydrating project '%s'", a.ProjectPath)
-	res, err := a.Runtime.Reconcile(a.Context, a.Instance.ID, nil, nil, false, false)
-	if err != nil {
-		return err
-	}
-	if a.Context.Err() != nil {
-		a.Logger.Errorf("Hydration canceled")
-		return nil
-	}
-	for _, path := range res.AffectedPaths {
-		a.Logger.Infof("Reconciled: %s", path)
-	}
-	for _, merr := range res.Errors {
-		a.Logger.Errorf("%s: %s", merr.FilePath, merr.Message)
-	}
-	if len(res.Errors) == 0 {
-		a.Logger.Infof("Hydration completed!")
-	} else {
-		a.Logger.Infof("Hydration failed")
-	}
-	return nil
+	return a.reconcile(nil, nil)
 }
 
 func (a *App) ReconcileSource(sourcePath string) error {
 	a.Logger.Infof("Reconciling source and impacted models in project '%s'", a.ProjectPath)
 	paths := []string{sourcePath}
-	res, err := a.Runtime.Reconcile(a.Context, a.Instance.ID, paths, paths, false, false)
+	return a.reconcile(paths, paths)
+}
+
+// reconcile reconciles the project and logs the progress of every artifact as it's reconciled.
+func (a *App) reconcile(changedPaths, forcedPaths []string) error {
+	reported := make(map[*runtimev1.ReconcileError]bool)
+	onEvent := func(e catalog.ReconcileEvent) {
+		for _, merr := range e.Errors {
+			reported[merr] = true
+		}
+		a.logReconcileEvent(e)
+	}
+
+	res, err := a.Runtime.ReconcileStream(a.Context, a.Instance.ID, changedPaths, forcedPaths, false, false, onEvent)
 	if err != nil {
 		return err
 	}
@@ -240,11 +240,11 @@ func (a *App) ReconcileSource(sourcePath string) error {
 		a.Logger.Errorf("Hydration canceled")
 		return nil
 	}
-	for _, path := range res.AffectedPaths {
-		a.Logger.Infof("Reconciled: %s", path)
-	}
+	// log errors that don't relate to a single artifact being reconciled (like duplicate names)
 	for _, merr := range res.Errors {
-		a.Logger.Errorf("%s: %s", merr.FilePath, merr.Message)
+		if !reported[merr] {
+			a.Logger.Errorf("%s: %s", merr.FilePath, merr.Message)
+		}
 	}
 	if len(res.Errors) == 0 {
 		a.Logger.Infof("Hydration completed!")
@@ -254,7 +254,30 @@ func (a *App) ReconcileSource(sourcePath string) error {
 	return nil
 }
 
-func (a *App) Serve(httpPort, grpcPort int, enableUI, openBrowser bool) error {
+// logReconcileEvent renders the progress of an artifact during reconcile.
+func (a *App) logReconcileEvent(e catalog.ReconcileEvent) {
+	d := e.Duration.Round(time.Millisecond)
+	switch e.Type {
+	case catalog.ReconcileEventQueued:
+		a.Logger.Debugf("Queued: %s", e.Path)
+	case catalog.ReconcileEventStarted:
+		a.Logger.Infof("Reconciling: %s", e.Path)
+	case catalog.ReconcileEventCreated:
+		a.Logger.Infof("Created: %s (%s)", e.Path, d)
+	case catalog.ReconcileEventUpdated:
+		a.Logger.Infof("Updated: %s (%s)", e.Path, d)
+	case catalog.ReconcileEventRenamed:
+		a.Logger.Infof("Renamed: %s (%s)", e.Path, d)
+	case catalog.ReconcileEventDropped:
+		a.Logger.Infof("Dropped: %s (%s)", e.Path, d)
+	case catalog.ReconcileEventErrored:
+		for _, merr := range e.Errors {
+			a.Logger.Errorf("%s: %s", merr.FilePath, merr.Message)
+		}
+	}
+}
+
+func (a *App) Serve(httpPort, grpcPort int, enableUI, openBrowser, watch bool) error {
 	// Build local info for frontend
 	localConf, err := config()
 	if err != nil {
@@ -318,6 +341,13 @@ func (a *App) Serve(httpPort, grpcPort int, enableUI, openBrowser bool) error {
 		return graceful.ServeHTTP(ctx, server, httpPort)
 	})
 
+	// Reconcile files as they're edited
+	if watch {
+		group.Go(func() error {
+			return a.Runtime.WatchRepo(ctx, a.Instance.ID)
+		})
+	}
+
 	// Open the browser when health check succeeds
 	go a.pollServer(ctx, httpPort, enableUI && openBrowser)
 
@@ -215,6 +215,133 @@ func (DatabaseObjectType) EnumDescriptor() ([]byte, []int) {
 	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{2}
 }
 
+type CatalogEntryVersion_Operation int32
+
+const (
+	CatalogEntryVersion_OPERATION_UNSPECIFIED CatalogEntryVersion_Operation = 0
+	CatalogEntryVersion_OPERATION_CREATE      CatalogEntryVersion_Operation = 1
+	CatalogEntryVersion_OPERATION_UPDATE      CatalogEntryVersion_Operation = 2
+	CatalogEntryVersion_OPERATION_RENAME      CatalogEntryVersion_Operation = 3
+	CatalogEntryVersion_OPERATION_DELETE      CatalogEntryVersion_Operation = 4
+)
+
+// Enum value maps for CatalogEntryVersion_Operation.
+var (
+	CatalogEntryVersion_Operation_name = map[int32]string{
+		0: "OPERATION_UNSPECIFIED",
+		1: "OPERATION_CREATE",
+		2: "OPERATION_UPDATE",
+		3: "OPERATION_RENAME",
+		4: "OPERATION_DELETE",
+	}
+	CatalogEntryVersion_Operation_value = map[string]int32{
+		"OPERATION_UNSPECIFIED": 0,
+		"OPERATION_CREATE":      1,
+		"OPERATION_UPDATE":      2,
+		"OPERATION_RENAME":      3,
+		"OPERATION_DELETE":      4,
+	}
+)
+
+func (x CatalogEntryVersion_Operation) Enum() *CatalogEntryVersion_Operation {
+	p := new(CatalogEntryVersion_Operation)
+	*p = x
+	return p
+}
+
+func (x CatalogEntryVersion_Operation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CatalogEntryVersion_Operation) Descriptor() protoreflect.EnumDescriptor {
+	return file_rill_runtime_v1_api_proto_enumTypes[3].Descriptor()
+}
+
+func (CatalogEntryVersion_Operation) Type() protoreflect.EnumType {
+	return &file_rill_runtime_v1_api_proto_enumTypes[3]
+}
+
+func (x CatalogEntryVersion_Operation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CatalogEntryVersion_Operation.Descriptor instead.
+func (CatalogEntryVersion_Operation) EnumDescriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{33, 0}
+}
+
+// Type of the event
+type ReconcileEvent_Type int32
+
+const (
+	ReconcileEvent_TYPE_UNSPECIFIED ReconcileEvent_Type = 0
+	// The item will be reconciled
+	ReconcileEvent_TYPE_QUEUED ReconcileEvent_Type = 1
+	// The item started reconciling
+	ReconcileEvent_TYPE_STARTED ReconcileEvent_Type = 2
+	// The item was created
+	ReconcileEvent_TYPE_CREATED ReconcileEvent_Type = 3
+	// The item was updated
+	ReconcileEvent_TYPE_UPDATED ReconcileEvent_Type = 4
+	// The item was renamed
+	ReconcileEvent_TYPE_RENAMED ReconcileEvent_Type = 5
+	// The item was dropped
+	ReconcileEvent_TYPE_DROPPED ReconcileEvent_Type = 6
+	// The item failed to reconcile (see errors)
+	ReconcileEvent_TYPE_ERRORED ReconcileEvent_Type = 7
+)
+
+// Enum value maps for ReconcileEvent_Type.
+var (
+	ReconcileEvent_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_QUEUED",
+		2: "TYPE_STARTED",
+		3: "TYPE_CREATED",
+		4: "TYPE_UPDATED",
+		5: "TYPE_RENAMED",
+		6: "TYPE_DROPPED",
+		7: "TYPE_ERRORED",
+	}
+	ReconcileEvent_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"TYPE_QUEUED":      1,
+		"TYPE_STARTED":     2,
+		"TYPE_CREATED":     3,
+		"TYPE_UPDATED":     4,
+		"TYPE_RENAMED":     5,
+		"TYPE_DROPPED":     6,
+		"TYPE_ERRORED":     7,
+	}
+)
+
+func (x ReconcileEvent_Type) Enum() *ReconcileEvent_Type {
+	p := new(ReconcileEvent_Type)
+	*p = x
+	return p
+}
+
+func (x ReconcileEvent_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReconcileEvent_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_rill_runtime_v1_api_proto_enumTypes[4].Descriptor()
+}
+
+func (ReconcileEvent_Type) Type() protoreflect.EnumType {
+	return &file_rill_runtime_v1_api_proto_enumTypes[4]
+}
+
+func (x ReconcileEvent_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReconcileEvent_Type.Descriptor instead.
+func (ReconcileEvent_Type) EnumDescriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{45, 0}
+}
+
 // Code represents different categories of reconciliation errors
 type ReconcileError_Code int32
 
@@ -264,11 +391,11 @@ func (x ReconcileError_Code) String() string {
 }
 
 func (ReconcileError_Code) Descriptor() protoreflect.EnumDescriptor {
-	return file_rill_runtime_v1_api_proto_enumTypes[3].Descriptor()
+	return file_rill_runtime_v1_api_proto_enumTypes[5].Descriptor()
 }
 
 func (ReconcileError_Code) Type() protoreflect.EnumType {
-	return &file_rill_runtime_v1_api_proto_enumTypes[3]
+	return &file_rill_runtime_v1_api_proto_enumTypes[5]
 }
 
 func (x ReconcileError_Code) Number() protoreflect.EnumNumber {
@@ -277,7 +404,7 @@ func (x ReconcileError_Code) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ReconcileError_Code.Descriptor instead.
 func (ReconcileError_Code) EnumDescriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{32, 0}
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{48, 0}
 }
 
 // Type represents the field type
@@ -320,11 +447,11 @@ func (x Connector_Property_Type) String() string {
 }
 
 func (Connector_Property_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_rill_runtime_v1_api_proto_enumTypes[4].Descriptor()
+	return file_rill_runtime_v1_api_proto_enumTypes[6].Descriptor()
 }
 
 func (Connector_Property_Type) Type() protoreflect.EnumType {
-	return &file_rill_runtime_v1_api_proto_enumTypes[4]
+	return &file_rill_runtime_v1_api_proto_enumTypes[6]
 }
 
 func (x Connector_Property_Type) Number() protoreflect.EnumNumber {
@@ -333,7 +460,7 @@ func (x Connector_Property_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Connector_Property_Type.Descriptor instead.
 func (Connector_Property_Type) EnumDescriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{90, 0, 0}
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{108, 0, 0}
 }
 
 // Request message for RuntimeService.Ping
@@ -450,7 +577,7 @@ type Instance struct {
 	OlapDriver string `protobuf:"bytes,2,opt,name=olap_driver,json=olapDriver,proto3" json:"olap_driver,omitempty"`
 	// DSN for connection to OLAP
 	OlapDsn string `protobuf:"bytes,3,opt,name=olap_dsn,json=olapDsn,proto3" json:"olap_dsn,omitempty"`
-	// Driver for reading/editing code artifacts (options: file, metastore).
+	// Driver for reading/editing code artifacts (options: file, git, metastore).
 	// This enables virtualizing a file system in a cloud setting.
 	RepoDriver string `protobuf:"bytes,4,opt,name=repo_driver,json=repoDriver,proto3" json:"repo_driver,omitempty"`
 	// DSN for connecting to repo
@@ -458,6 +585,16 @@ type Instance struct {
 	// If true, the runtime will store the instance's catalog in its OLAP store instead
 	// of in the runtime's metadata store. Currently only supported for the duckdb driver.
 	EmbedCatalog bool `protobuf:"varint,6,opt,name=embed_catalog,json=embedCatalog,proto3" json:"embed_catalog,omitempty"`
+	// Default timeout for queries served by the query APIs (0 means no timeout)
+	QueryTimeoutMs int64 `protobuf:"varint,7,opt,name=query_timeout_ms,json=queryTimeoutMs,proto3" json:"query_timeout_ms,omitempty"`
+	// Default max number of rows returned by queries served by the query APIs (0 means no limit)
+	QueryMaxRows int64 `protobuf:"varint,8,opt,name=query_max_rows,json=queryMaxRows,proto3" json:"query_max_rows,omitempty"`
+	// Default max memory in bytes for queries served by the query APIs (0 means the OLAP store's default).
+	// Not supported by the duckdb driver, whose memory limit is database-wide (set it with the memory_limit DSN option instead).
+	QueryMaxMemoryBytes int64 `protobuf:"varint,9,opt,name=query_max_memory_bytes,json=queryMaxMemoryBytes,proto3" json:"query_max_memory_bytes,omitempty"`
+	// Variables that are interpolated into the instance's sources and models (as {{ .vars.name }}).
+	// They override the defaults set in the project's rill.yaml.
+	Variables map[string]string `protobuf:"bytes,10,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *Instance) Reset() {
@@ -534,6 +671,34 @@ func (x *Instance) GetEmbedCatalog() bool {
 	return false
 }
 
+func (x *Instance) GetQueryTimeoutMs() int64 {
+	if x != nil {
+		return x.QueryTimeoutMs
+	}
+	return 0
+}
+
+func (x *Instance) GetQueryMaxRows() int64 {
+	if x != nil {
+		return x.QueryMaxRows
+	}
+	return 0
+}
+
+func (x *Instance) GetQueryMaxMemoryBytes() int64 {
+	if x != nil {
+		return x.QueryMaxMemoryBytes
+	}
+	return 0
+}
+
+func (x *Instance) GetVariables() map[string]string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
 // Request message for RuntimeService.ListInstances
 type ListInstancesRequest struct {
 	state         protoimpl.MessageState
@@ -749,12 +914,16 @@ type CreateInstanceRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InstanceId   string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
-	OlapDriver   string `protobuf:"bytes,2,opt,name=olap_driver,json=olapDriver,proto3" json:"olap_driver,omitempty"`
-	OlapDsn      string `protobuf:"bytes,3,opt,name=olap_dsn,json=olapDsn,proto3" json:"olap_dsn,omitempty"`
-	RepoDriver   string `protobuf:"bytes,4,opt,name=repo_driver,json=repoDriver,proto3" json:"repo_driver,omitempty"`
-	RepoDsn      string `protobuf:"bytes,5,opt,name=repo_dsn,json=repoDsn,proto3" json:"repo_dsn,omitempty"`
-	EmbedCatalog bool   `protobuf:"varint,6,opt,name=embed_catalog,json=embedCatalog,proto3" json:"embed_catalog,omitempty"`
+	InstanceId          string            `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	OlapDriver          string            `protobuf:"bytes,2,opt,name=olap_driver,json=olapDriver,proto3" json:"olap_driver,omitempty"`
+	OlapDsn             string            `protobuf:"bytes,3,opt,name=olap_dsn,json=olapDsn,proto3" json:"olap_dsn,omitempty"`
+	RepoDriver          string            `protobuf:"bytes,4,opt,name=repo_driver,json=repoDriver,proto3" json:"repo_driver,omitempty"`
+	RepoDsn             string            `protobuf:"bytes,5,opt,name=repo_dsn,json=repoDsn,proto3" json:"repo_dsn,omitempty"`
+	EmbedCatalog        bool              `protobuf:"varint,6,opt,name=embed_catalog,json=embedCatalog,proto3" json:"embed_catalog,omitempty"`
+	QueryTimeoutMs      int64             `protobuf:"varint,7,opt,name=query_timeout_ms,json=queryTimeoutMs,proto3" json:"query_timeout_ms,omitempty"`
+	QueryMaxRows        int64             `protobuf:"varint,8,opt,name=query_max_rows,json=queryMaxRows,proto3" json:"query_max_rows,omitempty"`
+	QueryMaxMemoryBytes int64             `protobuf:"varint,9,opt,name=query_max_memory_bytes,json=queryMaxMemoryBytes,proto3" json:"query_max_memory_bytes,omitempty"`
+	Variables           map[string]string `protobuf:"bytes,10,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *CreateInstanceRequest) Reset() {
@@ -831,6 +1000,34 @@ func (x *CreateInstanceRequest) GetEmbedCatalog() bool {
 	return false
 }
 
+func (x *CreateInstanceRequest) GetQueryTimeoutMs() int64 {
+	if x != nil {
+		return x.QueryTimeoutMs
+	}
+	return 0
+}
+
+func (x *CreateInstanceRequest) GetQueryMaxRows() int64 {
+	if x != nil {
+		return x.QueryMaxRows
+	}
+	return 0
+}
+
+func (x *CreateInstanceRequest) GetQueryMaxMemoryBytes() int64 {
+	if x != nil {
+		return x.QueryMaxMemoryBytes
+	}
+	return 0
+}
+
+func (x *CreateInstanceRequest) GetVariables() map[string]string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
 // Response message for RuntimeService.CreateInstance
 type CreateInstanceResponse struct {
 	state         protoimpl.MessageState
@@ -879,17 +1076,30 @@ func (x *CreateInstanceResponse) GetInstance() *Instance {
 	return nil
 }
 
-// Request message for RuntimeService.DeleteInstance
-type DeleteInstanceRequest struct {
+// Request message for RuntimeService.EditInstance.
+// Fields that are not set are left unchanged.
+type EditInstanceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
-}
-
-func (x *DeleteInstanceRequest) Reset() {
-	*x = DeleteInstanceRequest{}
+	InstanceId          string  `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	OlapDriver          *string `protobuf:"bytes,2,opt,name=olap_driver,json=olapDriver,proto3,oneof" json:"olap_driver,omitempty"`
+	OlapDsn             *string `protobuf:"bytes,3,opt,name=olap_dsn,json=olapDsn,proto3,oneof" json:"olap_dsn,omitempty"`
+	RepoDriver          *string `protobuf:"bytes,4,opt,name=repo_driver,json=repoDriver,proto3,oneof" json:"repo_driver,omitempty"`
+	RepoDsn             *string `protobuf:"bytes,5,opt,name=repo_dsn,json=repoDsn,proto3,oneof" json:"repo_dsn,omitempty"`
+	EmbedCatalog        *bool   `protobuf:"varint,6,opt,name=embed_catalog,json=embedCatalog,proto3,oneof" json:"embed_catalog,omitempty"`
+	QueryTimeoutMs      *int64  `protobuf:"varint,7,opt,name=query_timeout_ms,json=queryTimeoutMs,proto3,oneof" json:"query_timeout_ms,omitempty"`
+	QueryMaxRows        *int64  `protobuf:"varint,8,opt,name=query_max_rows,json=queryMaxRows,proto3,oneof" json:"query_max_rows,omitempty"`
+	QueryMaxMemoryBytes *int64  `protobuf:"varint,9,opt,name=query_max_memory_bytes,json=queryMaxMemoryBytes,proto3,oneof" json:"query_max_memory_bytes,omitempty"`
+	// Variables to set. They're merged into the instance's existing variables (an empty string is a valid value).
+	Variables map[string]string `protobuf:"bytes,10,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Names of variables to remove. They're removed before the variables above are set.
+	UnsetVariables []string `protobuf:"bytes,11,rep,name=unset_variables,json=unsetVariables,proto3" json:"unset_variables,omitempty"`
+}
+
+func (x *EditInstanceRequest) Reset() {
+	*x = EditInstanceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -897,13 +1107,13 @@ func (x *DeleteInstanceRequest) Reset() {
 	}
 }
 
-func (x *DeleteInstanceRequest) String() string {
+func (x *EditInstanceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteInstanceRequest) ProtoMessage() {}
+func (*EditInstanceRequest) ProtoMessage() {}
 
-func (x *DeleteInstanceRequest) ProtoReflect() protoreflect.Message {
+func (x *EditInstanceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -915,27 +1125,99 @@ func (x *DeleteInstanceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteInstanceRequest.ProtoReflect.Descriptor instead.
-func (*DeleteInstanceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EditInstanceRequest.ProtoReflect.Descriptor instead.
+func (*EditInstanceRequest) Descriptor() ([]byte, []int) {
 	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *DeleteInstanceRequest) GetInstanceId() string {
+func (x *EditInstanceRequest) GetInstanceId() string {
 	if x != nil {
 		return x.InstanceId
 	}
 	return ""
 }
 
-// Response message for RuntimeService.DeleteInstance
-type DeleteInstanceResponse struct {
+func (x *EditInstanceRequest) GetOlapDriver() string {
+	if x != nil && x.OlapDriver != nil {
+		return *x.OlapDriver
+	}
+	return ""
+}
+
+func (x *EditInstanceRequest) GetOlapDsn() string {
+	if x != nil && x.OlapDsn != nil {
+		return *x.OlapDsn
+	}
+	return ""
+}
+
+func (x *EditInstanceRequest) GetRepoDriver() string {
+	if x != nil && x.RepoDriver != nil {
+		return *x.RepoDriver
+	}
+	return ""
+}
+
+func (x *EditInstanceRequest) GetRepoDsn() string {
+	if x != nil && x.RepoDsn != nil {
+		return *x.RepoDsn
+	}
+	return ""
+}
+
+func (x *EditInstanceRequest) GetEmbedCatalog() bool {
+	if x != nil && x.EmbedCatalog != nil {
+		return *x.EmbedCatalog
+	}
+	return false
+}
+
+func (x *EditInstanceRequest) GetQueryTimeoutMs() int64 {
+	if x != nil && x.QueryTimeoutMs != nil {
+		return *x.QueryTimeoutMs
+	}
+	return 0
+}
+
+func (x *EditInstanceRequest) GetQueryMaxRows() int64 {
+	if x != nil && x.QueryMaxRows != nil {
+		return *x.QueryMaxRows
+	}
+	return 0
+}
+
+func (x *EditInstanceRequest) GetQueryMaxMemoryBytes() int64 {
+	if x != nil && x.QueryMaxMemoryBytes != nil {
+		return *x.QueryMaxMemoryBytes
+	}
+	return 0
+}
+
+func (x *EditInstanceRequest) GetVariables() map[string]string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *EditInstanceRequest) GetUnsetVariables() []string {
+	if x != nil {
+		return x.UnsetVariables
+	}
+	return nil
+}
+
+// Response message for RuntimeService.EditInstance
+type EditInstanceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Instance *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
 }
 
-func (x *DeleteInstanceResponse) Reset() {
-	*x = DeleteInstanceResponse{}
+func (x *EditInstanceResponse) Reset() {
+	*x = EditInstanceResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -943,13 +1225,13 @@ func (x *DeleteInstanceResponse) Reset() {
 	}
 }
 
-func (x *DeleteInstanceResponse) String() string {
+func (x *EditInstanceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteInstanceResponse) ProtoMessage() {}
+func (*EditInstanceResponse) ProtoMessage() {}
 
-func (x *DeleteInstanceResponse) ProtoReflect() protoreflect.Message {
+func (x *EditInstanceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -961,23 +1243,32 @@ func (x *DeleteInstanceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteInstanceResponse.ProtoReflect.Descriptor instead.
-func (*DeleteInstanceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use EditInstanceResponse.ProtoReflect.Descriptor instead.
+func (*EditInstanceResponse) Descriptor() ([]byte, []int) {
 	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{10}
 }
 
-// Request message for RuntimeService.ListFiles
-type ListFilesRequest struct {
+func (x *EditInstanceResponse) GetInstance() *Instance {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+// Request message for RuntimeService.DeleteInstance
+type DeleteInstanceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
-	Glob       string `protobuf:"bytes,2,opt,name=glob,proto3" json:"glob,omitempty"`
+	// Drop the instance's data: the OLAP tables and views created for its sources and models,
+	// its embedded catalog and, for drivers that manage their own storage (like a DuckDB file), the stored data.
+	DropData bool `protobuf:"varint,2,opt,name=drop_data,json=dropData,proto3" json:"drop_data,omitempty"`
 }
 
-func (x *ListFilesRequest) Reset() {
-	*x = ListFilesRequest{}
+func (x *DeleteInstanceRequest) Reset() {
+	*x = DeleteInstanceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -985,13 +1276,13 @@ func (x *ListFilesRequest) Reset() {
 	}
 }
 
-func (x *ListFilesRequest) String() string {
+func (x *DeleteInstanceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListFilesRequest) ProtoMessage() {}
+func (*DeleteInstanceRequest) ProtoMessage() {}
 
-func (x *ListFilesRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteInstanceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1003,36 +1294,45 @@ func (x *ListFilesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListFilesRequest.ProtoReflect.Descriptor instead.
-func (*ListFilesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteInstanceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteInstanceRequest) Descriptor() ([]byte, []int) {
 	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ListFilesRequest) GetInstanceId() string {
+func (x *DeleteInstanceRequest) GetInstanceId() string {
 	if x != nil {
 		return x.InstanceId
 	}
 	return ""
 }
 
-func (x *ListFilesRequest) GetGlob() string {
+func (x *DeleteInstanceRequest) GetDropData() bool {
 	if x != nil {
-		return x.Glob
+		return x.DropData
 	}
-	return ""
+	return false
 }
 
-// Response message for RuntimeService.ListFiles
-type ListFilesResponse struct {
+// Response message for RuntimeService.DeleteInstance
+type DeleteInstanceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Paths []string `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
+	// Number of cached connections that were evicted (and closed)
+	ConnectionsEvicted int32 `protobuf:"varint,1,opt,name=connections_evicted,json=connectionsEvicted,proto3" json:"connections_evicted,omitempty"`
+	// Number of cached query results that were purged
+	QueryCacheEntriesPurged int32 `protobuf:"varint,2,opt,name=query_cache_entries_purged,json=queryCacheEntriesPurged,proto3" json:"query_cache_entries_purged,omitempty"`
+	// Names of the catalog entries that were deleted
+	CatalogEntriesDeleted []string `protobuf:"bytes,3,rep,name=catalog_entries_deleted,json=catalogEntriesDeleted,proto3" json:"catalog_entries_deleted,omitempty"`
+	// Names of the OLAP objects that were dropped (only set if drop_data was true)
+	DroppedObjects []string `protobuf:"bytes,4,rep,name=dropped_objects,json=droppedObjects,proto3" json:"dropped_objects,omitempty"`
+	// True if the data stored by the OLAP driver was deleted (only set if drop_data was true)
+	OlapDataDropped bool `protobuf:"varint,5,opt,name=olap_data_dropped,json=olapDataDropped,proto3" json:"olap_data_dropped,omitempty"`
 }
 
-func (x *ListFilesResponse) Reset() {
-	*x = ListFilesResponse{}
+func (x *DeleteInstanceResponse) Reset() {
+	*x = DeleteInstanceResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1040,13 +1340,13 @@ func (x *ListFilesResponse) Reset() {
 	}
 }
 
-func (x *ListFilesResponse) String() string {
+func (x *DeleteInstanceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListFilesResponse) ProtoMessage() {}
+func (*DeleteInstanceResponse) ProtoMessage() {}
 
-func (x *ListFilesResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteInstanceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1058,30 +1358,61 @@ func (x *ListFilesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListFilesResponse.ProtoReflect.Descriptor instead.
-func (*ListFilesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteInstanceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteInstanceResponse) Descriptor() ([]byte, []int) {
 	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ListFilesResponse) GetPaths() []string {
+func (x *DeleteInstanceResponse) GetConnectionsEvicted() int32 {
 	if x != nil {
-		return x.Paths
+		return x.ConnectionsEvicted
+	}
+	return 0
+}
+
+func (x *DeleteInstanceResponse) GetQueryCacheEntriesPurged() int32 {
+	if x != nil {
+		return x.QueryCacheEntriesPurged
+	}
+	return 0
+}
+
+func (x *DeleteInstanceResponse) GetCatalogEntriesDeleted() []string {
+	if x != nil {
+		return x.CatalogEntriesDeleted
 	}
 	return nil
 }
 
-// Request message for RuntimeService.GetFile
-type GetFileRequest struct {
+func (x *DeleteInstanceResponse) GetDroppedObjects() []string {
+	if x != nil {
+		return x.DroppedObjects
+	}
+	return nil
+}
+
+func (x *DeleteInstanceResponse) GetOlapDataDropped() bool {
+	if x != nil {
+		return x.OlapDataDropped
+	}
+	return false
+}
+
+// Request message for RuntimeService.ListFiles
+type ListFilesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
-	Path       string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Glob       string `protobuf:"bytes,2,opt,name=glob,proto3" json:"glob,omitempty"`
+	// Maximum number of paths to return. If 0, all matching paths are returned.
+	PageSize  int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 }
 
-func (x *GetFileRequest) Reset() {
-	*x = GetFileRequest{}
+func (x *ListFilesRequest) Reset() {
+	*x = ListFilesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1089,13 +1420,13 @@ func (x *GetFileRequest) Reset() {
 	}
 }
 
-func (x *GetFileRequest) String() string {
+func (x *ListFilesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFileRequest) ProtoMessage() {}
+func (*ListFilesRequest) ProtoMessage() {}
 
-func (x *GetFileRequest) ProtoReflect() protoreflect.Message {
+func (x *ListFilesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1107,12 +1438,140 @@ func (x *GetFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFileRequest.ProtoReflect.Descriptor instead.
-func (*GetFileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListFilesRequest.ProtoReflect.Descriptor instead.
+func (*ListFilesRequest) Descriptor() ([]byte, []int) {
 	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetFileRequest) GetInstanceId() string {
+func (x *ListFilesRequest) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *ListFilesRequest) GetGlob() string {
+	if x != nil {
+		return x.Glob
+	}
+	return ""
+}
+
+func (x *ListFilesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListFilesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// Response message for RuntimeService.ListFiles
+type ListFilesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Paths         []string `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
+	NextPageToken string   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListFilesResponse) Reset() {
+	*x = ListFilesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFilesResponse) ProtoMessage() {}
+
+func (x *ListFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFilesResponse.ProtoReflect.Descriptor instead.
+func (*ListFilesResponse) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListFilesResponse) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *ListFilesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Request message for RuntimeService.GetFile
+type GetFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Path       string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// Optional revision to get the file's contents as of (see ListFileRevisions)
+	Revision string `protobuf:"bytes,3,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *GetFileRequest) Reset() {
+	*x = GetFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileRequest) ProtoMessage() {}
+
+func (x *GetFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileRequest.ProtoReflect.Descriptor instead.
+func (*GetFileRequest) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetFileRequest) GetInstanceId() string {
 	if x != nil {
 		return x.InstanceId
 	}
@@ -1126,6 +1585,13 @@ func (x *GetFileRequest) GetPath() string {
 	return ""
 }
 
+func (x *GetFileRequest) GetRevision() string {
+	if x != nil {
+		return x.Revision
+	}
+	return ""
+}
+
 // Reponse message for RuntimeService.GetFile
 type GetFileResponse struct {
 	state         protoimpl.MessageState
@@ -1134,12 +1600,15 @@ type GetFileResponse struct {
 
 	Blob      string                 `protobuf:"bytes,1,opt,name=blob,proto3" json:"blob,omitempty"`
 	UpdatedOn *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=updated_on,json=updatedOn,proto3" json:"updated_on,omitempty"`
+	// Opaque identifier of the file's current contents. Pass it as expected_version in
+	// PutFile to detect conflicting edits. Not set when a revision was requested.
+	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *GetFileResponse) Reset() {
 	*x = GetFileResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[14]
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1152,7 +1621,7 @@ func (x *GetFileResponse) String() string {
 func (*GetFileResponse) ProtoMessage() {}
 
 func (x *GetFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[14]
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1165,7 +1634,7 @@ func (x *GetFileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFileResponse.ProtoReflect.Descriptor instead.
 func (*GetFileResponse) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{14}
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetFileResponse) GetBlob() string {
@@ -1182,6 +1651,13 @@ func (x *GetFileResponse) GetUpdatedOn() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *GetFileResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
 // Request message for RuntimeService.PutFile
 type PutFileRequest struct {
 	state         protoimpl.MessageState
@@ -1196,12 +1672,15 @@ type PutFileRequest struct {
 	// Will cause the operation to fail if the file already exists.
 	// It should only be set when create = true.
 	CreateOnly bool `protobuf:"varint,5,opt,name=create_only,json=createOnly,proto3" json:"create_only,omitempty"`
+	// If set, the operation will fail with a conflict if the file's current version
+	// (see GetFileResponse) doesn't match, i.e. if it was changed by someone else.
+	ExpectedVersion string `protobuf:"bytes,6,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
 }
 
 func (x *PutFileRequest) Reset() {
 	*x = PutFileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[15]
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1214,7 +1693,7 @@ func (x *PutFileRequest) String() string {
 func (*PutFileRequest) ProtoMessage() {}
 
 func (x *PutFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[15]
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1227,7 +1706,7 @@ func (x *PutFileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutFileRequest.ProtoReflect.Descriptor instead.
 func (*PutFileRequest) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{15}
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *PutFileRequest) GetInstanceId() string {
@@ -1265,6 +1744,13 @@ func (x *PutFileRequest) GetCreateOnly() bool {
 	return false
 }
 
+func (x *PutFileRequest) GetExpectedVersion() string {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return ""
+}
+
 // Response message for RuntimeService.PutFile
 type PutFileResponse struct {
 	state         protoimpl.MessageState
@@ -1277,7 +1763,7 @@ type PutFileResponse struct {
 func (x *PutFileResponse) Reset() {
 	*x = PutFileResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[16]
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1290,7 +1776,7 @@ func (x *PutFileResponse) String() string {
 func (*PutFileResponse) ProtoMessage() {}
 
 func (x *PutFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[16]
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1303,7 +1789,7 @@ func (x *PutFileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutFileResponse.ProtoReflect.Descriptor instead.
 func (*PutFileResponse) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{16}
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *PutFileResponse) GetFilePath() string {
@@ -1326,7 +1812,7 @@ type DeleteFileRequest struct {
 func (x *DeleteFileRequest) Reset() {
 	*x = DeleteFileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[17]
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1339,7 +1825,7 @@ func (x *DeleteFileRequest) String() string {
 func (*DeleteFileRequest) ProtoMessage() {}
 
 func (x *DeleteFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[17]
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1352,7 +1838,7 @@ func (x *DeleteFileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteFileRequest.ProtoReflect.Descriptor instead.
 func (*DeleteFileRequest) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{17}
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *DeleteFileRequest) GetInstanceId() string {
@@ -1379,7 +1865,7 @@ type DeleteFileResponse struct {
 func (x *DeleteFileResponse) Reset() {
 	*x = DeleteFileResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[18]
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1392,7 +1878,7 @@ func (x *DeleteFileResponse) String() string {
 func (*DeleteFileResponse) ProtoMessage() {}
 
 func (x *DeleteFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[18]
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1405,7 +1891,7 @@ func (x *DeleteFileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteFileResponse.ProtoReflect.Descriptor instead.
 func (*DeleteFileResponse) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{18}
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{20}
 }
 
 // Request message for RuntimeService.RenameFile
@@ -1422,7 +1908,7 @@ type RenameFileRequest struct {
 func (x *RenameFileRequest) Reset() {
 	*x = RenameFileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[19]
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1435,7 +1921,7 @@ func (x *RenameFileRequest) String() string {
 func (*RenameFileRequest) ProtoMessage() {}
 
 func (x *RenameFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[19]
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1448,7 +1934,7 @@ func (x *RenameFileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RenameFileRequest.ProtoReflect.Descriptor instead.
 func (*RenameFileRequest) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{19}
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *RenameFileRequest) GetInstanceId() string {
@@ -1482,7 +1968,7 @@ type RenameFileResponse struct {
 func (x *RenameFileResponse) Reset() {
 	*x = RenameFileResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[20]
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1495,7 +1981,7 @@ func (x *RenameFileResponse) String() string {
 func (*RenameFileResponse) ProtoMessage() {}
 
 func (x *RenameFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[20]
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1508,45 +1994,39 @@ func (x *RenameFileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RenameFileResponse.ProtoReflect.Descriptor instead.
 func (*RenameFileResponse) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{20}
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{22}
 }
 
-// CatalogEntry contains information about an object in the catalog
-type CatalogEntry struct {
+// FileRevision represents a change to a file in a repo that tracks history
+type FileRevision struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Types that are assignable to Object:
-	//	*CatalogEntry_Table
-	//	*CatalogEntry_Source
-	//	*CatalogEntry_Model
-	//	*CatalogEntry_MetricsView
-	Object      isCatalogEntry_Object  `protobuf_oneof:"object"`
-	Path        string                 `protobuf:"bytes,6,opt,name=path,proto3" json:"path,omitempty"`
-	CreatedOn   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_on,json=createdOn,proto3" json:"created_on,omitempty"`
-	UpdatedOn   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_on,json=updatedOn,proto3" json:"updated_on,omitempty"`
-	RefreshedOn *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=refreshed_on,json=refreshedOn,proto3" json:"refreshed_on,omitempty"`
+	Revision    string                 `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	AuthorName  string                 `protobuf:"bytes,2,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
+	AuthorEmail string                 `protobuf:"bytes,3,opt,name=author_email,json=authorEmail,proto3" json:"author_email,omitempty"`
+	Message     string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	CreatedOn   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_on,json=createdOn,proto3" json:"created_on,omitempty"`
 }
 
-func (x *CatalogEntry) Reset() {
-	*x = CatalogEntry{}
+func (x *FileRevision) Reset() {
+	*x = FileRevision{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[21]
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CatalogEntry) String() string {
+func (x *FileRevision) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CatalogEntry) ProtoMessage() {}
+func (*FileRevision) ProtoMessage() {}
 
-func (x *CatalogEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[21]
+func (x *FileRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1557,136 +2037,73 @@ func (x *CatalogEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CatalogEntry.ProtoReflect.Descriptor instead.
-func (*CatalogEntry) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use FileRevision.ProtoReflect.Descriptor instead.
+func (*FileRevision) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *CatalogEntry) GetName() string {
+func (x *FileRevision) GetRevision() string {
 	if x != nil {
-		return x.Name
+		return x.Revision
 	}
 	return ""
 }
 
-func (m *CatalogEntry) GetObject() isCatalogEntry_Object {
-	if m != nil {
-		return m.Object
+func (x *FileRevision) GetAuthorName() string {
+	if x != nil {
+		return x.AuthorName
 	}
-	return nil
+	return ""
 }
 
-func (x *CatalogEntry) GetTable() *Table {
-	if x, ok := x.GetObject().(*CatalogEntry_Table); ok {
-		return x.Table
+func (x *FileRevision) GetAuthorEmail() string {
+	if x != nil {
+		return x.AuthorEmail
 	}
-	return nil
+	return ""
 }
 
-func (x *CatalogEntry) GetSource() *Source {
-	if x, ok := x.GetObject().(*CatalogEntry_Source); ok {
-		return x.Source
+func (x *FileRevision) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-func (x *CatalogEntry) GetModel() *Model {
-	if x, ok := x.GetObject().(*CatalogEntry_Model); ok {
-		return x.Model
+func (x *FileRevision) GetCreatedOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedOn
 	}
 	return nil
 }
 
-func (x *CatalogEntry) GetMetricsView() *MetricsView {
-	if x, ok := x.GetObject().(*CatalogEntry_MetricsView); ok {
-		return x.MetricsView
-	}
-	return nil
+// Request message for RuntimeService.ListFileRevisions
+type ListFileRevisionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Path       string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
 }
 
-func (x *CatalogEntry) GetPath() string {
-	if x != nil {
-		return x.Path
+func (x *ListFileRevisionsRequest) Reset() {
+	*x = ListFileRevisionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *CatalogEntry) GetCreatedOn() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedOn
-	}
-	return nil
+func (x *ListFileRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CatalogEntry) GetUpdatedOn() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UpdatedOn
-	}
-	return nil
-}
-
-func (x *CatalogEntry) GetRefreshedOn() *timestamppb.Timestamp {
-	if x != nil {
-		return x.RefreshedOn
-	}
-	return nil
-}
-
-type isCatalogEntry_Object interface {
-	isCatalogEntry_Object()
-}
-
-type CatalogEntry_Table struct {
-	Table *Table `protobuf:"bytes,2,opt,name=table,proto3,oneof"`
-}
-
-type CatalogEntry_Source struct {
-	Source *Source `protobuf:"bytes,3,opt,name=source,proto3,oneof"`
-}
-
-type CatalogEntry_Model struct {
-	Model *Model `protobuf:"bytes,4,opt,name=model,proto3,oneof"`
-}
-
-type CatalogEntry_MetricsView struct {
-	MetricsView *MetricsView `protobuf:"bytes,5,opt,name=metrics_view,json=metricsView,proto3,oneof"`
-}
-
-func (*CatalogEntry_Table) isCatalogEntry_Object() {}
-
-func (*CatalogEntry_Source) isCatalogEntry_Object() {}
-
-func (*CatalogEntry_Model) isCatalogEntry_Object() {}
-
-func (*CatalogEntry_MetricsView) isCatalogEntry_Object() {}
-
-// Request message for RuntimeService.ListCatalogEntries
-type ListCatalogEntriesRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	InstanceId string     `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
-	Type       ObjectType `protobuf:"varint,2,opt,name=type,proto3,enum=rill.runtime.v1.ObjectType" json:"type,omitempty"`
-}
-
-func (x *ListCatalogEntriesRequest) Reset() {
-	*x = ListCatalogEntriesRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[22]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ListCatalogEntriesRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+func (*ListFileRevisionsRequest) ProtoMessage() {}
 
-func (*ListCatalogEntriesRequest) ProtoMessage() {}
-
-func (x *ListCatalogEntriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[22]
+func (x *ListFileRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1697,51 +2114,51 @@ func (x *ListCatalogEntriesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCatalogEntriesRequest.ProtoReflect.Descriptor instead.
-func (*ListCatalogEntriesRequest) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use ListFileRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListFileRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ListCatalogEntriesRequest) GetInstanceId() string {
+func (x *ListFileRevisionsRequest) GetInstanceId() string {
 	if x != nil {
 		return x.InstanceId
 	}
 	return ""
 }
 
-func (x *ListCatalogEntriesRequest) GetType() ObjectType {
+func (x *ListFileRevisionsRequest) GetPath() string {
 	if x != nil {
-		return x.Type
+		return x.Path
 	}
-	return ObjectType_OBJECT_TYPE_UNSPECIFIED
+	return ""
 }
 
-// Response message for RuntimeService.ListCatalogEntries
-type ListCatalogEntriesResponse struct {
+// Response message for RuntimeService.ListFileRevisions
+type ListFileRevisionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Entries []*CatalogEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Revisions []*FileRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
 }
 
-func (x *ListCatalogEntriesResponse) Reset() {
-	*x = ListCatalogEntriesResponse{}
+func (x *ListFileRevisionsResponse) Reset() {
+	*x = ListFileRevisionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[23]
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListCatalogEntriesResponse) String() string {
+func (x *ListFileRevisionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCatalogEntriesResponse) ProtoMessage() {}
+func (*ListFileRevisionsResponse) ProtoMessage() {}
 
-func (x *ListCatalogEntriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[23]
+func (x *ListFileRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1752,45 +2169,46 @@ func (x *ListCatalogEntriesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCatalogEntriesResponse.ProtoReflect.Descriptor instead.
-func (*ListCatalogEntriesResponse) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use ListFileRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListFileRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *ListCatalogEntriesResponse) GetEntries() []*CatalogEntry {
+func (x *ListFileRevisionsResponse) GetRevisions() []*FileRevision {
 	if x != nil {
-		return x.Entries
+		return x.Revisions
 	}
 	return nil
 }
 
-// Request message for RuntimeService.GetCatalogEntry
-type GetCatalogEntryRequest struct {
+// Request message for RuntimeService.RevertFile
+type RevertFileRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
-	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Path       string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Revision   string `protobuf:"bytes,3,opt,name=revision,proto3" json:"revision,omitempty"`
 }
 
-func (x *GetCatalogEntryRequest) Reset() {
-	*x = GetCatalogEntryRequest{}
+func (x *RevertFileRequest) Reset() {
+	*x = RevertFileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[24]
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetCatalogEntryRequest) String() string {
+func (x *RevertFileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCatalogEntryRequest) ProtoMessage() {}
+func (*RevertFileRequest) ProtoMessage() {}
 
-func (x *GetCatalogEntryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[24]
+func (x *RevertFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1801,51 +2219,56 @@ func (x *GetCatalogEntryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCatalogEntryRequest.ProtoReflect.Descriptor instead.
-func (*GetCatalogEntryRequest) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use RevertFileRequest.ProtoReflect.Descriptor instead.
+func (*RevertFileRequest) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *GetCatalogEntryRequest) GetInstanceId() string {
+func (x *RevertFileRequest) GetInstanceId() string {
 	if x != nil {
 		return x.InstanceId
 	}
 	return ""
 }
 
-func (x *GetCatalogEntryRequest) GetName() string {
+func (x *RevertFileRequest) GetPath() string {
 	if x != nil {
-		return x.Name
+		return x.Path
 	}
 	return ""
 }
 
-// Response message for RuntimeService.GetCatalogEntry
-type GetCatalogEntryResponse struct {
+func (x *RevertFileRequest) GetRevision() string {
+	if x != nil {
+		return x.Revision
+	}
+	return ""
+}
+
+// Response message for RuntimeService.RevertFile
+type RevertFileResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Entry *CatalogEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
 }
 
-func (x *GetCatalogEntryResponse) Reset() {
-	*x = GetCatalogEntryResponse{}
+func (x *RevertFileResponse) Reset() {
+	*x = RevertFileResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[25]
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetCatalogEntryResponse) String() string {
+func (x *RevertFileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCatalogEntryResponse) ProtoMessage() {}
+func (*RevertFileResponse) ProtoMessage() {}
 
-func (x *GetCatalogEntryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[25]
+func (x *RevertFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1856,45 +2279,50 @@ func (x *GetCatalogEntryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCatalogEntryResponse.ProtoReflect.Descriptor instead.
-func (*GetCatalogEntryResponse) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{25}
-}
-
-func (x *GetCatalogEntryResponse) GetEntry() *CatalogEntry {
-	if x != nil {
-		return x.Entry
-	}
-	return nil
+// Deprecated: Use RevertFileResponse.ProtoReflect.Descriptor instead.
+func (*RevertFileResponse) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{27}
 }
 
-// Request message for RuntimeService.TriggerRefresh
-type TriggerRefreshRequest struct {
+// CatalogEntry contains information about an object in the catalog
+type CatalogEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
-	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are assignable to Object:
+	//	*CatalogEntry_Table
+	//	*CatalogEntry_Source
+	//	*CatalogEntry_Model
+	//	*CatalogEntry_MetricsView
+	Object      isCatalogEntry_Object  `protobuf_oneof:"object"`
+	Path        string                 `protobuf:"bytes,6,opt,name=path,proto3" json:"path,omitempty"`
+	CreatedOn   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_on,json=createdOn,proto3" json:"created_on,omitempty"`
+	UpdatedOn   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_on,json=updatedOn,proto3" json:"updated_on,omitempty"`
+	RefreshedOn *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=refreshed_on,json=refreshedOn,proto3" json:"refreshed_on,omitempty"`
+	// Folder of the entry's artifact relative to the root folder of its kind (e.g. "finance" for "/models/finance/revenue.sql").
+	// It's empty for artifacts that are not nested in a folder.
+	Folder string `protobuf:"bytes,10,opt,name=folder,proto3" json:"folder,omitempty"`
 }
 
-func (x *TriggerRefreshRequest) Reset() {
-	*x = TriggerRefreshRequest{}
+func (x *CatalogEntry) Reset() {
+	*x = CatalogEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[26]
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TriggerRefreshRequest) String() string {
+func (x *CatalogEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TriggerRefreshRequest) ProtoMessage() {}
+func (*CatalogEntry) ProtoMessage() {}
 
-func (x *TriggerRefreshRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[26]
+func (x *CatalogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1905,126 +2333,128 @@ func (x *TriggerRefreshRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TriggerRefreshRequest.ProtoReflect.Descriptor instead.
-func (*TriggerRefreshRequest) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use CatalogEntry.ProtoReflect.Descriptor instead.
+func (*CatalogEntry) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *TriggerRefreshRequest) GetInstanceId() string {
+func (x *CatalogEntry) GetName() string {
 	if x != nil {
-		return x.InstanceId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *TriggerRefreshRequest) GetName() string {
-	if x != nil {
-		return x.Name
+func (m *CatalogEntry) GetObject() isCatalogEntry_Object {
+	if m != nil {
+		return m.Object
 	}
-	return ""
+	return nil
 }
 
-// Response message for RuntimeService.TriggerRefresh
-type TriggerRefreshResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *CatalogEntry) GetTable() *Table {
+	if x, ok := x.GetObject().(*CatalogEntry_Table); ok {
+		return x.Table
+	}
+	return nil
 }
 
-func (x *TriggerRefreshResponse) Reset() {
-	*x = TriggerRefreshResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[27]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *CatalogEntry) GetSource() *Source {
+	if x, ok := x.GetObject().(*CatalogEntry_Source); ok {
+		return x.Source
 	}
+	return nil
 }
 
-func (x *TriggerRefreshResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CatalogEntry) GetModel() *Model {
+	if x, ok := x.GetObject().(*CatalogEntry_Model); ok {
+		return x.Model
+	}
+	return nil
 }
 
-func (*TriggerRefreshResponse) ProtoMessage() {}
+func (x *CatalogEntry) GetMetricsView() *MetricsView {
+	if x, ok := x.GetObject().(*CatalogEntry_MetricsView); ok {
+		return x.MetricsView
+	}
+	return nil
+}
 
-func (x *TriggerRefreshResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[27]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *CatalogEntry) GetPath() string {
+	if x != nil {
+		return x.Path
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use TriggerRefreshResponse.ProtoReflect.Descriptor instead.
-func (*TriggerRefreshResponse) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{27}
+func (x *CatalogEntry) GetCreatedOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedOn
+	}
+	return nil
 }
 
-// Request message for RuntimeService.TriggerSync
-type TriggerSyncRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *CatalogEntry) GetUpdatedOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedOn
+	}
+	return nil
+}
 
-	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+func (x *CatalogEntry) GetRefreshedOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RefreshedOn
+	}
+	return nil
 }
 
-func (x *TriggerSyncRequest) Reset() {
-	*x = TriggerSyncRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[28]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *CatalogEntry) GetFolder() string {
+	if x != nil {
+		return x.Folder
 	}
+	return ""
 }
 
-func (x *TriggerSyncRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type isCatalogEntry_Object interface {
+	isCatalogEntry_Object()
 }
 
-func (*TriggerSyncRequest) ProtoMessage() {}
+type CatalogEntry_Table struct {
+	Table *Table `protobuf:"bytes,2,opt,name=table,proto3,oneof"`
+}
 
-func (x *TriggerSyncRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[28]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+type CatalogEntry_Source struct {
+	Source *Source `protobuf:"bytes,3,opt,name=source,proto3,oneof"`
 }
 
-// Deprecated: Use TriggerSyncRequest.ProtoReflect.Descriptor instead.
-func (*TriggerSyncRequest) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{28}
+type CatalogEntry_Model struct {
+	Model *Model `protobuf:"bytes,4,opt,name=model,proto3,oneof"`
 }
 
-func (x *TriggerSyncRequest) GetInstanceId() string {
-	if x != nil {
-		return x.InstanceId
-	}
-	return ""
+type CatalogEntry_MetricsView struct {
+	MetricsView *MetricsView `protobuf:"bytes,5,opt,name=metrics_view,json=metricsView,proto3,oneof"`
 }
 
-// Response message for RuntimeService.TriggerSync
-type TriggerSyncResponse struct {
+func (*CatalogEntry_Table) isCatalogEntry_Object() {}
+
+func (*CatalogEntry_Source) isCatalogEntry_Object() {}
+
+func (*CatalogEntry_Model) isCatalogEntry_Object() {}
+
+func (*CatalogEntry_MetricsView) isCatalogEntry_Object() {}
+
+// Request message for RuntimeService.ListCatalogEntries
+type ListCatalogEntriesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ObjectsCount        uint32 `protobuf:"varint,1,opt,name=objects_count,json=objectsCount,proto3" json:"objects_count,omitempty"`
-	ObjectsAddedCount   uint32 `protobuf:"varint,2,opt,name=objects_added_count,json=objectsAddedCount,proto3" json:"objects_added_count,omitempty"`
-	ObjectsUpdatedCount uint32 `protobuf:"varint,3,opt,name=objects_updated_count,json=objectsUpdatedCount,proto3" json:"objects_updated_count,omitempty"`
-	ObjectsRemovedCount uint32 `protobuf:"varint,4,opt,name=objects_removed_count,json=objectsRemovedCount,proto3" json:"objects_removed_count,omitempty"`
+	InstanceId string     `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Type       ObjectType `protobuf:"varint,2,opt,name=type,proto3,enum=rill.runtime.v1.ObjectType" json:"type,omitempty"`
 }
 
-func (x *TriggerSyncResponse) Reset() {
-	*x = TriggerSyncResponse{}
+func (x *ListCatalogEntriesRequest) Reset() {
+	*x = ListCatalogEntriesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2032,13 +2462,13 @@ func (x *TriggerSyncResponse) Reset() {
 	}
 }
 
-func (x *TriggerSyncResponse) String() string {
+func (x *ListCatalogEntriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TriggerSyncResponse) ProtoMessage() {}
+func (*ListCatalogEntriesRequest) ProtoMessage() {}
 
-func (x *TriggerSyncResponse) ProtoReflect() protoreflect.Message {
+func (x *ListCatalogEntriesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2050,63 +2480,36 @@ func (x *TriggerSyncResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TriggerSyncResponse.ProtoReflect.Descriptor instead.
-func (*TriggerSyncResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListCatalogEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCatalogEntriesRequest) Descriptor() ([]byte, []int) {
 	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *TriggerSyncResponse) GetObjectsCount() uint32 {
-	if x != nil {
-		return x.ObjectsCount
-	}
-	return 0
-}
-
-func (x *TriggerSyncResponse) GetObjectsAddedCount() uint32 {
-	if x != nil {
-		return x.ObjectsAddedCount
-	}
-	return 0
-}
-
-func (x *TriggerSyncResponse) GetObjectsUpdatedCount() uint32 {
+func (x *ListCatalogEntriesRequest) GetInstanceId() string {
 	if x != nil {
-		return x.ObjectsUpdatedCount
+		return x.InstanceId
 	}
-	return 0
+	return ""
 }
 
-func (x *TriggerSyncResponse) GetObjectsRemovedCount() uint32 {
+func (x *ListCatalogEntriesRequest) GetType() ObjectType {
 	if x != nil {
-		return x.ObjectsRemovedCount
+		return x.Type
 	}
-	return 0
+	return ObjectType_OBJECT_TYPE_UNSPECIFIED
 }
 
-// Request message for RuntimeService.Reconcile
-type ReconcileRequest struct {
+// Response message for RuntimeService.ListCatalogEntries
+type ListCatalogEntriesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Instance to reconcile
-	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
-	// Changed paths provides a way to "hint" what files have changed in the repo, enabling
-	// reconciliation to execute faster by not scanning all code artifacts for changes.
-	ChangedPaths []string `protobuf:"bytes,2,rep,name=changed_paths,json=changedPaths,proto3" json:"changed_paths,omitempty"`
-	// Forced paths is used to force run reconcile on certain files.
-	// This is mainly used by UI to reconcile paths missing in catalog and get errors if any.
-	ForcedPaths []string `protobuf:"bytes,3,rep,name=forced_paths,json=forcedPaths,proto3" json:"forced_paths,omitempty"`
-	// If true, will validate the file artifacts, but not actually execute any migrations
-	Dry bool `protobuf:"varint,4,opt,name=dry,proto3" json:"dry,omitempty"`
-	// If true, will not execute any migrations if any artifact fails to validate.
-	// Otherwise, it will execute a best-effort reconciliation (including dropping objects with
-	// artifacts that fail to validate.)
-	Strict bool `protobuf:"varint,5,opt,name=strict,proto3" json:"strict,omitempty"`
+	Entries []*CatalogEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
 }
 
-func (x *ReconcileRequest) Reset() {
-	*x = ReconcileRequest{}
+func (x *ListCatalogEntriesResponse) Reset() {
+	*x = ListCatalogEntriesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2114,13 +2517,13 @@ func (x *ReconcileRequest) Reset() {
 	}
 }
 
-func (x *ReconcileRequest) String() string {
+func (x *ListCatalogEntriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReconcileRequest) ProtoMessage() {}
+func (*ListCatalogEntriesResponse) ProtoMessage() {}
 
-func (x *ReconcileRequest) ProtoReflect() protoreflect.Message {
+func (x *ListCatalogEntriesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2132,63 +2535,30 @@ func (x *ReconcileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReconcileRequest.ProtoReflect.Descriptor instead.
-func (*ReconcileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListCatalogEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCatalogEntriesResponse) Descriptor() ([]byte, []int) {
 	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *ReconcileRequest) GetInstanceId() string {
-	if x != nil {
-		return x.InstanceId
-	}
-	return ""
-}
-
-func (x *ReconcileRequest) GetChangedPaths() []string {
-	if x != nil {
-		return x.ChangedPaths
-	}
-	return nil
-}
-
-func (x *ReconcileRequest) GetForcedPaths() []string {
+func (x *ListCatalogEntriesResponse) GetEntries() []*CatalogEntry {
 	if x != nil {
-		return x.ForcedPaths
+		return x.Entries
 	}
 	return nil
 }
 
-func (x *ReconcileRequest) GetDry() bool {
-	if x != nil {
-		return x.Dry
-	}
-	return false
-}
-
-func (x *ReconcileRequest) GetStrict() bool {
-	if x != nil {
-		return x.Strict
-	}
-	return false
-}
-
-// Response message for RuntimeService.Reconcile
-type ReconcileResponse struct {
+// Request message for RuntimeService.GetCatalogEntry
+type GetCatalogEntryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Errors encountered during reconciliation. If strict = false, any path in
-	// affected_paths without an error can be assumed to have been reconciled succesfully.
-	Errors []*ReconcileError `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty"`
-	// affected_paths lists all the file artifact paths that were considered while
-	// executing the reconciliation. If changed_paths was empty, this will include all
-	// code artifacts in the repo.
-	AffectedPaths []string `protobuf:"bytes,2,rep,name=affected_paths,json=affectedPaths,proto3" json:"affected_paths,omitempty"`
+	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *ReconcileResponse) Reset() {
-	*x = ReconcileResponse{}
+func (x *GetCatalogEntryRequest) Reset() {
+	*x = GetCatalogEntryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2196,13 +2566,13 @@ func (x *ReconcileResponse) Reset() {
 	}
 }
 
-func (x *ReconcileResponse) String() string {
+func (x *GetCatalogEntryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReconcileResponse) ProtoMessage() {}
+func (*GetCatalogEntryRequest) ProtoMessage() {}
 
-func (x *ReconcileResponse) ProtoReflect() protoreflect.Message {
+func (x *GetCatalogEntryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2214,52 +2584,36 @@ func (x *ReconcileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReconcileResponse.ProtoReflect.Descriptor instead.
-func (*ReconcileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCatalogEntryRequest.ProtoReflect.Descriptor instead.
+func (*GetCatalogEntryRequest) Descriptor() ([]byte, []int) {
 	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *ReconcileResponse) GetErrors() []*ReconcileError {
+func (x *GetCatalogEntryRequest) GetInstanceId() string {
 	if x != nil {
-		return x.Errors
+		return x.InstanceId
 	}
-	return nil
+	return ""
 }
 
-func (x *ReconcileResponse) GetAffectedPaths() []string {
+func (x *GetCatalogEntryRequest) GetName() string {
 	if x != nil {
-		return x.AffectedPaths
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-// ReconcileError represents an error encountered while running Reconcile.
-type ReconcileError struct {
+// Response message for RuntimeService.GetCatalogEntry
+type GetCatalogEntryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Code denotes the category of error
-	Code ReconcileError_Code `protobuf:"varint,1,opt,name=code,proto3,enum=rill.runtime.v1.ReconcileError_Code" json:"code,omitempty"`
-	// Message is a developer-friendly error message
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	// File path of the code artifact that this error relates to (if any)
-	FilePath string `protobuf:"bytes,3,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
-	// Property path of the error in the code artifact (if any).
-	// It's represented as a JS-style property path, e.g. "key0.key1[index2].key3".
-	// It only applies to structured code artifacts (i.e. YAML).
-	// Only applicable if file_path is set.
-	PropertyPath []string `protobuf:"bytes,4,rep,name=property_path,json=propertyPath,proto3" json:"property_path,omitempty"`
-	// Start location of the error in the code artifact (if any).
-	// Only applicable if file_path is set.
-	StartLocation *ReconcileError_CharLocation `protobuf:"bytes,5,opt,name=start_location,json=startLocation,proto3" json:"start_location,omitempty"`
-	// End location of the error in the code artifact (if any).
-	// Only applicable if file_path and start_location is set.
-	EndLocation *ReconcileError_CharLocation `protobuf:"bytes,6,opt,name=end_location,json=endLocation,proto3" json:"end_location,omitempty"`
+	Entry *CatalogEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
 }
 
-func (x *ReconcileError) Reset() {
-	*x = ReconcileError{}
+func (x *GetCatalogEntryResponse) Reset() {
+	*x = GetCatalogEntryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2267,13 +2621,13 @@ func (x *ReconcileError) Reset() {
 	}
 }
 
-func (x *ReconcileError) String() string {
+func (x *GetCatalogEntryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReconcileError) ProtoMessage() {}
+func (*GetCatalogEntryResponse) ProtoMessage() {}
 
-func (x *ReconcileError) ProtoReflect() protoreflect.Message {
+func (x *GetCatalogEntryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2285,79 +2639,37 @@ func (x *ReconcileError) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReconcileError.ProtoReflect.Descriptor instead.
-func (*ReconcileError) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCatalogEntryResponse.ProtoReflect.Descriptor instead.
+func (*GetCatalogEntryResponse) Descriptor() ([]byte, []int) {
 	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *ReconcileError) GetCode() ReconcileError_Code {
+func (x *GetCatalogEntryResponse) GetEntry() *CatalogEntry {
 	if x != nil {
-		return x.Code
+		return x.Entry
 	}
-	return ReconcileError_CODE_UNSPECIFIED
+	return nil
 }
 
-func (x *ReconcileError) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-func (x *ReconcileError) GetFilePath() string {
-	if x != nil {
-		return x.FilePath
-	}
-	return ""
-}
-
-func (x *ReconcileError) GetPropertyPath() []string {
-	if x != nil {
-		return x.PropertyPath
-	}
-	return nil
-}
-
-func (x *ReconcileError) GetStartLocation() *ReconcileError_CharLocation {
-	if x != nil {
-		return x.StartLocation
-	}
-	return nil
-}
-
-func (x *ReconcileError) GetEndLocation() *ReconcileError_CharLocation {
-	if x != nil {
-		return x.EndLocation
-	}
-	return nil
-}
-
-type PutFileAndReconcileRequest struct {
+// CatalogEntryVersion is a snapshot of a catalog entry, recorded when the entry changed
+type CatalogEntryVersion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Instance to store file in and reconcile
-	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
-	// Path to store the file at
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	// Contents to save to the file
-	Blob string `protobuf:"bytes,3,opt,name=blob,proto3" json:"blob,omitempty"`
-	// Create indicates whether to create the file if it doesn't already exist
-	Create bool `protobuf:"varint,4,opt,name=create,proto3" json:"create,omitempty"`
-	// create_only will cause the operation to fail if a file already exists at path.
-	// It should only be set when create = true.
-	CreateOnly bool `protobuf:"varint,5,opt,name=create_only,json=createOnly,proto3" json:"create_only,omitempty"`
-	// If true, will save the file and validate it and related file artifacts, but not actually execute any migrations.
-	Dry bool `protobuf:"varint,6,opt,name=dry,proto3" json:"dry,omitempty"`
-	// If true, will not execute any migrations if any artifact fails to validate.
-	// Otherwise, it will execute a best-effort reconciliation (including dropping objects with
-	// artifacts that fail to validate.)
-	Strict bool `protobuf:"varint,7,opt,name=strict,proto3" json:"strict,omitempty"`
+	Version   int64                         `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Operation CatalogEntryVersion_Operation `protobuf:"varint,2,opt,name=operation,proto3,enum=rill.runtime.v1.CatalogEntryVersion_Operation" json:"operation,omitempty"`
+	// The entry after the change. For deletes, it's the entry that was deleted.
+	Entry *CatalogEntry `protobuf:"bytes,3,opt,name=entry,proto3" json:"entry,omitempty"`
+	// The entry's previous name (only set for renames)
+	FromName string `protobuf:"bytes,4,opt,name=from_name,json=fromName,proto3" json:"from_name,omitempty"`
+	// SHA-256 hash of the contents of the entry's artifact file
+	ContentHash string                 `protobuf:"bytes,5,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	CreatedOn   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_on,json=createdOn,proto3" json:"created_on,omitempty"`
 }
 
-func (x *PutFileAndReconcileRequest) Reset() {
-	*x = PutFileAndReconcileRequest{}
+func (x *CatalogEntryVersion) Reset() {
+	*x = CatalogEntryVersion{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2365,13 +2677,13 @@ func (x *PutFileAndReconcileRequest) Reset() {
 	}
 }
 
-func (x *PutFileAndReconcileRequest) String() string {
+func (x *CatalogEntryVersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PutFileAndReconcileRequest) ProtoMessage() {}
+func (*CatalogEntryVersion) ProtoMessage() {}
 
-func (x *PutFileAndReconcileRequest) ProtoReflect() protoreflect.Message {
+func (x *CatalogEntryVersion) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2383,76 +2695,65 @@ func (x *PutFileAndReconcileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PutFileAndReconcileRequest.ProtoReflect.Descriptor instead.
-func (*PutFileAndReconcileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CatalogEntryVersion.ProtoReflect.Descriptor instead.
+func (*CatalogEntryVersion) Descriptor() ([]byte, []int) {
 	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *PutFileAndReconcileRequest) GetInstanceId() string {
-	if x != nil {
-		return x.InstanceId
-	}
-	return ""
-}
-
-func (x *PutFileAndReconcileRequest) GetPath() string {
+func (x *CatalogEntryVersion) GetVersion() int64 {
 	if x != nil {
-		return x.Path
+		return x.Version
 	}
-	return ""
+	return 0
 }
 
-func (x *PutFileAndReconcileRequest) GetBlob() string {
+func (x *CatalogEntryVersion) GetOperation() CatalogEntryVersion_Operation {
 	if x != nil {
-		return x.Blob
+		return x.Operation
 	}
-	return ""
+	return CatalogEntryVersion_OPERATION_UNSPECIFIED
 }
 
-func (x *PutFileAndReconcileRequest) GetCreate() bool {
+func (x *CatalogEntryVersion) GetEntry() *CatalogEntry {
 	if x != nil {
-		return x.Create
+		return x.Entry
 	}
-	return false
+	return nil
 }
 
-func (x *PutFileAndReconcileRequest) GetCreateOnly() bool {
+func (x *CatalogEntryVersion) GetFromName() string {
 	if x != nil {
-		return x.CreateOnly
+		return x.FromName
 	}
-	return false
+	return ""
 }
 
-func (x *PutFileAndReconcileRequest) GetDry() bool {
+func (x *CatalogEntryVersion) GetContentHash() string {
 	if x != nil {
-		return x.Dry
+		return x.ContentHash
 	}
-	return false
+	return ""
 }
 
-func (x *PutFileAndReconcileRequest) GetStrict() bool {
+func (x *CatalogEntryVersion) GetCreatedOn() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Strict
+		return x.CreatedOn
 	}
-	return false
+	return nil
 }
 
-type PutFileAndReconcileResponse struct {
+// Request message for RuntimeService.ListCatalogEntryVersions
+type ListCatalogEntryVersionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Errors encountered during reconciliation. If strict = false, any path in
-	// affected_paths without an error can be assumed to have been reconciled succesfully.
-	Errors []*ReconcileError `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty"`
-	// affected_paths lists all the file artifact paths that were considered while
-	// executing the reconciliation. If changed_paths was empty, this will include all
-	// code artifacts in the repo.
-	AffectedPaths []string `protobuf:"bytes,2,rep,name=affected_paths,json=affectedPaths,proto3" json:"affected_paths,omitempty"`
+	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *PutFileAndReconcileResponse) Reset() {
-	*x = PutFileAndReconcileResponse{}
+func (x *ListCatalogEntryVersionsRequest) Reset() {
+	*x = ListCatalogEntryVersionsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2460,13 +2761,13 @@ func (x *PutFileAndReconcileResponse) Reset() {
 	}
 }
 
-func (x *PutFileAndReconcileResponse) String() string {
+func (x *ListCatalogEntryVersionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PutFileAndReconcileResponse) ProtoMessage() {}
+func (*ListCatalogEntryVersionsRequest) ProtoMessage() {}
 
-func (x *PutFileAndReconcileResponse) ProtoReflect() protoreflect.Message {
+func (x *ListCatalogEntryVersionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2478,44 +2779,36 @@ func (x *PutFileAndReconcileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PutFileAndReconcileResponse.ProtoReflect.Descriptor instead.
-func (*PutFileAndReconcileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListCatalogEntryVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListCatalogEntryVersionsRequest) Descriptor() ([]byte, []int) {
 	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *PutFileAndReconcileResponse) GetErrors() []*ReconcileError {
+func (x *ListCatalogEntryVersionsRequest) GetInstanceId() string {
 	if x != nil {
-		return x.Errors
+		return x.InstanceId
 	}
-	return nil
+	return ""
 }
 
-func (x *PutFileAndReconcileResponse) GetAffectedPaths() []string {
+func (x *ListCatalogEntryVersionsRequest) GetName() string {
 	if x != nil {
-		return x.AffectedPaths
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type DeleteFileAndReconcileRequest struct {
+// Response message for RuntimeService.ListCatalogEntryVersions
+type ListCatalogEntryVersionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Instance to store file in and reconcile
-	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
-	// Path to store the file at
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	// If true, will save the file and validate it and related file artifacts, but not actually execute any migrations.
-	Dry bool `protobuf:"varint,3,opt,name=dry,proto3" json:"dry,omitempty"`
-	// If true, will not execute any migrations if any artifact fails to validate.
-	// Otherwise, it will execute a best-effort reconciliation (including dropping objects with
-	// artifacts that fail to validate.)
-	Strict bool `protobuf:"varint,4,opt,name=strict,proto3" json:"strict,omitempty"`
+	Versions []*CatalogEntryVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
 }
 
-func (x *DeleteFileAndReconcileRequest) Reset() {
-	*x = DeleteFileAndReconcileRequest{}
+func (x *ListCatalogEntryVersionsResponse) Reset() {
+	*x = ListCatalogEntryVersionsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2523,13 +2816,13 @@ func (x *DeleteFileAndReconcileRequest) Reset() {
 	}
 }
 
-func (x *DeleteFileAndReconcileRequest) String() string {
+func (x *ListCatalogEntryVersionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteFileAndReconcileRequest) ProtoMessage() {}
+func (*ListCatalogEntryVersionsResponse) ProtoMessage() {}
 
-func (x *DeleteFileAndReconcileRequest) ProtoReflect() protoreflect.Message {
+func (x *ListCatalogEntryVersionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2541,70 +2834,112 @@ func (x *DeleteFileAndReconcileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteFileAndReconcileRequest.ProtoReflect.Descriptor instead.
-func (*DeleteFileAndReconcileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListCatalogEntryVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListCatalogEntryVersionsResponse) Descriptor() ([]byte, []int) {
 	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *DeleteFileAndReconcileRequest) GetInstanceId() string {
+func (x *ListCatalogEntryVersionsResponse) GetVersions() []*CatalogEntryVersion {
 	if x != nil {
-		return x.InstanceId
+		return x.Versions
 	}
-	return ""
+	return nil
 }
 
-func (x *DeleteFileAndReconcileRequest) GetPath() string {
+// Request message for RuntimeService.RestoreCatalogEntryVersion
+type RestoreCatalogEntryVersionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Version    int64  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *RestoreCatalogEntryVersionRequest) Reset() {
+	*x = RestoreCatalogEntryVersionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreCatalogEntryVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCatalogEntryVersionRequest) ProtoMessage() {}
+
+func (x *RestoreCatalogEntryVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCatalogEntryVersionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreCatalogEntryVersionRequest) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RestoreCatalogEntryVersionRequest) GetInstanceId() string {
 	if x != nil {
-		return x.Path
+		return x.InstanceId
 	}
 	return ""
 }
 
-func (x *DeleteFileAndReconcileRequest) GetDry() bool {
+func (x *RestoreCatalogEntryVersionRequest) GetName() string {
 	if x != nil {
-		return x.Dry
+		return x.Name
 	}
-	return false
+	return ""
 }
 
-func (x *DeleteFileAndReconcileRequest) GetStrict() bool {
+func (x *RestoreCatalogEntryVersionRequest) GetVersion() int64 {
 	if x != nil {
-		return x.Strict
+		return x.Version
 	}
-	return false
+	return 0
 }
 
-type DeleteFileAndReconcileResponse struct {
+// Response message for RuntimeService.RestoreCatalogEntryVersion
+type RestoreCatalogEntryVersionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Errors encountered during reconciliation. If strict = false, any path in
-	// affected_paths without an error can be assumed to have been reconciled succesfully.
+	// Errors encountered during reconciliation
 	Errors []*ReconcileError `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty"`
 	// affected_paths lists all the file artifact paths that were considered while
-	// executing the reconciliation. If changed_paths was empty, this will include all
-	// code artifacts in the repo.
+	// executing the reconciliation
 	AffectedPaths []string `protobuf:"bytes,2,rep,name=affected_paths,json=affectedPaths,proto3" json:"affected_paths,omitempty"`
 }
 
-func (x *DeleteFileAndReconcileResponse) Reset() {
-	*x = DeleteFileAndReconcileResponse{}
+func (x *RestoreCatalogEntryVersionResponse) Reset() {
+	*x = RestoreCatalogEntryVersionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[36]
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteFileAndReconcileResponse) String() string {
+func (x *RestoreCatalogEntryVersionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteFileAndReconcileResponse) ProtoMessage() {}
+func (*RestoreCatalogEntryVersionResponse) ProtoMessage() {}
 
-func (x *DeleteFileAndReconcileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[36]
+func (x *RestoreCatalogEntryVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2615,61 +2950,52 @@ func (x *DeleteFileAndReconcileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteFileAndReconcileResponse.ProtoReflect.Descriptor instead.
-func (*DeleteFileAndReconcileResponse) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use RestoreCatalogEntryVersionResponse.ProtoReflect.Descriptor instead.
+func (*RestoreCatalogEntryVersionResponse) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *DeleteFileAndReconcileResponse) GetErrors() []*ReconcileError {
+func (x *RestoreCatalogEntryVersionResponse) GetErrors() []*ReconcileError {
 	if x != nil {
 		return x.Errors
 	}
 	return nil
 }
 
-func (x *DeleteFileAndReconcileResponse) GetAffectedPaths() []string {
+func (x *RestoreCatalogEntryVersionResponse) GetAffectedPaths() []string {
 	if x != nil {
 		return x.AffectedPaths
 	}
 	return nil
 }
 
-type RenameFileAndReconcileRequest struct {
+// Request message for RuntimeService.TriggerRefresh
+type TriggerRefreshRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Instance to store file in and reconcile
 	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
-	// Current path of the file
-	FromPath string `protobuf:"bytes,2,opt,name=from_path,json=fromPath,proto3" json:"from_path,omitempty"`
-	// New path of the file
-	ToPath string `protobuf:"bytes,3,opt,name=to_path,json=toPath,proto3" json:"to_path,omitempty"`
-	// If true, will save the file and validate it and related file artifacts, but not actually execute any migrations.
-	Dry bool `protobuf:"varint,4,opt,name=dry,proto3" json:"dry,omitempty"`
-	// If true, will not execute any migrations if any artifact fails to validate.
-	// Otherwise, it will execute a best-effort reconciliation (including dropping objects with
-	// artifacts that fail to validate.)
-	Strict bool `protobuf:"varint,5,opt,name=strict,proto3" json:"strict,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *RenameFileAndReconcileRequest) Reset() {
-	*x = RenameFileAndReconcileRequest{}
+func (x *TriggerRefreshRequest) Reset() {
+	*x = TriggerRefreshRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[37]
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RenameFileAndReconcileRequest) String() string {
+func (x *TriggerRefreshRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RenameFileAndReconcileRequest) ProtoMessage() {}
+func (*TriggerRefreshRequest) ProtoMessage() {}
 
-func (x *RenameFileAndReconcileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[37]
+func (x *TriggerRefreshRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2680,77 +3006,141 @@ func (x *RenameFileAndReconcileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RenameFileAndReconcileRequest.ProtoReflect.Descriptor instead.
-func (*RenameFileAndReconcileRequest) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use TriggerRefreshRequest.ProtoReflect.Descriptor instead.
+func (*TriggerRefreshRequest) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *RenameFileAndReconcileRequest) GetInstanceId() string {
+func (x *TriggerRefreshRequest) GetInstanceId() string {
 	if x != nil {
 		return x.InstanceId
 	}
 	return ""
 }
 
-func (x *RenameFileAndReconcileRequest) GetFromPath() string {
-	if x != nil {
-		return x.FromPath
-	}
-	return ""
-}
-
-func (x *RenameFileAndReconcileRequest) GetToPath() string {
+func (x *TriggerRefreshRequest) GetName() string {
 	if x != nil {
-		return x.ToPath
+		return x.Name
 	}
 	return ""
 }
 
-func (x *RenameFileAndReconcileRequest) GetDry() bool {
-	if x != nil {
-		return x.Dry
+// Response message for RuntimeService.TriggerRefresh
+type TriggerRefreshResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TriggerRefreshResponse) Reset() {
+	*x = TriggerRefreshResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-func (x *RenameFileAndReconcileRequest) GetStrict() bool {
+func (x *TriggerRefreshResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerRefreshResponse) ProtoMessage() {}
+
+func (x *TriggerRefreshResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerRefreshResponse.ProtoReflect.Descriptor instead.
+func (*TriggerRefreshResponse) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{39}
+}
+
+// Request message for RuntimeService.TriggerSync
+type TriggerSyncRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+}
+
+func (x *TriggerSyncRequest) Reset() {
+	*x = TriggerSyncRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TriggerSyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerSyncRequest) ProtoMessage() {}
+
+func (x *TriggerSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerSyncRequest.ProtoReflect.Descriptor instead.
+func (*TriggerSyncRequest) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *TriggerSyncRequest) GetInstanceId() string {
 	if x != nil {
-		return x.Strict
+		return x.InstanceId
 	}
-	return false
+	return ""
 }
 
-type RenameFileAndReconcileResponse struct {
+// Response message for RuntimeService.TriggerSync
+type TriggerSyncResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Errors encountered during reconciliation. If strict = false, any path in
-	// affected_paths without an error can be assumed to have been reconciled succesfully.
-	Errors []*ReconcileError `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty"`
-	// affected_paths lists all the file artifact paths that were considered while
-	// executing the reconciliation. If changed_paths was empty, this will include all
-	// code artifacts in the repo.
-	AffectedPaths []string `protobuf:"bytes,2,rep,name=affected_paths,json=affectedPaths,proto3" json:"affected_paths,omitempty"`
+	ObjectsCount        uint32 `protobuf:"varint,1,opt,name=objects_count,json=objectsCount,proto3" json:"objects_count,omitempty"`
+	ObjectsAddedCount   uint32 `protobuf:"varint,2,opt,name=objects_added_count,json=objectsAddedCount,proto3" json:"objects_added_count,omitempty"`
+	ObjectsUpdatedCount uint32 `protobuf:"varint,3,opt,name=objects_updated_count,json=objectsUpdatedCount,proto3" json:"objects_updated_count,omitempty"`
+	ObjectsRemovedCount uint32 `protobuf:"varint,4,opt,name=objects_removed_count,json=objectsRemovedCount,proto3" json:"objects_removed_count,omitempty"`
 }
 
-func (x *RenameFileAndReconcileResponse) Reset() {
-	*x = RenameFileAndReconcileResponse{}
+func (x *TriggerSyncResponse) Reset() {
+	*x = TriggerSyncResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[38]
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RenameFileAndReconcileResponse) String() string {
+func (x *TriggerSyncResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RenameFileAndReconcileResponse) ProtoMessage() {}
+func (*TriggerSyncResponse) ProtoMessage() {}
 
-func (x *RenameFileAndReconcileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[38]
+func (x *TriggerSyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2761,59 +3151,78 @@ func (x *RenameFileAndReconcileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RenameFileAndReconcileResponse.ProtoReflect.Descriptor instead.
-func (*RenameFileAndReconcileResponse) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use TriggerSyncResponse.ProtoReflect.Descriptor instead.
+func (*TriggerSyncResponse) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *RenameFileAndReconcileResponse) GetErrors() []*ReconcileError {
+func (x *TriggerSyncResponse) GetObjectsCount() uint32 {
 	if x != nil {
-		return x.Errors
+		return x.ObjectsCount
 	}
-	return nil
+	return 0
 }
 
-func (x *RenameFileAndReconcileResponse) GetAffectedPaths() []string {
+func (x *TriggerSyncResponse) GetObjectsAddedCount() uint32 {
 	if x != nil {
-		return x.AffectedPaths
+		return x.ObjectsAddedCount
 	}
-	return nil
+	return 0
 }
 
-type RefreshAndReconcileRequest struct {
+func (x *TriggerSyncResponse) GetObjectsUpdatedCount() uint32 {
+	if x != nil {
+		return x.ObjectsUpdatedCount
+	}
+	return 0
+}
+
+func (x *TriggerSyncResponse) GetObjectsRemovedCount() uint32 {
+	if x != nil {
+		return x.ObjectsRemovedCount
+	}
+	return 0
+}
+
+// Request message for RuntimeService.Reconcile
+type ReconcileRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Instance to store file in and reconcile
+	// Instance to reconcile
 	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
-	// Current path of the file
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	// If true, will save the file and validate it and related file artifacts, but not actually execute any migrations.
-	Dry bool `protobuf:"varint,3,opt,name=dry,proto3" json:"dry,omitempty"`
+	// Changed paths provides a way to "hint" what files have changed in the repo, enabling
+	// reconciliation to execute faster by not scanning all code artifacts for changes.
+	ChangedPaths []string `protobuf:"bytes,2,rep,name=changed_paths,json=changedPaths,proto3" json:"changed_paths,omitempty"`
+	// Forced paths is used to force run reconcile on certain files.
+	// This is mainly used by UI to reconcile paths missing in catalog and get errors if any.
+	ForcedPaths []string `protobuf:"bytes,3,rep,name=forced_paths,json=forcedPaths,proto3" json:"forced_paths,omitempty"`
+	// If true, will validate the file artifacts, but not actually execute any migrations
+	Dry bool `protobuf:"varint,4,opt,name=dry,proto3" json:"dry,omitempty"`
 	// If true, will not execute any migrations if any artifact fails to validate.
 	// Otherwise, it will execute a best-effort reconciliation (including dropping objects with
 	// artifacts that fail to validate.)
 	Strict bool `protobuf:"varint,5,opt,name=strict,proto3" json:"strict,omitempty"`
 }
 
-func (x *RefreshAndReconcileRequest) Reset() {
-	*x = RefreshAndReconcileRequest{}
+func (x *ReconcileRequest) Reset() {
+	*x = ReconcileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[39]
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RefreshAndReconcileRequest) String() string {
+func (x *ReconcileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshAndReconcileRequest) ProtoMessage() {}
+func (*ReconcileRequest) ProtoMessage() {}
 
-func (x *RefreshAndReconcileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[39]
+func (x *ReconcileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2824,40 +3233,48 @@ func (x *RefreshAndReconcileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefreshAndReconcileRequest.ProtoReflect.Descriptor instead.
-func (*RefreshAndReconcileRequest) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use ReconcileRequest.ProtoReflect.Descriptor instead.
+func (*ReconcileRequest) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *RefreshAndReconcileRequest) GetInstanceId() string {
+func (x *ReconcileRequest) GetInstanceId() string {
 	if x != nil {
 		return x.InstanceId
 	}
 	return ""
 }
 
-func (x *RefreshAndReconcileRequest) GetPath() string {
+func (x *ReconcileRequest) GetChangedPaths() []string {
 	if x != nil {
-		return x.Path
+		return x.ChangedPaths
 	}
-	return ""
+	return nil
 }
 
-func (x *RefreshAndReconcileRequest) GetDry() bool {
+func (x *ReconcileRequest) GetForcedPaths() []string {
+	if x != nil {
+		return x.ForcedPaths
+	}
+	return nil
+}
+
+func (x *ReconcileRequest) GetDry() bool {
 	if x != nil {
 		return x.Dry
 	}
 	return false
 }
 
-func (x *RefreshAndReconcileRequest) GetStrict() bool {
+func (x *ReconcileRequest) GetStrict() bool {
 	if x != nil {
 		return x.Strict
 	}
 	return false
 }
 
-type RefreshAndReconcileResponse struct {
+// Response message for RuntimeService.Reconcile
+type ReconcileResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -2871,23 +3288,23 @@ type RefreshAndReconcileResponse struct {
 	AffectedPaths []string `protobuf:"bytes,2,rep,name=affected_paths,json=affectedPaths,proto3" json:"affected_paths,omitempty"`
 }
 
-func (x *RefreshAndReconcileResponse) Reset() {
-	*x = RefreshAndReconcileResponse{}
+func (x *ReconcileResponse) Reset() {
+	*x = ReconcileResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[40]
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RefreshAndReconcileResponse) String() string {
+func (x *ReconcileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshAndReconcileResponse) ProtoMessage() {}
+func (*ReconcileResponse) ProtoMessage() {}
 
-func (x *RefreshAndReconcileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[40]
+func (x *ReconcileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2898,60 +3315,54 @@ func (x *RefreshAndReconcileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefreshAndReconcileResponse.ProtoReflect.Descriptor instead.
-func (*RefreshAndReconcileResponse) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use ReconcileResponse.ProtoReflect.Descriptor instead.
+func (*ReconcileResponse) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *RefreshAndReconcileResponse) GetErrors() []*ReconcileError {
+func (x *ReconcileResponse) GetErrors() []*ReconcileError {
 	if x != nil {
 		return x.Errors
 	}
 	return nil
 }
 
-func (x *RefreshAndReconcileResponse) GetAffectedPaths() []string {
+func (x *ReconcileResponse) GetAffectedPaths() []string {
 	if x != nil {
 		return x.AffectedPaths
 	}
 	return nil
 }
 
-// Request message for RuntimeService.Query
-type QueryRequest struct {
+// Response message for RuntimeService.ReconcileStream
+type ReconcileStreamResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Instance to query
-	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
-	// SELECT statement
-	Sql string `protobuf:"bytes,2,opt,name=sql,proto3" json:"sql,omitempty"`
-	// Args to interpolate into the statement
-	Args []*structpb.Value `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
-	// Query priority (not supported by all backends)
-	Priority int32 `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
-	// If true, will only validate the query, not execute it
-	DryRun bool `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Types that are assignable to Response:
+	//	*ReconcileStreamResponse_Event
+	//	*ReconcileStreamResponse_Result
+	Response isReconcileStreamResponse_Response `protobuf_oneof:"response"`
 }
 
-func (x *QueryRequest) Reset() {
-	*x = QueryRequest{}
+func (x *ReconcileStreamResponse) Reset() {
+	*x = ReconcileStreamResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[41]
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryRequest) String() string {
+func (x *ReconcileStreamResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryRequest) ProtoMessage() {}
+func (*ReconcileStreamResponse) ProtoMessage() {}
 
-func (x *QueryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[41]
+func (x *ReconcileStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2962,140 +3373,85 @@ func (x *QueryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryRequest.ProtoReflect.Descriptor instead.
-func (*QueryRequest) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use ReconcileStreamResponse.ProtoReflect.Descriptor instead.
+func (*ReconcileStreamResponse) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *QueryRequest) GetInstanceId() string {
-	if x != nil {
-		return x.InstanceId
-	}
-	return ""
-}
-
-func (x *QueryRequest) GetSql() string {
-	if x != nil {
-		return x.Sql
-	}
-	return ""
-}
-
-func (x *QueryRequest) GetArgs() []*structpb.Value {
-	if x != nil {
-		return x.Args
+func (m *ReconcileStreamResponse) GetResponse() isReconcileStreamResponse_Response {
+	if m != nil {
+		return m.Response
 	}
 	return nil
 }
 
-func (x *QueryRequest) GetPriority() int32 {
-	if x != nil {
-		return x.Priority
-	}
-	return 0
-}
-
-func (x *QueryRequest) GetDryRun() bool {
-	if x != nil {
-		return x.DryRun
+func (x *ReconcileStreamResponse) GetEvent() *ReconcileEvent {
+	if x, ok := x.GetResponse().(*ReconcileStreamResponse_Event); ok {
+		return x.Event
 	}
-	return false
-}
-
-// Response message for RuntimeService.Query
-type QueryResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Schema of result data
-	Meta *StructType `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
-	// Result data
-	Data []*structpb.Struct `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	return nil
 }
 
-func (x *QueryResponse) Reset() {
-	*x = QueryResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[42]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ReconcileStreamResponse) GetResult() *ReconcileResponse {
+	if x, ok := x.GetResponse().(*ReconcileStreamResponse_Result); ok {
+		return x.Result
 	}
+	return nil
 }
 
-func (x *QueryResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type isReconcileStreamResponse_Response interface {
+	isReconcileStreamResponse_Response()
 }
 
-func (*QueryResponse) ProtoMessage() {}
-
-func (x *QueryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[42]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+type ReconcileStreamResponse_Event struct {
+	// Progress event for a migration item
+	Event *ReconcileEvent `protobuf:"bytes,1,opt,name=event,proto3,oneof"`
 }
 
-// Deprecated: Use QueryResponse.ProtoReflect.Descriptor instead.
-func (*QueryResponse) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{42}
+type ReconcileStreamResponse_Result struct {
+	// Result of the reconcile. It's only set on the last message.
+	Result *ReconcileResponse `protobuf:"bytes,2,opt,name=result,proto3,oneof"`
 }
 
-func (x *QueryResponse) GetMeta() *StructType {
-	if x != nil {
-		return x.Meta
-	}
-	return nil
-}
+func (*ReconcileStreamResponse_Event) isReconcileStreamResponse_Response() {}
 
-func (x *QueryResponse) GetData() []*structpb.Struct {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
+func (*ReconcileStreamResponse_Result) isReconcileStreamResponse_Response() {}
 
-// Request message for RuntimeService.MetricsViewToplist
-type MetricsViewToplistRequest struct {
+// ReconcileEvent reports the progress of a single migration item during a reconcile.
+// In a dry run, the events describe the migrations that would have run.
+type ReconcileEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InstanceId      string                 `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
-	MetricsViewName string                 `protobuf:"bytes,2,opt,name=metrics_view_name,json=metricsViewName,proto3" json:"metrics_view_name,omitempty"`
-	DimensionName   string                 `protobuf:"bytes,3,opt,name=dimension_name,json=dimensionName,proto3" json:"dimension_name,omitempty"`
-	MeasureNames    []string               `protobuf:"bytes,4,rep,name=measure_names,json=measureNames,proto3" json:"measure_names,omitempty"`
-	TimeStart       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=time_start,json=timeStart,proto3" json:"time_start,omitempty"`
-	TimeEnd         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=time_end,json=timeEnd,proto3" json:"time_end,omitempty"`
-	Limit           int64                  `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset          int64                  `protobuf:"varint,8,opt,name=offset,proto3" json:"offset,omitempty"`
-	Sort            []*MetricsViewSort     `protobuf:"bytes,9,rep,name=sort,proto3" json:"sort,omitempty"`
-	Filter          *MetricsViewFilter     `protobuf:"bytes,10,opt,name=filter,proto3" json:"filter,omitempty"`
-	Priority        int32                  `protobuf:"varint,11,opt,name=priority,proto3" json:"priority,omitempty"`
+	Type ReconcileEvent_Type `protobuf:"varint,1,opt,name=type,proto3,enum=rill.runtime.v1.ReconcileEvent_Type" json:"type,omitempty"`
+	// Name of the catalog object
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Path of the code artifact
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// Time it took to reconcile the item. Only set for events that finish an item.
+	DurationMs int64 `protobuf:"varint,4,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	// Errors encountered while reconciling the item. Only set for TYPE_ERRORED.
+	Errors []*ReconcileError `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`
 }
 
-func (x *MetricsViewToplistRequest) Reset() {
-	*x = MetricsViewToplistRequest{}
+func (x *ReconcileEvent) Reset() {
+	*x = ReconcileEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[43]
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MetricsViewToplistRequest) String() string {
+func (x *ReconcileEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MetricsViewToplistRequest) ProtoMessage() {}
+func (*ReconcileEvent) ProtoMessage() {}
 
-func (x *MetricsViewToplistRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[43]
+func (x *ReconcileEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3106,115 +3462,122 @@ func (x *MetricsViewToplistRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MetricsViewToplistRequest.ProtoReflect.Descriptor instead.
-func (*MetricsViewToplistRequest) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use ReconcileEvent.ProtoReflect.Descriptor instead.
+func (*ReconcileEvent) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *MetricsViewToplistRequest) GetInstanceId() string {
+func (x *ReconcileEvent) GetType() ReconcileEvent_Type {
 	if x != nil {
-		return x.InstanceId
+		return x.Type
 	}
-	return ""
+	return ReconcileEvent_TYPE_UNSPECIFIED
 }
 
-func (x *MetricsViewToplistRequest) GetMetricsViewName() string {
+func (x *ReconcileEvent) GetName() string {
 	if x != nil {
-		return x.MetricsViewName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *MetricsViewToplistRequest) GetDimensionName() string {
+func (x *ReconcileEvent) GetPath() string {
 	if x != nil {
-		return x.DimensionName
+		return x.Path
 	}
 	return ""
 }
 
-func (x *MetricsViewToplistRequest) GetMeasureNames() []string {
+func (x *ReconcileEvent) GetDurationMs() int64 {
 	if x != nil {
-		return x.MeasureNames
+		return x.DurationMs
 	}
-	return nil
+	return 0
 }
 
-func (x *MetricsViewToplistRequest) GetTimeStart() *timestamppb.Timestamp {
+func (x *ReconcileEvent) GetErrors() []*ReconcileError {
 	if x != nil {
-		return x.TimeStart
+		return x.Errors
 	}
 	return nil
 }
 
-func (x *MetricsViewToplistRequest) GetTimeEnd() *timestamppb.Timestamp {
-	if x != nil {
-		return x.TimeEnd
-	}
-	return nil
+// Request message for RuntimeService.WatchReconcile
+type WatchReconcileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
 }
 
-func (x *MetricsViewToplistRequest) GetLimit() int64 {
-	if x != nil {
-		return x.Limit
+func (x *WatchReconcileRequest) Reset() {
+	*x = WatchReconcileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *MetricsViewToplistRequest) GetOffset() int64 {
-	if x != nil {
-		return x.Offset
-	}
-	return 0
+func (x *WatchReconcileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *MetricsViewToplistRequest) GetSort() []*MetricsViewSort {
-	if x != nil {
-		return x.Sort
+func (*WatchReconcileRequest) ProtoMessage() {}
+
+func (x *WatchReconcileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *MetricsViewToplistRequest) GetFilter() *MetricsViewFilter {
-	if x != nil {
-		return x.Filter
-	}
-	return nil
+// Deprecated: Use WatchReconcileRequest.ProtoReflect.Descriptor instead.
+func (*WatchReconcileRequest) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *MetricsViewToplistRequest) GetPriority() int32 {
+func (x *WatchReconcileRequest) GetInstanceId() string {
 	if x != nil {
-		return x.Priority
+		return x.InstanceId
 	}
-	return 0
+	return ""
 }
 
-// Response message for RuntimeService.MetricsViewToplist
-type MetricsViewToplistResponse struct {
+// Response message for RuntimeService.WatchReconcile.
+// See message ReconcileResponse for field descriptions.
+type WatchReconcileResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Meta []*MetricsViewColumn `protobuf:"bytes,1,rep,name=meta,proto3" json:"meta,omitempty"`
-	Data []*structpb.Struct   `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	Errors        []*ReconcileError `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty"`
+	AffectedPaths []string          `protobuf:"bytes,2,rep,name=affected_paths,json=affectedPaths,proto3" json:"affected_paths,omitempty"`
 }
 
-func (x *MetricsViewToplistResponse) Reset() {
-	*x = MetricsViewToplistResponse{}
+func (x *WatchReconcileResponse) Reset() {
+	*x = WatchReconcileResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[44]
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MetricsViewToplistResponse) String() string {
+func (x *WatchReconcileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MetricsViewToplistResponse) ProtoMessage() {}
+func (*WatchReconcileResponse) ProtoMessage() {}
 
-func (x *MetricsViewToplistResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[44]
+func (x *WatchReconcileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3225,58 +3588,67 @@ func (x *MetricsViewToplistResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MetricsViewToplistResponse.ProtoReflect.Descriptor instead.
-func (*MetricsViewToplistResponse) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use WatchReconcileResponse.ProtoReflect.Descriptor instead.
+func (*WatchReconcileResponse) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *MetricsViewToplistResponse) GetMeta() []*MetricsViewColumn {
+func (x *WatchReconcileResponse) GetErrors() []*ReconcileError {
 	if x != nil {
-		return x.Meta
+		return x.Errors
 	}
 	return nil
 }
 
-func (x *MetricsViewToplistResponse) GetData() []*structpb.Struct {
+func (x *WatchReconcileResponse) GetAffectedPaths() []string {
 	if x != nil {
-		return x.Data
+		return x.AffectedPaths
 	}
 	return nil
 }
 
-// Request message for RuntimeService.MetricsViewTimeSeries
-type MetricsViewTimeSeriesRequest struct {
+// ReconcileError represents an error encountered while running Reconcile.
+type ReconcileError struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InstanceId      string                 `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
-	MetricsViewName string                 `protobuf:"bytes,2,opt,name=metrics_view_name,json=metricsViewName,proto3" json:"metrics_view_name,omitempty"`
-	MeasureNames    []string               `protobuf:"bytes,3,rep,name=measure_names,json=measureNames,proto3" json:"measure_names,omitempty"`
-	TimeStart       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=time_start,json=timeStart,proto3" json:"time_start,omitempty"`
-	TimeEnd         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=time_end,json=timeEnd,proto3" json:"time_end,omitempty"`
-	TimeGranularity string                 `protobuf:"bytes,6,opt,name=time_granularity,json=timeGranularity,proto3" json:"time_granularity,omitempty"`
-	Filter          *MetricsViewFilter     `protobuf:"bytes,7,opt,name=filter,proto3" json:"filter,omitempty"`
-	Priority        int32                  `protobuf:"varint,8,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Code denotes the category of error
+	Code ReconcileError_Code `protobuf:"varint,1,opt,name=code,proto3,enum=rill.runtime.v1.ReconcileError_Code" json:"code,omitempty"`
+	// Message is a developer-friendly error message
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// File path of the code artifact that this error relates to (if any)
+	FilePath string `protobuf:"bytes,3,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
+	// Property path of the error in the code artifact (if any).
+	// It's represented as a JS-style property path, e.g. "key0.key1[index2].key3".
+	// It only applies to structured code artifacts (i.e. YAML).
+	// Only applicable if file_path is set.
+	PropertyPath []string `protobuf:"bytes,4,rep,name=property_path,json=propertyPath,proto3" json:"property_path,omitempty"`
+	// Start location of the error in the code artifact (if any).
+	// Only applicable if file_path is set.
+	StartLocation *ReconcileError_CharLocation `protobuf:"bytes,5,opt,name=start_location,json=startLocation,proto3" json:"start_location,omitempty"`
+	// End location of the error in the code artifact (if any).
+	// Only applicable if file_path and start_location is set.
+	EndLocation *ReconcileError_CharLocation `protobuf:"bytes,6,opt,name=end_location,json=endLocation,proto3" json:"end_location,omitempty"`
 }
 
-func (x *MetricsViewTimeSeriesRequest) Reset() {
-	*x = MetricsViewTimeSeriesRequest{}
+func (x *ReconcileError) Reset() {
+	*x = ReconcileError{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[45]
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MetricsViewTimeSeriesRequest) String() string {
+func (x *ReconcileError) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MetricsViewTimeSeriesRequest) ProtoMessage() {}
+func (*ReconcileError) ProtoMessage() {}
 
-func (x *MetricsViewTimeSeriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[45]
+func (x *ReconcileError) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3287,94 +3659,97 @@ func (x *MetricsViewTimeSeriesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MetricsViewTimeSeriesRequest.ProtoReflect.Descriptor instead.
-func (*MetricsViewTimeSeriesRequest) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use ReconcileError.ProtoReflect.Descriptor instead.
+func (*ReconcileError) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *MetricsViewTimeSeriesRequest) GetInstanceId() string {
+func (x *ReconcileError) GetCode() ReconcileError_Code {
 	if x != nil {
-		return x.InstanceId
+		return x.Code
 	}
-	return ""
+	return ReconcileError_CODE_UNSPECIFIED
 }
 
-func (x *MetricsViewTimeSeriesRequest) GetMetricsViewName() string {
+func (x *ReconcileError) GetMessage() string {
 	if x != nil {
-		return x.MetricsViewName
+		return x.Message
 	}
 	return ""
 }
 
-func (x *MetricsViewTimeSeriesRequest) GetMeasureNames() []string {
+func (x *ReconcileError) GetFilePath() string {
 	if x != nil {
-		return x.MeasureNames
+		return x.FilePath
 	}
-	return nil
+	return ""
 }
 
-func (x *MetricsViewTimeSeriesRequest) GetTimeStart() *timestamppb.Timestamp {
+func (x *ReconcileError) GetPropertyPath() []string {
 	if x != nil {
-		return x.TimeStart
+		return x.PropertyPath
 	}
 	return nil
 }
 
-func (x *MetricsViewTimeSeriesRequest) GetTimeEnd() *timestamppb.Timestamp {
+func (x *ReconcileError) GetStartLocation() *ReconcileError_CharLocation {
 	if x != nil {
-		return x.TimeEnd
+		return x.StartLocation
 	}
 	return nil
 }
 
-func (x *MetricsViewTimeSeriesRequest) GetTimeGranularity() string {
-	if x != nil {
-		return x.TimeGranularity
-	}
-	return ""
-}
-
-func (x *MetricsViewTimeSeriesRequest) GetFilter() *MetricsViewFilter {
+func (x *ReconcileError) GetEndLocation() *ReconcileError_CharLocation {
 	if x != nil {
-		return x.Filter
+		return x.EndLocation
 	}
 	return nil
 }
 
-func (x *MetricsViewTimeSeriesRequest) GetPriority() int32 {
-	if x != nil {
-		return x.Priority
-	}
-	return 0
-}
-
-// Response message for RuntimeService.MetricsViewTimeSeries
-type MetricsViewTimeSeriesResponse struct {
+type PutFileAndReconcileRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Meta []*MetricsViewColumn `protobuf:"bytes,1,rep,name=meta,proto3" json:"meta,omitempty"`
-	Data []*structpb.Struct   `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	// Instance to store file in and reconcile
+	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	// Path to store the file at
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// Contents to save to the file
+	Blob string `protobuf:"bytes,3,opt,name=blob,proto3" json:"blob,omitempty"`
+	// Create indicates whether to create the file if it doesn't already exist
+	Create bool `protobuf:"varint,4,opt,name=create,proto3" json:"create,omitempty"`
+	// create_only will cause the operation to fail if a file already exists at path.
+	// It should only be set when create = true.
+	CreateOnly bool `protobuf:"varint,5,opt,name=create_only,json=createOnly,proto3" json:"create_only,omitempty"`
+	// If set, the operation will fail with a conflict if the file's current version
+	// (see GetFileResponse) doesn't match, i.e. if it was changed by someone else.
+	ExpectedVersion string `protobuf:"bytes,8,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	// If true, will save the file and validate it and related file artifacts, but not actually execute any migrations.
+	Dry bool `protobuf:"varint,6,opt,name=dry,proto3" json:"dry,omitempty"`
+	// If true, will not execute any migrations if any artifact fails to validate.
+	// Otherwise, it will execute a best-effort reconciliation (including dropping objects with
+	// artifacts that fail to validate.)
+	Strict bool `protobuf:"varint,7,opt,name=strict,proto3" json:"strict,omitempty"`
 }
 
-func (x *MetricsViewTimeSeriesResponse) Reset() {
-	*x = MetricsViewTimeSeriesResponse{}
+func (x *PutFileAndReconcileRequest) Reset() {
+	*x = PutFileAndReconcileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[46]
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MetricsViewTimeSeriesResponse) String() string {
+func (x *PutFileAndReconcileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MetricsViewTimeSeriesResponse) ProtoMessage() {}
+func (*PutFileAndReconcileRequest) ProtoMessage() {}
 
-func (x *MetricsViewTimeSeriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[46]
+func (x *PutFileAndReconcileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3385,148 +3760,98 @@ func (x *MetricsViewTimeSeriesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MetricsViewTimeSeriesResponse.ProtoReflect.Descriptor instead.
-func (*MetricsViewTimeSeriesResponse) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{46}
-}
-
-func (x *MetricsViewTimeSeriesResponse) GetMeta() []*MetricsViewColumn {
-	if x != nil {
-		return x.Meta
-	}
-	return nil
+// Deprecated: Use PutFileAndReconcileRequest.ProtoReflect.Descriptor instead.
+func (*PutFileAndReconcileRequest) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *MetricsViewTimeSeriesResponse) GetData() []*structpb.Struct {
+func (x *PutFileAndReconcileRequest) GetInstanceId() string {
 	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-// Request message for RuntimeService.MetricsViewTotals
-type MetricsViewTotalsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	InstanceId      string                 `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
-	MetricsViewName string                 `protobuf:"bytes,2,opt,name=metrics_view_name,json=metricsViewName,proto3" json:"metrics_view_name,omitempty"`
-	MeasureNames    []string               `protobuf:"bytes,3,rep,name=measure_names,json=measureNames,proto3" json:"measure_names,omitempty"`
-	TimeStart       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=time_start,json=timeStart,proto3" json:"time_start,omitempty"`
-	TimeEnd         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=time_end,json=timeEnd,proto3" json:"time_end,omitempty"`
-	Filter          *MetricsViewFilter     `protobuf:"bytes,7,opt,name=filter,proto3" json:"filter,omitempty"`
-	Priority        int32                  `protobuf:"varint,8,opt,name=priority,proto3" json:"priority,omitempty"`
-}
-
-func (x *MetricsViewTotalsRequest) Reset() {
-	*x = MetricsViewTotalsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[47]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *MetricsViewTotalsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*MetricsViewTotalsRequest) ProtoMessage() {}
-
-func (x *MetricsViewTotalsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[47]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.InstanceId
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use MetricsViewTotalsRequest.ProtoReflect.Descriptor instead.
-func (*MetricsViewTotalsRequest) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{47}
+	return ""
 }
 
-func (x *MetricsViewTotalsRequest) GetInstanceId() string {
+func (x *PutFileAndReconcileRequest) GetPath() string {
 	if x != nil {
-		return x.InstanceId
+		return x.Path
 	}
 	return ""
 }
 
-func (x *MetricsViewTotalsRequest) GetMetricsViewName() string {
+func (x *PutFileAndReconcileRequest) GetBlob() string {
 	if x != nil {
-		return x.MetricsViewName
+		return x.Blob
 	}
 	return ""
 }
 
-func (x *MetricsViewTotalsRequest) GetMeasureNames() []string {
+func (x *PutFileAndReconcileRequest) GetCreate() bool {
 	if x != nil {
-		return x.MeasureNames
+		return x.Create
 	}
-	return nil
+	return false
 }
 
-func (x *MetricsViewTotalsRequest) GetTimeStart() *timestamppb.Timestamp {
+func (x *PutFileAndReconcileRequest) GetCreateOnly() bool {
 	if x != nil {
-		return x.TimeStart
+		return x.CreateOnly
 	}
-	return nil
+	return false
 }
 
-func (x *MetricsViewTotalsRequest) GetTimeEnd() *timestamppb.Timestamp {
+func (x *PutFileAndReconcileRequest) GetExpectedVersion() string {
 	if x != nil {
-		return x.TimeEnd
+		return x.ExpectedVersion
 	}
-	return nil
+	return ""
 }
 
-func (x *MetricsViewTotalsRequest) GetFilter() *MetricsViewFilter {
+func (x *PutFileAndReconcileRequest) GetDry() bool {
 	if x != nil {
-		return x.Filter
+		return x.Dry
 	}
-	return nil
+	return false
 }
 
-func (x *MetricsViewTotalsRequest) GetPriority() int32 {
+func (x *PutFileAndReconcileRequest) GetStrict() bool {
 	if x != nil {
-		return x.Priority
+		return x.Strict
 	}
-	return 0
+	return false
 }
 
-// Response message for RuntimeService.MetricsViewTotals
-type MetricsViewTotalsResponse struct {
+type PutFileAndReconcileResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Meta []*MetricsViewColumn `protobuf:"bytes,1,rep,name=meta,proto3" json:"meta,omitempty"`
-	Data *structpb.Struct     `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// Errors encountered during reconciliation. If strict = false, any path in
+	// affected_paths without an error can be assumed to have been reconciled succesfully.
+	Errors []*ReconcileError `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty"`
+	// affected_paths lists all the file artifact paths that were considered while
+	// executing the reconciliation. If changed_paths was empty, this will include all
+	// code artifacts in the repo.
+	AffectedPaths []string `protobuf:"bytes,2,rep,name=affected_paths,json=affectedPaths,proto3" json:"affected_paths,omitempty"`
 }
 
-func (x *MetricsViewTotalsResponse) Reset() {
-	*x = MetricsViewTotalsResponse{}
+func (x *PutFileAndReconcileResponse) Reset() {
+	*x = PutFileAndReconcileResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[48]
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MetricsViewTotalsResponse) String() string {
+func (x *PutFileAndReconcileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MetricsViewTotalsResponse) ProtoMessage() {}
+func (*PutFileAndReconcileResponse) ProtoMessage() {}
 
-func (x *MetricsViewTotalsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[48]
+func (x *PutFileAndReconcileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3537,52 +3862,59 @@ func (x *MetricsViewTotalsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MetricsViewTotalsResponse.ProtoReflect.Descriptor instead.
-func (*MetricsViewTotalsResponse) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use PutFileAndReconcileResponse.ProtoReflect.Descriptor instead.
+func (*PutFileAndReconcileResponse) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *MetricsViewTotalsResponse) GetMeta() []*MetricsViewColumn {
+func (x *PutFileAndReconcileResponse) GetErrors() []*ReconcileError {
 	if x != nil {
-		return x.Meta
+		return x.Errors
 	}
 	return nil
 }
 
-func (x *MetricsViewTotalsResponse) GetData() *structpb.Struct {
+func (x *PutFileAndReconcileResponse) GetAffectedPaths() []string {
 	if x != nil {
-		return x.Data
+		return x.AffectedPaths
 	}
 	return nil
 }
 
-// Sort clause for metrics view requests
-type MetricsViewSort struct {
+type DeleteFileAndReconcileRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Ascending bool   `protobuf:"varint,2,opt,name=ascending,proto3" json:"ascending,omitempty"`
+	// Instance to store file in and reconcile
+	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	// Path to store the file at
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// If true, will save the file and validate it and related file artifacts, but not actually execute any migrations.
+	Dry bool `protobuf:"varint,3,opt,name=dry,proto3" json:"dry,omitempty"`
+	// If true, will not execute any migrations if any artifact fails to validate.
+	// Otherwise, it will execute a best-effort reconciliation (including dropping objects with
+	// artifacts that fail to validate.)
+	Strict bool `protobuf:"varint,4,opt,name=strict,proto3" json:"strict,omitempty"`
 }
 
-func (x *MetricsViewSort) Reset() {
-	*x = MetricsViewSort{}
+func (x *DeleteFileAndReconcileRequest) Reset() {
+	*x = DeleteFileAndReconcileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[49]
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MetricsViewSort) String() string {
+func (x *DeleteFileAndReconcileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MetricsViewSort) ProtoMessage() {}
+func (*DeleteFileAndReconcileRequest) ProtoMessage() {}
 
-func (x *MetricsViewSort) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[49]
+func (x *DeleteFileAndReconcileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3593,52 +3925,70 @@ func (x *MetricsViewSort) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MetricsViewSort.ProtoReflect.Descriptor instead.
-func (*MetricsViewSort) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use DeleteFileAndReconcileRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFileAndReconcileRequest) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *MetricsViewSort) GetName() string {
+func (x *DeleteFileAndReconcileRequest) GetInstanceId() string {
 	if x != nil {
-		return x.Name
+		return x.InstanceId
 	}
 	return ""
 }
 
-func (x *MetricsViewSort) GetAscending() bool {
+func (x *DeleteFileAndReconcileRequest) GetPath() string {
 	if x != nil {
-		return x.Ascending
+		return x.Path
+	}
+	return ""
+}
+
+func (x *DeleteFileAndReconcileRequest) GetDry() bool {
+	if x != nil {
+		return x.Dry
 	}
 	return false
 }
 
-// Filter clause for metrics view requests
-type MetricsViewFilter struct {
-	state         protoimpl.MessageState
+func (x *DeleteFileAndReconcileRequest) GetStrict() bool {
+	if x != nil {
+		return x.Strict
+	}
+	return false
+}
+
+type DeleteFileAndReconcileResponse struct {
+	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Include []*MetricsViewFilter_Cond `protobuf:"bytes,2,rep,name=include,proto3" json:"include,omitempty"`
-	Exclude []*MetricsViewFilter_Cond `protobuf:"bytes,3,rep,name=exclude,proto3" json:"exclude,omitempty"`
+	// Errors encountered during reconciliation. If strict = false, any path in
+	// affected_paths without an error can be assumed to have been reconciled succesfully.
+	Errors []*ReconcileError `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty"`
+	// affected_paths lists all the file artifact paths that were considered while
+	// executing the reconciliation. If changed_paths was empty, this will include all
+	// code artifacts in the repo.
+	AffectedPaths []string `protobuf:"bytes,2,rep,name=affected_paths,json=affectedPaths,proto3" json:"affected_paths,omitempty"`
 }
 
-func (x *MetricsViewFilter) Reset() {
-	*x = MetricsViewFilter{}
+func (x *DeleteFileAndReconcileResponse) Reset() {
+	*x = DeleteFileAndReconcileResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[50]
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MetricsViewFilter) String() string {
+func (x *DeleteFileAndReconcileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MetricsViewFilter) ProtoMessage() {}
+func (*DeleteFileAndReconcileResponse) ProtoMessage() {}
 
-func (x *MetricsViewFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[50]
+func (x *DeleteFileAndReconcileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3649,53 +3999,61 @@ func (x *MetricsViewFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MetricsViewFilter.ProtoReflect.Descriptor instead.
-func (*MetricsViewFilter) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use DeleteFileAndReconcileResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFileAndReconcileResponse) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *MetricsViewFilter) GetInclude() []*MetricsViewFilter_Cond {
+func (x *DeleteFileAndReconcileResponse) GetErrors() []*ReconcileError {
 	if x != nil {
-		return x.Include
+		return x.Errors
 	}
 	return nil
 }
 
-func (x *MetricsViewFilter) GetExclude() []*MetricsViewFilter_Cond {
+func (x *DeleteFileAndReconcileResponse) GetAffectedPaths() []string {
 	if x != nil {
-		return x.Exclude
+		return x.AffectedPaths
 	}
 	return nil
 }
 
-// MetricsViewColumn represents a column in a metrics view
-type MetricsViewColumn struct {
+type RenameFileAndReconcileRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Type     string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Nullable bool   `protobuf:"varint,3,opt,name=nullable,proto3" json:"nullable,omitempty"`
+	// Instance to store file in and reconcile
+	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	// Current path of the file
+	FromPath string `protobuf:"bytes,2,opt,name=from_path,json=fromPath,proto3" json:"from_path,omitempty"`
+	// New path of the file
+	ToPath string `protobuf:"bytes,3,opt,name=to_path,json=toPath,proto3" json:"to_path,omitempty"`
+	// If true, will save the file and validate it and related file artifacts, but not actually execute any migrations.
+	Dry bool `protobuf:"varint,4,opt,name=dry,proto3" json:"dry,omitempty"`
+	// If true, will not execute any migrations if any artifact fails to validate.
+	// Otherwise, it will execute a best-effort reconciliation (including dropping objects with
+	// artifacts that fail to validate.)
+	Strict bool `protobuf:"varint,5,opt,name=strict,proto3" json:"strict,omitempty"`
 }
 
-func (x *MetricsViewColumn) Reset() {
-	*x = MetricsViewColumn{}
+func (x *RenameFileAndReconcileRequest) Reset() {
+	*x = RenameFileAndReconcileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[51]
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MetricsViewColumn) String() string {
+func (x *RenameFileAndReconcileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MetricsViewColumn) ProtoMessage() {}
+func (*RenameFileAndReconcileRequest) ProtoMessage() {}
 
-func (x *MetricsViewColumn) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[51]
+func (x *RenameFileAndReconcileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3706,60 +4064,77 @@ func (x *MetricsViewColumn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MetricsViewColumn.ProtoReflect.Descriptor instead.
-func (*MetricsViewColumn) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use RenameFileAndReconcileRequest.ProtoReflect.Descriptor instead.
+func (*RenameFileAndReconcileRequest) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *MetricsViewColumn) GetName() string {
+func (x *RenameFileAndReconcileRequest) GetInstanceId() string {
 	if x != nil {
-		return x.Name
+		return x.InstanceId
 	}
 	return ""
 }
 
-func (x *MetricsViewColumn) GetType() string {
+func (x *RenameFileAndReconcileRequest) GetFromPath() string {
 	if x != nil {
-		return x.Type
+		return x.FromPath
 	}
 	return ""
 }
 
-func (x *MetricsViewColumn) GetNullable() bool {
+func (x *RenameFileAndReconcileRequest) GetToPath() string {
 	if x != nil {
-		return x.Nullable
+		return x.ToPath
+	}
+	return ""
+}
+
+func (x *RenameFileAndReconcileRequest) GetDry() bool {
+	if x != nil {
+		return x.Dry
 	}
 	return false
 }
 
-type EstimateRollupIntervalRequest struct {
+func (x *RenameFileAndReconcileRequest) GetStrict() bool {
+	if x != nil {
+		return x.Strict
+	}
+	return false
+}
+
+type RenameFileAndReconcileResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
-	TableName  string `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
-	ColumnName string `protobuf:"bytes,3,opt,name=column_name,json=columnName,proto3" json:"column_name,omitempty"`
-	Priority   int32  `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Errors encountered during reconciliation. If strict = false, any path in
+	// affected_paths without an error can be assumed to have been reconciled succesfully.
+	Errors []*ReconcileError `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty"`
+	// affected_paths lists all the file artifact paths that were considered while
+	// executing the reconciliation. If changed_paths was empty, this will include all
+	// code artifacts in the repo.
+	AffectedPaths []string `protobuf:"bytes,2,rep,name=affected_paths,json=affectedPaths,proto3" json:"affected_paths,omitempty"`
 }
 
-func (x *EstimateRollupIntervalRequest) Reset() {
-	*x = EstimateRollupIntervalRequest{}
+func (x *RenameFileAndReconcileResponse) Reset() {
+	*x = RenameFileAndReconcileResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[52]
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EstimateRollupIntervalRequest) String() string {
+func (x *RenameFileAndReconcileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EstimateRollupIntervalRequest) ProtoMessage() {}
+func (*RenameFileAndReconcileResponse) ProtoMessage() {}
 
-func (x *EstimateRollupIntervalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[52]
+func (x *RenameFileAndReconcileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3770,66 +4145,59 @@ func (x *EstimateRollupIntervalRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EstimateRollupIntervalRequest.ProtoReflect.Descriptor instead.
-func (*EstimateRollupIntervalRequest) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{52}
-}
-
-func (x *EstimateRollupIntervalRequest) GetInstanceId() string {
-	if x != nil {
-		return x.InstanceId
-	}
-	return ""
-}
-
-func (x *EstimateRollupIntervalRequest) GetTableName() string {
-	if x != nil {
-		return x.TableName
-	}
-	return ""
+// Deprecated: Use RenameFileAndReconcileResponse.ProtoReflect.Descriptor instead.
+func (*RenameFileAndReconcileResponse) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *EstimateRollupIntervalRequest) GetColumnName() string {
+func (x *RenameFileAndReconcileResponse) GetErrors() []*ReconcileError {
 	if x != nil {
-		return x.ColumnName
+		return x.Errors
 	}
-	return ""
+	return nil
 }
 
-func (x *EstimateRollupIntervalRequest) GetPriority() int32 {
+func (x *RenameFileAndReconcileResponse) GetAffectedPaths() []string {
 	if x != nil {
-		return x.Priority
+		return x.AffectedPaths
 	}
-	return 0
+	return nil
 }
 
-type EstimateRollupIntervalResponse struct {
+type RefreshAndReconcileRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Start    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
-	End      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
-	Interval TimeGrain              `protobuf:"varint,3,opt,name=interval,proto3,enum=rill.runtime.v1.TimeGrain" json:"interval,omitempty"`
+	// Instance to store file in and reconcile
+	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	// Current path of the file
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// If true, will save the file and validate it and related file artifacts, but not actually execute any migrations.
+	Dry bool `protobuf:"varint,3,opt,name=dry,proto3" json:"dry,omitempty"`
+	// If true, will not execute any migrations if any artifact fails to validate.
+	// Otherwise, it will execute a best-effort reconciliation (including dropping objects with
+	// artifacts that fail to validate.)
+	Strict bool `protobuf:"varint,5,opt,name=strict,proto3" json:"strict,omitempty"`
 }
 
-func (x *EstimateRollupIntervalResponse) Reset() {
-	*x = EstimateRollupIntervalResponse{}
+func (x *RefreshAndReconcileRequest) Reset() {
+	*x = RefreshAndReconcileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[53]
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EstimateRollupIntervalResponse) String() string {
+func (x *RefreshAndReconcileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EstimateRollupIntervalResponse) ProtoMessage() {}
+func (*RefreshAndReconcileRequest) ProtoMessage() {}
 
-func (x *EstimateRollupIntervalResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[53]
+func (x *RefreshAndReconcileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3840,62 +4208,71 @@ func (x *EstimateRollupIntervalResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EstimateRollupIntervalResponse.ProtoReflect.Descriptor instead.
-func (*EstimateRollupIntervalResponse) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use RefreshAndReconcileRequest.ProtoReflect.Descriptor instead.
+func (*RefreshAndReconcileRequest) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *EstimateRollupIntervalResponse) GetStart() *timestamppb.Timestamp {
+func (x *RefreshAndReconcileRequest) GetInstanceId() string {
 	if x != nil {
-		return x.Start
+		return x.InstanceId
 	}
-	return nil
+	return ""
 }
 
-func (x *EstimateRollupIntervalResponse) GetEnd() *timestamppb.Timestamp {
+func (x *RefreshAndReconcileRequest) GetPath() string {
 	if x != nil {
-		return x.End
+		return x.Path
 	}
-	return nil
+	return ""
 }
 
-func (x *EstimateRollupIntervalResponse) GetInterval() TimeGrain {
+func (x *RefreshAndReconcileRequest) GetDry() bool {
 	if x != nil {
-		return x.Interval
+		return x.Dry
 	}
-	return TimeGrain_TIME_GRAIN_UNSPECIFIED
+	return false
 }
 
-type ProfileColumnSummary struct {
+func (x *RefreshAndReconcileRequest) GetStrict() bool {
+	if x != nil {
+		return x.Strict
+	}
+	return false
+}
+
+type RefreshAndReconcileResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Case:
-	//	*ProfileColumnSummary_CategoricalSummary
-	//	*ProfileColumnSummary_NumericSummary
-	//	*ProfileColumnSummary_TimeRangeSummary
-	Case isProfileColumnSummary_Case `protobuf_oneof:"case"`
+	// Errors encountered during reconciliation. If strict = false, any path in
+	// affected_paths without an error can be assumed to have been reconciled succesfully.
+	Errors []*ReconcileError `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty"`
+	// affected_paths lists all the file artifact paths that were considered while
+	// executing the reconciliation. If changed_paths was empty, this will include all
+	// code artifacts in the repo.
+	AffectedPaths []string `protobuf:"bytes,2,rep,name=affected_paths,json=affectedPaths,proto3" json:"affected_paths,omitempty"`
 }
 
-func (x *ProfileColumnSummary) Reset() {
-	*x = ProfileColumnSummary{}
+func (x *RefreshAndReconcileResponse) Reset() {
+	*x = RefreshAndReconcileResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[54]
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProfileColumnSummary) String() string {
+func (x *RefreshAndReconcileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProfileColumnSummary) ProtoMessage() {}
+func (*RefreshAndReconcileResponse) ProtoMessage() {}
 
-func (x *ProfileColumnSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[54]
-	if protoimpl.UnsafeEnabled && x != nil {
+func (x *RefreshAndReconcileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -3905,90 +4282,63 @@ func (x *ProfileColumnSummary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProfileColumnSummary.ProtoReflect.Descriptor instead.
-func (*ProfileColumnSummary) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{54}
-}
-
-func (m *ProfileColumnSummary) GetCase() isProfileColumnSummary_Case {
-	if m != nil {
-		return m.Case
-	}
-	return nil
-}
-
-func (x *ProfileColumnSummary) GetCategoricalSummary() *CategoricalSummary {
-	if x, ok := x.GetCase().(*ProfileColumnSummary_CategoricalSummary); ok {
-		return x.CategoricalSummary
-	}
-	return nil
+// Deprecated: Use RefreshAndReconcileResponse.ProtoReflect.Descriptor instead.
+func (*RefreshAndReconcileResponse) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *ProfileColumnSummary) GetNumericSummary() *NumericSummary {
-	if x, ok := x.GetCase().(*ProfileColumnSummary_NumericSummary); ok {
-		return x.NumericSummary
+func (x *RefreshAndReconcileResponse) GetErrors() []*ReconcileError {
+	if x != nil {
+		return x.Errors
 	}
 	return nil
 }
 
-func (x *ProfileColumnSummary) GetTimeRangeSummary() *TimeRangeSummary {
-	if x, ok := x.GetCase().(*ProfileColumnSummary_TimeRangeSummary); ok {
-		return x.TimeRangeSummary
+func (x *RefreshAndReconcileResponse) GetAffectedPaths() []string {
+	if x != nil {
+		return x.AffectedPaths
 	}
 	return nil
 }
 
-type isProfileColumnSummary_Case interface {
-	isProfileColumnSummary_Case()
-}
-
-type ProfileColumnSummary_CategoricalSummary struct {
-	CategoricalSummary *CategoricalSummary `protobuf:"bytes,1,opt,name=categorical_summary,json=categoricalSummary,proto3,oneof"`
-}
-
-type ProfileColumnSummary_NumericSummary struct {
-	NumericSummary *NumericSummary `protobuf:"bytes,2,opt,name=numeric_summary,json=numericSummary,proto3,oneof"`
-}
-
-type ProfileColumnSummary_TimeRangeSummary struct {
-	TimeRangeSummary *TimeRangeSummary `protobuf:"bytes,3,opt,name=time_range_summary,json=timeRangeSummary,proto3,oneof"`
-}
-
-func (*ProfileColumnSummary_CategoricalSummary) isProfileColumnSummary_Case() {}
-
-func (*ProfileColumnSummary_NumericSummary) isProfileColumnSummary_Case() {}
-
-func (*ProfileColumnSummary_TimeRangeSummary) isProfileColumnSummary_Case() {}
-
-// Response for RuntimeService.GetTopK and RuntimeService.GetCardinalityOfColumn. Message will have either topK or cardinality set.
-type CategoricalSummary struct {
+// Request message for RuntimeService.Query
+type QueryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Case:
-	//	*CategoricalSummary_TopK
-	//	*CategoricalSummary_Cardinality
-	Case isCategoricalSummary_Case `protobuf_oneof:"case"`
+	// Instance to query
+	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	// SELECT statement
+	Sql string `protobuf:"bytes,2,opt,name=sql,proto3" json:"sql,omitempty"`
+	// Args to interpolate into the statement
+	Args []*structpb.Value `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
+	// Query priority (not supported by all backends)
+	Priority int32 `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	// If true, will only validate the query, not execute it.
+	// The response's meta contains the schema the query would return (if it returns rows), and data is empty.
+	DryRun bool `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Timeout for the query (overrides the instance's default if set)
+	TimeoutMs int64 `protobuf:"varint,6,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
 }
 
-func (x *CategoricalSummary) Reset() {
-	*x = CategoricalSummary{}
+func (x *QueryRequest) Reset() {
+	*x = QueryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[55]
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CategoricalSummary) String() string {
+func (x *QueryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CategoricalSummary) ProtoMessage() {}
+func (*QueryRequest) ProtoMessage() {}
 
-func (x *CategoricalSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[55]
+func (x *QueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3999,73 +4349,82 @@ func (x *CategoricalSummary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CategoricalSummary.ProtoReflect.Descriptor instead.
-func (*CategoricalSummary) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use QueryRequest.ProtoReflect.Descriptor instead.
+func (*QueryRequest) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{57}
 }
 
-func (m *CategoricalSummary) GetCase() isCategoricalSummary_Case {
-	if m != nil {
-		return m.Case
+func (x *QueryRequest) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
 	}
-	return nil
+	return ""
 }
 
-func (x *CategoricalSummary) GetTopK() *TopK {
-	if x, ok := x.GetCase().(*CategoricalSummary_TopK); ok {
-		return x.TopK
+func (x *QueryRequest) GetSql() string {
+	if x != nil {
+		return x.Sql
 	}
-	return nil
+	return ""
 }
 
-func (x *CategoricalSummary) GetCardinality() float64 {
-	if x, ok := x.GetCase().(*CategoricalSummary_Cardinality); ok {
-		return x.Cardinality
+func (x *QueryRequest) GetArgs() []*structpb.Value {
+	if x != nil {
+		return x.Args
 	}
-	return 0
+	return nil
 }
 
-type isCategoricalSummary_Case interface {
-	isCategoricalSummary_Case()
+func (x *QueryRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
 }
 
-type CategoricalSummary_TopK struct {
-	TopK *TopK `protobuf:"bytes,1,opt,name=top_k,json=topK,proto3,oneof"`
+func (x *QueryRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
 }
 
-type CategoricalSummary_Cardinality struct {
-	Cardinality float64 `protobuf:"fixed64,2,opt,name=cardinality,proto3,oneof"`
+func (x *QueryRequest) GetTimeoutMs() int64 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
 }
 
-func (*CategoricalSummary_TopK) isCategoricalSummary_Case() {}
-
-func (*CategoricalSummary_Cardinality) isCategoricalSummary_Case() {}
-
-type GetCardinalityOfColumnResponse struct {
+// Response message for RuntimeService.Query
+type QueryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CategoricalSummary *CategoricalSummary `protobuf:"bytes,1,opt,name=categorical_summary,json=categoricalSummary,proto3" json:"categorical_summary,omitempty"`
+	// Schema of result data
+	Meta *StructType `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// Result data
+	Data []*structpb.Struct `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *GetCardinalityOfColumnResponse) Reset() {
-	*x = GetCardinalityOfColumnResponse{}
+func (x *QueryResponse) Reset() {
+	*x = QueryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[56]
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetCardinalityOfColumnResponse) String() string {
+func (x *QueryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCardinalityOfColumnResponse) ProtoMessage() {}
+func (*QueryResponse) ProtoMessage() {}
 
-func (x *GetCardinalityOfColumnResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[56]
+func (x *QueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4076,43 +4435,60 @@ func (x *GetCardinalityOfColumnResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCardinalityOfColumnResponse.ProtoReflect.Descriptor instead.
-func (*GetCardinalityOfColumnResponse) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use QueryResponse.ProtoReflect.Descriptor instead.
+func (*QueryResponse) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *GetCardinalityOfColumnResponse) GetCategoricalSummary() *CategoricalSummary {
+func (x *QueryResponse) GetMeta() *StructType {
 	if x != nil {
-		return x.CategoricalSummary
+		return x.Meta
 	}
 	return nil
 }
 
-type GetTopKResponse struct {
+func (x *QueryResponse) GetData() []*structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Request message for RuntimeService.QueryArrow
+type QueryArrowRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CategoricalSummary *CategoricalSummary `protobuf:"bytes,1,opt,name=categorical_summary,json=categoricalSummary,proto3" json:"categorical_summary,omitempty"`
+	// Instance to query
+	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	// SELECT statement
+	Sql string `protobuf:"bytes,2,opt,name=sql,proto3" json:"sql,omitempty"`
+	// Args to interpolate into the statement
+	Args []*structpb.Value `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
+	// Query priority (not supported by all backends)
+	Priority int32 `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Timeout for the query (overrides the instance's default if set)
+	TimeoutMs int64 `protobuf:"varint,5,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
 }
 
-func (x *GetTopKResponse) Reset() {
-	*x = GetTopKResponse{}
+func (x *QueryArrowRequest) Reset() {
+	*x = QueryArrowRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[57]
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetTopKResponse) String() string {
+func (x *QueryArrowRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTopKResponse) ProtoMessage() {}
+func (*QueryArrowRequest) ProtoMessage() {}
 
-func (x *GetTopKResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[57]
+func (x *QueryArrowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4123,49 +4499,73 @@ func (x *GetTopKResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTopKResponse.ProtoReflect.Descriptor instead.
-func (*GetTopKResponse) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use QueryArrowRequest.ProtoReflect.Descriptor instead.
+func (*QueryArrowRequest) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *GetTopKResponse) GetCategoricalSummary() *CategoricalSummary {
+func (x *QueryArrowRequest) GetInstanceId() string {
 	if x != nil {
-		return x.CategoricalSummary
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *QueryArrowRequest) GetSql() string {
+	if x != nil {
+		return x.Sql
+	}
+	return ""
+}
+
+func (x *QueryArrowRequest) GetArgs() []*structpb.Value {
+	if x != nil {
+		return x.Args
 	}
 	return nil
 }
 
-// Response for RuntimeService.GetNumericHistogram, RuntimeService.GetDescriptiveStatistics and RuntimeService.GetCardinalityOfColumn.
-// Message will have either numericHistogramBins, numericStatistics or numericOutliers set.
-type NumericSummary struct {
+func (x *QueryArrowRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *QueryArrowRequest) GetTimeoutMs() int64 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+// Response message for RuntimeService.QueryArrow
+type QueryArrowResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Case:
-	//	*NumericSummary_NumericHistogramBins
-	//	*NumericSummary_NumericStatistics
-	//	*NumericSummary_NumericOutliers
-	Case isNumericSummary_Case `protobuf_oneof:"case"`
+	// Chunk of the Arrow IPC stream. Each response contains whole IPC messages (the first contains the schema).
+	ArrowIpc []byte `protobuf:"bytes,1,opt,name=arrow_ipc,json=arrowIpc,proto3" json:"arrow_ipc,omitempty"`
 }
 
-func (x *NumericSummary) Reset() {
-	*x = NumericSummary{}
+func (x *QueryArrowResponse) Reset() {
+	*x = QueryArrowResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[58]
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NumericSummary) String() string {
+func (x *QueryArrowResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NumericSummary) ProtoMessage() {}
+func (*QueryArrowResponse) ProtoMessage() {}
 
-func (x *NumericSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[58]
+func (x *QueryArrowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4176,86 +4576,56 @@ func (x *NumericSummary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NumericSummary.ProtoReflect.Descriptor instead.
-func (*NumericSummary) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use QueryArrowResponse.ProtoReflect.Descriptor instead.
+func (*QueryArrowResponse) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{60}
 }
 
-func (m *NumericSummary) GetCase() isNumericSummary_Case {
-	if m != nil {
-		return m.Case
+func (x *QueryArrowResponse) GetArrowIpc() []byte {
+	if x != nil {
+		return x.ArrowIpc
 	}
 	return nil
 }
 
-func (x *NumericSummary) GetNumericHistogramBins() *NumericHistogramBins {
-	if x, ok := x.GetCase().(*NumericSummary_NumericHistogramBins); ok {
-		return x.NumericHistogramBins
-	}
-	return nil
-}
-
-func (x *NumericSummary) GetNumericStatistics() *NumericStatistics {
-	if x, ok := x.GetCase().(*NumericSummary_NumericStatistics); ok {
-		return x.NumericStatistics
-	}
-	return nil
-}
-
-func (x *NumericSummary) GetNumericOutliers() *NumericOutliers {
-	if x, ok := x.GetCase().(*NumericSummary_NumericOutliers); ok {
-		return x.NumericOutliers
-	}
-	return nil
-}
-
-type isNumericSummary_Case interface {
-	isNumericSummary_Case()
-}
-
-type NumericSummary_NumericHistogramBins struct {
-	NumericHistogramBins *NumericHistogramBins `protobuf:"bytes,1,opt,name=numeric_histogram_bins,json=numericHistogramBins,proto3,oneof"`
-}
-
-type NumericSummary_NumericStatistics struct {
-	NumericStatistics *NumericStatistics `protobuf:"bytes,2,opt,name=numeric_statistics,json=numericStatistics,proto3,oneof"`
-}
-
-type NumericSummary_NumericOutliers struct {
-	NumericOutliers *NumericOutliers `protobuf:"bytes,3,opt,name=numeric_outliers,json=numericOutliers,proto3,oneof"`
-}
-
-func (*NumericSummary_NumericHistogramBins) isNumericSummary_Case() {}
-
-func (*NumericSummary_NumericStatistics) isNumericSummary_Case() {}
-
-func (*NumericSummary_NumericOutliers) isNumericSummary_Case() {}
-
-type GetRugHistogramResponse struct {
+// Request message for RuntimeService.MetricsViewToplist
+type MetricsViewToplistRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NumericSummary *NumericSummary `protobuf:"bytes,1,opt,name=numeric_summary,json=numericSummary,proto3" json:"numeric_summary,omitempty"`
+	InstanceId      string                 `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	MetricsViewName string                 `protobuf:"bytes,2,opt,name=metrics_view_name,json=metricsViewName,proto3" json:"metrics_view_name,omitempty"`
+	DimensionName   string                 `protobuf:"bytes,3,opt,name=dimension_name,json=dimensionName,proto3" json:"dimension_name,omitempty"`
+	MeasureNames    []string               `protobuf:"bytes,4,rep,name=measure_names,json=measureNames,proto3" json:"measure_names,omitempty"`
+	TimeStart       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=time_start,json=timeStart,proto3" json:"time_start,omitempty"`
+	TimeEnd         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=time_end,json=timeEnd,proto3" json:"time_end,omitempty"`
+	Limit           int64                  `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset          int64                  `protobuf:"varint,8,opt,name=offset,proto3" json:"offset,omitempty"`
+	Sort            []*MetricsViewSort     `protobuf:"bytes,9,rep,name=sort,proto3" json:"sort,omitempty"`
+	Filter          *MetricsViewFilter     `protobuf:"bytes,10,opt,name=filter,proto3" json:"filter,omitempty"`
+	Priority        int32                  `protobuf:"varint,11,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Timeout for the query (overrides the instance's default if set)
+	TimeoutMs int64 `protobuf:"varint,12,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
 }
 
-func (x *GetRugHistogramResponse) Reset() {
-	*x = GetRugHistogramResponse{}
+func (x *MetricsViewToplistRequest) Reset() {
+	*x = MetricsViewToplistRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[59]
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetRugHistogramResponse) String() string {
+func (x *MetricsViewToplistRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRugHistogramResponse) ProtoMessage() {}
+func (*MetricsViewToplistRequest) ProtoMessage() {}
 
-func (x *GetRugHistogramResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[59]
+func (x *MetricsViewToplistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4266,122 +4636,107 @@ func (x *GetRugHistogramResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRugHistogramResponse.ProtoReflect.Descriptor instead.
-func (*GetRugHistogramResponse) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use MetricsViewToplistRequest.ProtoReflect.Descriptor instead.
+func (*MetricsViewToplistRequest) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *GetRugHistogramResponse) GetNumericSummary() *NumericSummary {
+func (x *MetricsViewToplistRequest) GetInstanceId() string {
 	if x != nil {
-		return x.NumericSummary
+		return x.InstanceId
 	}
-	return nil
-}
-
-type GetNumericHistogramResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	NumericSummary *NumericSummary `protobuf:"bytes,1,opt,name=numeric_summary,json=numericSummary,proto3" json:"numeric_summary,omitempty"`
+	return ""
 }
 
-func (x *GetNumericHistogramResponse) Reset() {
-	*x = GetNumericHistogramResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[60]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *MetricsViewToplistRequest) GetMetricsViewName() string {
+	if x != nil {
+		return x.MetricsViewName
 	}
+	return ""
 }
 
-func (x *GetNumericHistogramResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *MetricsViewToplistRequest) GetDimensionName() string {
+	if x != nil {
+		return x.DimensionName
+	}
+	return ""
 }
 
-func (*GetNumericHistogramResponse) ProtoMessage() {}
-
-func (x *GetNumericHistogramResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[60]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *MetricsViewToplistRequest) GetMeasureNames() []string {
+	if x != nil {
+		return x.MeasureNames
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetNumericHistogramResponse.ProtoReflect.Descriptor instead.
-func (*GetNumericHistogramResponse) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{60}
+func (x *MetricsViewToplistRequest) GetTimeStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeStart
+	}
+	return nil
 }
 
-func (x *GetNumericHistogramResponse) GetNumericSummary() *NumericSummary {
+func (x *MetricsViewToplistRequest) GetTimeEnd() *timestamppb.Timestamp {
 	if x != nil {
-		return x.NumericSummary
+		return x.TimeEnd
 	}
 	return nil
 }
 
-type GetDescriptiveStatisticsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	NumericSummary *NumericSummary `protobuf:"bytes,1,opt,name=numeric_summary,json=numericSummary,proto3" json:"numeric_summary,omitempty"`
+func (x *MetricsViewToplistRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
 }
 
-func (x *GetDescriptiveStatisticsResponse) Reset() {
-	*x = GetDescriptiveStatisticsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[61]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *MetricsViewToplistRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
 	}
+	return 0
 }
 
-func (x *GetDescriptiveStatisticsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *MetricsViewToplistRequest) GetSort() []*MetricsViewSort {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
 }
 
-func (*GetDescriptiveStatisticsResponse) ProtoMessage() {}
-
-func (x *GetDescriptiveStatisticsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[61]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *MetricsViewToplistRequest) GetFilter() *MetricsViewFilter {
+	if x != nil {
+		return x.Filter
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetDescriptiveStatisticsResponse.ProtoReflect.Descriptor instead.
-func (*GetDescriptiveStatisticsResponse) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{61}
+func (x *MetricsViewToplistRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
 }
 
-func (x *GetDescriptiveStatisticsResponse) GetNumericSummary() *NumericSummary {
+func (x *MetricsViewToplistRequest) GetTimeoutMs() int64 {
 	if x != nil {
-		return x.NumericSummary
+		return x.TimeoutMs
 	}
-	return nil
+	return 0
 }
 
-type GetTimeRangeSummaryResponse struct {
+// Response message for RuntimeService.MetricsViewToplist
+type MetricsViewToplistResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TimeRangeSummary *TimeRangeSummary `protobuf:"bytes,1,opt,name=time_range_summary,json=timeRangeSummary,proto3" json:"time_range_summary,omitempty"`
+	Meta []*MetricsViewColumn `protobuf:"bytes,1,rep,name=meta,proto3" json:"meta,omitempty"`
+	Data []*structpb.Struct   `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *GetTimeRangeSummaryResponse) Reset() {
-	*x = GetTimeRangeSummaryResponse{}
+func (x *MetricsViewToplistResponse) Reset() {
+	*x = MetricsViewToplistResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4389,13 +4744,13 @@ func (x *GetTimeRangeSummaryResponse) Reset() {
 	}
 }
 
-func (x *GetTimeRangeSummaryResponse) String() string {
+func (x *MetricsViewToplistResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTimeRangeSummaryResponse) ProtoMessage() {}
+func (*MetricsViewToplistResponse) ProtoMessage() {}
 
-func (x *GetTimeRangeSummaryResponse) ProtoReflect() protoreflect.Message {
+func (x *MetricsViewToplistResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4407,30 +4762,45 @@ func (x *GetTimeRangeSummaryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTimeRangeSummaryResponse.ProtoReflect.Descriptor instead.
-func (*GetTimeRangeSummaryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MetricsViewToplistResponse.ProtoReflect.Descriptor instead.
+func (*MetricsViewToplistResponse) Descriptor() ([]byte, []int) {
 	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *GetTimeRangeSummaryResponse) GetTimeRangeSummary() *TimeRangeSummary {
+func (x *MetricsViewToplistResponse) GetMeta() []*MetricsViewColumn {
 	if x != nil {
-		return x.TimeRangeSummary
+		return x.Meta
 	}
 	return nil
 }
 
-type TimeRangeSummary struct {
+func (x *MetricsViewToplistResponse) GetData() []*structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Request message for RuntimeService.MetricsViewTimeSeries
+type MetricsViewTimeSeriesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Min      *timestamppb.Timestamp     `protobuf:"bytes,1,opt,name=min,proto3" json:"min,omitempty"`
-	Max      *timestamppb.Timestamp     `protobuf:"bytes,2,opt,name=max,proto3" json:"max,omitempty"`
-	Interval *TimeRangeSummary_Interval `protobuf:"bytes,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	InstanceId      string                 `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	MetricsViewName string                 `protobuf:"bytes,2,opt,name=metrics_view_name,json=metricsViewName,proto3" json:"metrics_view_name,omitempty"`
+	MeasureNames    []string               `protobuf:"bytes,3,rep,name=measure_names,json=measureNames,proto3" json:"measure_names,omitempty"`
+	TimeStart       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=time_start,json=timeStart,proto3" json:"time_start,omitempty"`
+	TimeEnd         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=time_end,json=timeEnd,proto3" json:"time_end,omitempty"`
+	TimeGranularity string                 `protobuf:"bytes,6,opt,name=time_granularity,json=timeGranularity,proto3" json:"time_granularity,omitempty"`
+	Filter          *MetricsViewFilter     `protobuf:"bytes,7,opt,name=filter,proto3" json:"filter,omitempty"`
+	Priority        int32                  `protobuf:"varint,8,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Timeout for the query (overrides the instance's default if set)
+	TimeoutMs int64 `protobuf:"varint,9,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
 }
 
-func (x *TimeRangeSummary) Reset() {
-	*x = TimeRangeSummary{}
+func (x *MetricsViewTimeSeriesRequest) Reset() {
+	*x = MetricsViewTimeSeriesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4438,13 +4808,13 @@ func (x *TimeRangeSummary) Reset() {
 	}
 }
 
-func (x *TimeRangeSummary) String() string {
+func (x *MetricsViewTimeSeriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TimeRangeSummary) ProtoMessage() {}
+func (*MetricsViewTimeSeriesRequest) ProtoMessage() {}
 
-func (x *TimeRangeSummary) ProtoReflect() protoreflect.Message {
+func (x *MetricsViewTimeSeriesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4456,42 +4826,86 @@ func (x *TimeRangeSummary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TimeRangeSummary.ProtoReflect.Descriptor instead.
-func (*TimeRangeSummary) Descriptor() ([]byte, []int) {
+// Deprecated: Use MetricsViewTimeSeriesRequest.ProtoReflect.Descriptor instead.
+func (*MetricsViewTimeSeriesRequest) Descriptor() ([]byte, []int) {
 	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *TimeRangeSummary) GetMin() *timestamppb.Timestamp {
+func (x *MetricsViewTimeSeriesRequest) GetInstanceId() string {
 	if x != nil {
-		return x.Min
+		return x.InstanceId
 	}
-	return nil
+	return ""
 }
 
-func (x *TimeRangeSummary) GetMax() *timestamppb.Timestamp {
+func (x *MetricsViewTimeSeriesRequest) GetMetricsViewName() string {
 	if x != nil {
-		return x.Max
+		return x.MetricsViewName
+	}
+	return ""
+}
+
+func (x *MetricsViewTimeSeriesRequest) GetMeasureNames() []string {
+	if x != nil {
+		return x.MeasureNames
 	}
 	return nil
 }
 
-func (x *TimeRangeSummary) GetInterval() *TimeRangeSummary_Interval {
+func (x *MetricsViewTimeSeriesRequest) GetTimeStart() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Interval
+		return x.TimeStart
 	}
 	return nil
 }
 
-type NumericOutliers struct {
+func (x *MetricsViewTimeSeriesRequest) GetTimeEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeEnd
+	}
+	return nil
+}
+
+func (x *MetricsViewTimeSeriesRequest) GetTimeGranularity() string {
+	if x != nil {
+		return x.TimeGranularity
+	}
+	return ""
+}
+
+func (x *MetricsViewTimeSeriesRequest) GetFilter() *MetricsViewFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *MetricsViewTimeSeriesRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *MetricsViewTimeSeriesRequest) GetTimeoutMs() int64 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+// Response message for RuntimeService.MetricsViewTimeSeries
+type MetricsViewTimeSeriesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Outliers []*NumericOutliers_Outlier `protobuf:"bytes,1,rep,name=outliers,proto3" json:"outliers,omitempty"`
+	Meta []*MetricsViewColumn `protobuf:"bytes,1,rep,name=meta,proto3" json:"meta,omitempty"`
+	Data []*structpb.Struct   `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *NumericOutliers) Reset() {
-	*x = NumericOutliers{}
+func (x *MetricsViewTimeSeriesResponse) Reset() {
+	*x = MetricsViewTimeSeriesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4499,13 +4913,13 @@ func (x *NumericOutliers) Reset() {
 	}
 }
 
-func (x *NumericOutliers) String() string {
+func (x *MetricsViewTimeSeriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NumericOutliers) ProtoMessage() {}
+func (*MetricsViewTimeSeriesResponse) ProtoMessage() {}
 
-func (x *NumericOutliers) ProtoReflect() protoreflect.Message {
+func (x *MetricsViewTimeSeriesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4517,34 +4931,44 @@ func (x *NumericOutliers) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NumericOutliers.ProtoReflect.Descriptor instead.
-func (*NumericOutliers) Descriptor() ([]byte, []int) {
+// Deprecated: Use MetricsViewTimeSeriesResponse.ProtoReflect.Descriptor instead.
+func (*MetricsViewTimeSeriesResponse) Descriptor() ([]byte, []int) {
 	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *NumericOutliers) GetOutliers() []*NumericOutliers_Outlier {
+func (x *MetricsViewTimeSeriesResponse) GetMeta() []*MetricsViewColumn {
 	if x != nil {
-		return x.Outliers
+		return x.Meta
 	}
 	return nil
 }
 
-// Request for RuntimeService.GetTopK. Returns the top K values for a given column using agg function for table table_name.
-type GetTopKRequest struct {
+func (x *MetricsViewTimeSeriesResponse) GetData() []*structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Request message for RuntimeService.MetricsViewTotals
+type MetricsViewTotalsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
-	TableName  string `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
-	ColumnName string `protobuf:"bytes,3,opt,name=column_name,json=columnName,proto3" json:"column_name,omitempty"`
-	Agg        string `protobuf:"bytes,4,opt,name=agg,proto3" json:"agg,omitempty"` // default is count(*)
-	K          int32  `protobuf:"varint,5,opt,name=k,proto3" json:"k,omitempty"`    // default is 50
-	Priority   int32  `protobuf:"varint,6,opt,name=priority,proto3" json:"priority,omitempty"`
+	InstanceId      string                 `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	MetricsViewName string                 `protobuf:"bytes,2,opt,name=metrics_view_name,json=metricsViewName,proto3" json:"metrics_view_name,omitempty"`
+	MeasureNames    []string               `protobuf:"bytes,3,rep,name=measure_names,json=measureNames,proto3" json:"measure_names,omitempty"`
+	TimeStart       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=time_start,json=timeStart,proto3" json:"time_start,omitempty"`
+	TimeEnd         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=time_end,json=timeEnd,proto3" json:"time_end,omitempty"`
+	Filter          *MetricsViewFilter     `protobuf:"bytes,7,opt,name=filter,proto3" json:"filter,omitempty"`
+	Priority        int32                  `protobuf:"varint,8,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Timeout for the query (overrides the instance's default if set)
+	TimeoutMs int64 `protobuf:"varint,9,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
 }
 
-func (x *GetTopKRequest) Reset() {
-	*x = GetTopKRequest{}
+func (x *MetricsViewTotalsRequest) Reset() {
+	*x = MetricsViewTotalsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4552,13 +4976,13 @@ func (x *GetTopKRequest) Reset() {
 	}
 }
 
-func (x *GetTopKRequest) String() string {
+func (x *MetricsViewTotalsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTopKRequest) ProtoMessage() {}
+func (*MetricsViewTotalsRequest) ProtoMessage() {}
 
-func (x *GetTopKRequest) ProtoReflect() protoreflect.Message {
+func (x *MetricsViewTotalsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4570,63 +4994,79 @@ func (x *GetTopKRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTopKRequest.ProtoReflect.Descriptor instead.
-func (*GetTopKRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MetricsViewTotalsRequest.ProtoReflect.Descriptor instead.
+func (*MetricsViewTotalsRequest) Descriptor() ([]byte, []int) {
 	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *GetTopKRequest) GetInstanceId() string {
+func (x *MetricsViewTotalsRequest) GetInstanceId() string {
 	if x != nil {
 		return x.InstanceId
 	}
 	return ""
 }
 
-func (x *GetTopKRequest) GetTableName() string {
+func (x *MetricsViewTotalsRequest) GetMetricsViewName() string {
 	if x != nil {
-		return x.TableName
+		return x.MetricsViewName
 	}
 	return ""
 }
 
-func (x *GetTopKRequest) GetColumnName() string {
+func (x *MetricsViewTotalsRequest) GetMeasureNames() []string {
 	if x != nil {
-		return x.ColumnName
+		return x.MeasureNames
 	}
-	return ""
+	return nil
 }
 
-func (x *GetTopKRequest) GetAgg() string {
+func (x *MetricsViewTotalsRequest) GetTimeStart() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Agg
+		return x.TimeStart
 	}
-	return ""
+	return nil
 }
 
-func (x *GetTopKRequest) GetK() int32 {
+func (x *MetricsViewTotalsRequest) GetTimeEnd() *timestamppb.Timestamp {
 	if x != nil {
-		return x.K
+		return x.TimeEnd
 	}
-	return 0
+	return nil
 }
 
-func (x *GetTopKRequest) GetPriority() int32 {
+func (x *MetricsViewTotalsRequest) GetFilter() *MetricsViewFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *MetricsViewTotalsRequest) GetPriority() int32 {
 	if x != nil {
 		return x.Priority
 	}
 	return 0
 }
 
-type TopK struct {
+func (x *MetricsViewTotalsRequest) GetTimeoutMs() int64 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+// Response message for RuntimeService.MetricsViewTotals
+type MetricsViewTotalsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Entries []*TopK_Entry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Meta []*MetricsViewColumn `protobuf:"bytes,1,rep,name=meta,proto3" json:"meta,omitempty"`
+	Data *structpb.Struct     `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *TopK) Reset() {
-	*x = TopK{}
+func (x *MetricsViewTotalsResponse) Reset() {
+	*x = MetricsViewTotalsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4634,13 +5074,13 @@ func (x *TopK) Reset() {
 	}
 }
 
-func (x *TopK) String() string {
+func (x *MetricsViewTotalsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TopK) ProtoMessage() {}
+func (*MetricsViewTotalsResponse) ProtoMessage() {}
 
-func (x *TopK) ProtoReflect() protoreflect.Message {
+func (x *MetricsViewTotalsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4652,32 +5092,37 @@ func (x *TopK) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TopK.ProtoReflect.Descriptor instead.
-func (*TopK) Descriptor() ([]byte, []int) {
+// Deprecated: Use MetricsViewTotalsResponse.ProtoReflect.Descriptor instead.
+func (*MetricsViewTotalsResponse) Descriptor() ([]byte, []int) {
 	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *TopK) GetEntries() []*TopK_Entry {
+func (x *MetricsViewTotalsResponse) GetMeta() []*MetricsViewColumn {
 	if x != nil {
-		return x.Entries
+		return x.Meta
 	}
 	return nil
 }
 
-// Request for RuntimeService.GetNullCount. Returns the null count for a given column for table table_name
-type GetNullCountRequest struct {
+func (x *MetricsViewTotalsResponse) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Sort clause for metrics view requests
+type MetricsViewSort struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
-	TableName  string `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
-	ColumnName string `protobuf:"bytes,3,opt,name=column_name,json=columnName,proto3" json:"column_name,omitempty"`
-	Priority   int32  `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Ascending bool   `protobuf:"varint,2,opt,name=ascending,proto3" json:"ascending,omitempty"`
 }
 
-func (x *GetNullCountRequest) Reset() {
-	*x = GetNullCountRequest{}
+func (x *MetricsViewSort) Reset() {
+	*x = MetricsViewSort{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4685,13 +5130,13 @@ func (x *GetNullCountRequest) Reset() {
 	}
 }
 
-func (x *GetNullCountRequest) String() string {
+func (x *MetricsViewSort) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNullCountRequest) ProtoMessage() {}
+func (*MetricsViewSort) ProtoMessage() {}
 
-func (x *GetNullCountRequest) ProtoReflect() protoreflect.Message {
+func (x *MetricsViewSort) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4703,50 +5148,37 @@ func (x *GetNullCountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNullCountRequest.ProtoReflect.Descriptor instead.
-func (*GetNullCountRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MetricsViewSort.ProtoReflect.Descriptor instead.
+func (*MetricsViewSort) Descriptor() ([]byte, []int) {
 	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *GetNullCountRequest) GetInstanceId() string {
-	if x != nil {
-		return x.InstanceId
-	}
-	return ""
-}
-
-func (x *GetNullCountRequest) GetTableName() string {
-	if x != nil {
-		return x.TableName
-	}
-	return ""
-}
-
-func (x *GetNullCountRequest) GetColumnName() string {
+func (x *MetricsViewSort) GetName() string {
 	if x != nil {
-		return x.ColumnName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetNullCountRequest) GetPriority() int32 {
+func (x *MetricsViewSort) GetAscending() bool {
 	if x != nil {
-		return x.Priority
+		return x.Ascending
 	}
-	return 0
+	return false
 }
 
-// Response for RuntimeService.GetNullCount
-type GetNullCountResponse struct {
+// Filter clause for metrics view requests
+type MetricsViewFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Count float64 `protobuf:"fixed64,1,opt,name=count,proto3" json:"count,omitempty"`
+	Include []*MetricsViewFilter_Cond `protobuf:"bytes,2,rep,name=include,proto3" json:"include,omitempty"`
+	Exclude []*MetricsViewFilter_Cond `protobuf:"bytes,3,rep,name=exclude,proto3" json:"exclude,omitempty"`
 }
 
-func (x *GetNullCountResponse) Reset() {
-	*x = GetNullCountResponse{}
+func (x *MetricsViewFilter) Reset() {
+	*x = MetricsViewFilter{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4754,13 +5186,13 @@ func (x *GetNullCountResponse) Reset() {
 	}
 }
 
-func (x *GetNullCountResponse) String() string {
+func (x *MetricsViewFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNullCountResponse) ProtoMessage() {}
+func (*MetricsViewFilter) ProtoMessage() {}
 
-func (x *GetNullCountResponse) ProtoReflect() protoreflect.Message {
+func (x *MetricsViewFilter) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4772,32 +5204,38 @@ func (x *GetNullCountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNullCountResponse.ProtoReflect.Descriptor instead.
-func (*GetNullCountResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MetricsViewFilter.ProtoReflect.Descriptor instead.
+func (*MetricsViewFilter) Descriptor() ([]byte, []int) {
 	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *GetNullCountResponse) GetCount() float64 {
+func (x *MetricsViewFilter) GetInclude() []*MetricsViewFilter_Cond {
 	if x != nil {
-		return x.Count
+		return x.Include
 	}
-	return 0
+	return nil
 }
 
-// Request for RuntimeService.GetGetDescriptiveStatisticsRequest. Returns the stats for a given column for table table_name
-type GetDescriptiveStatisticsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+func (x *MetricsViewFilter) GetExclude() []*MetricsViewFilter_Cond {
+	if x != nil {
+		return x.Exclude
+	}
+	return nil
+}
+
+// MetricsViewColumn represents a column in a metrics view
+type MetricsViewColumn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
-	TableName  string `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
-	ColumnName string `protobuf:"bytes,3,opt,name=column_name,json=columnName,proto3" json:"column_name,omitempty"`
-	Priority   int32  `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type     string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Nullable bool   `protobuf:"varint,3,opt,name=nullable,proto3" json:"nullable,omitempty"`
 }
 
-func (x *GetDescriptiveStatisticsRequest) Reset() {
-	*x = GetDescriptiveStatisticsRequest{}
+func (x *MetricsViewColumn) Reset() {
+	*x = MetricsViewColumn{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4805,13 +5243,13 @@ func (x *GetDescriptiveStatisticsRequest) Reset() {
 	}
 }
 
-func (x *GetDescriptiveStatisticsRequest) String() string {
+func (x *MetricsViewColumn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDescriptiveStatisticsRequest) ProtoMessage() {}
+func (*MetricsViewColumn) ProtoMessage() {}
 
-func (x *GetDescriptiveStatisticsRequest) ProtoReflect() protoreflect.Message {
+func (x *MetricsViewColumn) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4823,56 +5261,45 @@ func (x *GetDescriptiveStatisticsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDescriptiveStatisticsRequest.ProtoReflect.Descriptor instead.
-func (*GetDescriptiveStatisticsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MetricsViewColumn.ProtoReflect.Descriptor instead.
+func (*MetricsViewColumn) Descriptor() ([]byte, []int) {
 	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *GetDescriptiveStatisticsRequest) GetInstanceId() string {
-	if x != nil {
-		return x.InstanceId
-	}
-	return ""
-}
-
-func (x *GetDescriptiveStatisticsRequest) GetTableName() string {
+func (x *MetricsViewColumn) GetName() string {
 	if x != nil {
-		return x.TableName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetDescriptiveStatisticsRequest) GetColumnName() string {
+func (x *MetricsViewColumn) GetType() string {
 	if x != nil {
-		return x.ColumnName
+		return x.Type
 	}
 	return ""
 }
 
-func (x *GetDescriptiveStatisticsRequest) GetPriority() int32 {
+func (x *MetricsViewColumn) GetNullable() bool {
 	if x != nil {
-		return x.Priority
+		return x.Nullable
 	}
-	return 0
+	return false
 }
 
-// Response for RuntimeService.GetDescriptiveStatistics
-type NumericStatistics struct {
+type EstimateRollupIntervalRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Min  float64 `protobuf:"fixed64,1,opt,name=min,proto3" json:"min,omitempty"`
-	Max  float64 `protobuf:"fixed64,2,opt,name=max,proto3" json:"max,omitempty"`
-	Mean float64 `protobuf:"fixed64,3,opt,name=mean,proto3" json:"mean,omitempty"`
-	Q25  float64 `protobuf:"fixed64,4,opt,name=q25,proto3" json:"q25,omitempty"`
-	Q50  float64 `protobuf:"fixed64,5,opt,name=q50,proto3" json:"q50,omitempty"`
-	Q75  float64 `protobuf:"fixed64,6,opt,name=q75,proto3" json:"q75,omitempty"`
-	Sd   float64 `protobuf:"fixed64,7,opt,name=sd,proto3" json:"sd,omitempty"`
+	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	TableName  string `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	ColumnName string `protobuf:"bytes,3,opt,name=column_name,json=columnName,proto3" json:"column_name,omitempty"`
+	Priority   int32  `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
-func (x *NumericStatistics) Reset() {
-	*x = NumericStatistics{}
+func (x *EstimateRollupIntervalRequest) Reset() {
+	*x = EstimateRollupIntervalRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4880,13 +5307,13 @@ func (x *NumericStatistics) Reset() {
 	}
 }
 
-func (x *NumericStatistics) String() string {
+func (x *EstimateRollupIntervalRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NumericStatistics) ProtoMessage() {}
+func (*EstimateRollupIntervalRequest) ProtoMessage() {}
 
-func (x *NumericStatistics) ProtoReflect() protoreflect.Message {
+func (x *EstimateRollupIntervalRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4898,74 +5325,51 @@ func (x *NumericStatistics) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NumericStatistics.ProtoReflect.Descriptor instead.
-func (*NumericStatistics) Descriptor() ([]byte, []int) {
+// Deprecated: Use EstimateRollupIntervalRequest.ProtoReflect.Descriptor instead.
+func (*EstimateRollupIntervalRequest) Descriptor() ([]byte, []int) {
 	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *NumericStatistics) GetMin() float64 {
-	if x != nil {
-		return x.Min
-	}
-	return 0
-}
-
-func (x *NumericStatistics) GetMax() float64 {
-	if x != nil {
-		return x.Max
-	}
-	return 0
-}
-
-func (x *NumericStatistics) GetMean() float64 {
-	if x != nil {
-		return x.Mean
-	}
-	return 0
-}
-
-func (x *NumericStatistics) GetQ25() float64 {
+func (x *EstimateRollupIntervalRequest) GetInstanceId() string {
 	if x != nil {
-		return x.Q25
+		return x.InstanceId
 	}
-	return 0
+	return ""
 }
 
-func (x *NumericStatistics) GetQ50() float64 {
+func (x *EstimateRollupIntervalRequest) GetTableName() string {
 	if x != nil {
-		return x.Q50
+		return x.TableName
 	}
-	return 0
+	return ""
 }
 
-func (x *NumericStatistics) GetQ75() float64 {
+func (x *EstimateRollupIntervalRequest) GetColumnName() string {
 	if x != nil {
-		return x.Q75
+		return x.ColumnName
 	}
-	return 0
+	return ""
 }
 
-func (x *NumericStatistics) GetSd() float64 {
+func (x *EstimateRollupIntervalRequest) GetPriority() int32 {
 	if x != nil {
-		return x.Sd
+		return x.Priority
 	}
 	return 0
 }
 
-// Request for RuntimeService.EstimateSmallestTimeGrainRequest
-type EstimateSmallestTimeGrainRequest struct {
+type EstimateRollupIntervalResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
-	TableName  string `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
-	ColumnName string `protobuf:"bytes,3,opt,name=column_name,json=columnName,proto3" json:"column_name,omitempty"`
-	Priority   int32  `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	Start    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	Interval TimeGrain              `protobuf:"varint,3,opt,name=interval,proto3,enum=rill.runtime.v1.TimeGrain" json:"interval,omitempty"`
 }
 
-func (x *EstimateSmallestTimeGrainRequest) Reset() {
-	*x = EstimateSmallestTimeGrainRequest{}
+func (x *EstimateRollupIntervalResponse) Reset() {
+	*x = EstimateRollupIntervalResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4973,13 +5377,13 @@ func (x *EstimateSmallestTimeGrainRequest) Reset() {
 	}
 }
 
-func (x *EstimateSmallestTimeGrainRequest) String() string {
+func (x *EstimateRollupIntervalResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EstimateSmallestTimeGrainRequest) ProtoMessage() {}
+func (*EstimateRollupIntervalResponse) ProtoMessage() {}
 
-func (x *EstimateSmallestTimeGrainRequest) ProtoReflect() protoreflect.Message {
+func (x *EstimateRollupIntervalResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4991,50 +5395,46 @@ func (x *EstimateSmallestTimeGrainRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EstimateSmallestTimeGrainRequest.ProtoReflect.Descriptor instead.
-func (*EstimateSmallestTimeGrainRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EstimateRollupIntervalResponse.ProtoReflect.Descriptor instead.
+func (*EstimateRollupIntervalResponse) Descriptor() ([]byte, []int) {
 	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *EstimateSmallestTimeGrainRequest) GetInstanceId() string {
-	if x != nil {
-		return x.InstanceId
-	}
-	return ""
-}
-
-func (x *EstimateSmallestTimeGrainRequest) GetTableName() string {
+func (x *EstimateRollupIntervalResponse) GetStart() *timestamppb.Timestamp {
 	if x != nil {
-		return x.TableName
+		return x.Start
 	}
-	return ""
+	return nil
 }
 
-func (x *EstimateSmallestTimeGrainRequest) GetColumnName() string {
+func (x *EstimateRollupIntervalResponse) GetEnd() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ColumnName
+		return x.End
 	}
-	return ""
+	return nil
 }
 
-func (x *EstimateSmallestTimeGrainRequest) GetPriority() int32 {
+func (x *EstimateRollupIntervalResponse) GetInterval() TimeGrain {
 	if x != nil {
-		return x.Priority
+		return x.Interval
 	}
-	return 0
+	return TimeGrain_TIME_GRAIN_UNSPECIFIED
 }
 
-// Response for RuntimeService.EstimateSmallestTimeGrain
-type EstimateSmallestTimeGrainResponse struct {
+type ProfileColumnSummary struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TimeGrain TimeGrain `protobuf:"varint,1,opt,name=time_grain,json=timeGrain,proto3,enum=rill.runtime.v1.TimeGrain" json:"time_grain,omitempty"`
+	// Types that are assignable to Case:
+	//	*ProfileColumnSummary_CategoricalSummary
+	//	*ProfileColumnSummary_NumericSummary
+	//	*ProfileColumnSummary_TimeRangeSummary
+	Case isProfileColumnSummary_Case `protobuf_oneof:"case"`
 }
 
-func (x *EstimateSmallestTimeGrainResponse) Reset() {
-	*x = EstimateSmallestTimeGrainResponse{}
+func (x *ProfileColumnSummary) Reset() {
+	*x = ProfileColumnSummary{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5042,13 +5442,13 @@ func (x *EstimateSmallestTimeGrainResponse) Reset() {
 	}
 }
 
-func (x *EstimateSmallestTimeGrainResponse) String() string {
+func (x *ProfileColumnSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EstimateSmallestTimeGrainResponse) ProtoMessage() {}
+func (*ProfileColumnSummary) ProtoMessage() {}
 
-func (x *EstimateSmallestTimeGrainResponse) ProtoReflect() protoreflect.Message {
+func (x *ProfileColumnSummary) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5060,116 +5460,90 @@ func (x *EstimateSmallestTimeGrainResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EstimateSmallestTimeGrainResponse.ProtoReflect.Descriptor instead.
-func (*EstimateSmallestTimeGrainResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProfileColumnSummary.ProtoReflect.Descriptor instead.
+func (*ProfileColumnSummary) Descriptor() ([]byte, []int) {
 	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *EstimateSmallestTimeGrainResponse) GetTimeGrain() TimeGrain {
-	if x != nil {
-		return x.TimeGrain
+func (m *ProfileColumnSummary) GetCase() isProfileColumnSummary_Case {
+	if m != nil {
+		return m.Case
 	}
-	return TimeGrain_TIME_GRAIN_UNSPECIFIED
+	return nil
 }
 
-// Request for RuntimeService.GetNumericHistogram. Returns the histogram for a given column for table table_name
-type GetNumericHistogramRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
-	TableName  string `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
-	ColumnName string `protobuf:"bytes,3,opt,name=column_name,json=columnName,proto3" json:"column_name,omitempty"`
-	Priority   int32  `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+func (x *ProfileColumnSummary) GetCategoricalSummary() *CategoricalSummary {
+	if x, ok := x.GetCase().(*ProfileColumnSummary_CategoricalSummary); ok {
+		return x.CategoricalSummary
+	}
+	return nil
 }
 
-func (x *GetNumericHistogramRequest) Reset() {
-	*x = GetNumericHistogramRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[73]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ProfileColumnSummary) GetNumericSummary() *NumericSummary {
+	if x, ok := x.GetCase().(*ProfileColumnSummary_NumericSummary); ok {
+		return x.NumericSummary
 	}
+	return nil
 }
 
-func (x *GetNumericHistogramRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ProfileColumnSummary) GetTimeRangeSummary() *TimeRangeSummary {
+	if x, ok := x.GetCase().(*ProfileColumnSummary_TimeRangeSummary); ok {
+		return x.TimeRangeSummary
+	}
+	return nil
 }
 
-func (*GetNumericHistogramRequest) ProtoMessage() {}
-
-func (x *GetNumericHistogramRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[73]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+type isProfileColumnSummary_Case interface {
+	isProfileColumnSummary_Case()
 }
 
-// Deprecated: Use GetNumericHistogramRequest.ProtoReflect.Descriptor instead.
-func (*GetNumericHistogramRequest) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{73}
+type ProfileColumnSummary_CategoricalSummary struct {
+	CategoricalSummary *CategoricalSummary `protobuf:"bytes,1,opt,name=categorical_summary,json=categoricalSummary,proto3,oneof"`
 }
 
-func (x *GetNumericHistogramRequest) GetInstanceId() string {
-	if x != nil {
-		return x.InstanceId
-	}
-	return ""
+type ProfileColumnSummary_NumericSummary struct {
+	NumericSummary *NumericSummary `protobuf:"bytes,2,opt,name=numeric_summary,json=numericSummary,proto3,oneof"`
 }
 
-func (x *GetNumericHistogramRequest) GetTableName() string {
-	if x != nil {
-		return x.TableName
-	}
-	return ""
+type ProfileColumnSummary_TimeRangeSummary struct {
+	TimeRangeSummary *TimeRangeSummary `protobuf:"bytes,3,opt,name=time_range_summary,json=timeRangeSummary,proto3,oneof"`
 }
 
-func (x *GetNumericHistogramRequest) GetColumnName() string {
-	if x != nil {
-		return x.ColumnName
-	}
-	return ""
-}
+func (*ProfileColumnSummary_CategoricalSummary) isProfileColumnSummary_Case() {}
 
-func (x *GetNumericHistogramRequest) GetPriority() int32 {
-	if x != nil {
-		return x.Priority
-	}
-	return 0
-}
+func (*ProfileColumnSummary_NumericSummary) isProfileColumnSummary_Case() {}
 
-// Response for RuntimeService.GetNumericHistogram
-type NumericHistogramBins struct {
+func (*ProfileColumnSummary_TimeRangeSummary) isProfileColumnSummary_Case() {}
+
+// Response for RuntimeService.GetTopK and RuntimeService.GetCardinalityOfColumn. Message will have either topK or cardinality set.
+type CategoricalSummary struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Bins []*NumericHistogramBins_Bin `protobuf:"bytes,1,rep,name=bins,proto3" json:"bins,omitempty"`
+	// Types that are assignable to Case:
+	//	*CategoricalSummary_TopK
+	//	*CategoricalSummary_Cardinality
+	Case isCategoricalSummary_Case `protobuf_oneof:"case"`
 }
 
-func (x *NumericHistogramBins) Reset() {
-	*x = NumericHistogramBins{}
+func (x *CategoricalSummary) Reset() {
+	*x = CategoricalSummary{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[74]
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NumericHistogramBins) String() string {
+func (x *CategoricalSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NumericHistogramBins) ProtoMessage() {}
+func (*CategoricalSummary) ProtoMessage() {}
 
-func (x *NumericHistogramBins) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[74]
+func (x *CategoricalSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5180,47 +5554,73 @@ func (x *NumericHistogramBins) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NumericHistogramBins.ProtoReflect.Descriptor instead.
-func (*NumericHistogramBins) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use CategoricalSummary.ProtoReflect.Descriptor instead.
+func (*CategoricalSummary) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *NumericHistogramBins) GetBins() []*NumericHistogramBins_Bin {
-	if x != nil {
-		return x.Bins
+func (m *CategoricalSummary) GetCase() isCategoricalSummary_Case {
+	if m != nil {
+		return m.Case
 	}
 	return nil
 }
 
-// Request for RuntimeService.GetRugHistogram
-type GetRugHistogramRequest struct {
+func (x *CategoricalSummary) GetTopK() *TopK {
+	if x, ok := x.GetCase().(*CategoricalSummary_TopK); ok {
+		return x.TopK
+	}
+	return nil
+}
+
+func (x *CategoricalSummary) GetCardinality() float64 {
+	if x, ok := x.GetCase().(*CategoricalSummary_Cardinality); ok {
+		return x.Cardinality
+	}
+	return 0
+}
+
+type isCategoricalSummary_Case interface {
+	isCategoricalSummary_Case()
+}
+
+type CategoricalSummary_TopK struct {
+	TopK *TopK `protobuf:"bytes,1,opt,name=top_k,json=topK,proto3,oneof"`
+}
+
+type CategoricalSummary_Cardinality struct {
+	Cardinality float64 `protobuf:"fixed64,2,opt,name=cardinality,proto3,oneof"`
+}
+
+func (*CategoricalSummary_TopK) isCategoricalSummary_Case() {}
+
+func (*CategoricalSummary_Cardinality) isCategoricalSummary_Case() {}
+
+type GetCardinalityOfColumnResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
-	TableName  string `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
-	ColumnName string `protobuf:"bytes,3,opt,name=column_name,json=columnName,proto3" json:"column_name,omitempty"`
-	Priority   int32  `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	CategoricalSummary *CategoricalSummary `protobuf:"bytes,1,opt,name=categorical_summary,json=categoricalSummary,proto3" json:"categorical_summary,omitempty"`
 }
 
-func (x *GetRugHistogramRequest) Reset() {
-	*x = GetRugHistogramRequest{}
+func (x *GetCardinalityOfColumnResponse) Reset() {
+	*x = GetCardinalityOfColumnResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[75]
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetRugHistogramRequest) String() string {
+func (x *GetCardinalityOfColumnResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRugHistogramRequest) ProtoMessage() {}
+func (*GetCardinalityOfColumnResponse) ProtoMessage() {}
 
-func (x *GetRugHistogramRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[75]
+func (x *GetCardinalityOfColumnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5231,68 +5631,43 @@ func (x *GetRugHistogramRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRugHistogramRequest.ProtoReflect.Descriptor instead.
-func (*GetRugHistogramRequest) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{75}
-}
-
-func (x *GetRugHistogramRequest) GetInstanceId() string {
-	if x != nil {
-		return x.InstanceId
-	}
-	return ""
-}
-
-func (x *GetRugHistogramRequest) GetTableName() string {
-	if x != nil {
-		return x.TableName
-	}
-	return ""
-}
-
-func (x *GetRugHistogramRequest) GetColumnName() string {
-	if x != nil {
-		return x.ColumnName
-	}
-	return ""
+// Deprecated: Use GetCardinalityOfColumnResponse.ProtoReflect.Descriptor instead.
+func (*GetCardinalityOfColumnResponse) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *GetRugHistogramRequest) GetPriority() int32 {
+func (x *GetCardinalityOfColumnResponse) GetCategoricalSummary() *CategoricalSummary {
 	if x != nil {
-		return x.Priority
+		return x.CategoricalSummary
 	}
-	return 0
+	return nil
 }
 
-// Request for RuntimeService.GetTimeRangeSummary
-type GetTimeRangeSummaryRequest struct {
+type GetTopKResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
-	TableName  string `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
-	ColumnName string `protobuf:"bytes,3,opt,name=column_name,json=columnName,proto3" json:"column_name,omitempty"`
-	Priority   int32  `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	CategoricalSummary *CategoricalSummary `protobuf:"bytes,1,opt,name=categorical_summary,json=categoricalSummary,proto3" json:"categorical_summary,omitempty"`
 }
 
-func (x *GetTimeRangeSummaryRequest) Reset() {
-	*x = GetTimeRangeSummaryRequest{}
+func (x *GetTopKResponse) Reset() {
+	*x = GetTopKResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[76]
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetTimeRangeSummaryRequest) String() string {
+func (x *GetTopKResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTimeRangeSummaryRequest) ProtoMessage() {}
+func (*GetTopKResponse) ProtoMessage() {}
 
-func (x *GetTimeRangeSummaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[76]
+func (x *GetTopKResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5303,68 +5678,49 @@ func (x *GetTimeRangeSummaryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTimeRangeSummaryRequest.ProtoReflect.Descriptor instead.
-func (*GetTimeRangeSummaryRequest) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{76}
-}
-
-func (x *GetTimeRangeSummaryRequest) GetInstanceId() string {
-	if x != nil {
-		return x.InstanceId
-	}
-	return ""
-}
-
-func (x *GetTimeRangeSummaryRequest) GetTableName() string {
-	if x != nil {
-		return x.TableName
-	}
-	return ""
-}
-
-func (x *GetTimeRangeSummaryRequest) GetColumnName() string {
-	if x != nil {
-		return x.ColumnName
-	}
-	return ""
+// Deprecated: Use GetTopKResponse.ProtoReflect.Descriptor instead.
+func (*GetTopKResponse) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *GetTimeRangeSummaryRequest) GetPriority() int32 {
+func (x *GetTopKResponse) GetCategoricalSummary() *CategoricalSummary {
 	if x != nil {
-		return x.Priority
+		return x.CategoricalSummary
 	}
-	return 0
+	return nil
 }
 
-// Request for RuntimeService.GetCardinalityOfColumn. Returns the cardinality for a given column for table table_name
-type GetCardinalityOfColumnRequest struct {
+// Response for RuntimeService.GetNumericHistogram, RuntimeService.GetDescriptiveStatistics and RuntimeService.GetCardinalityOfColumn.
+// Message will have either numericHistogramBins, numericStatistics or numericOutliers set.
+type NumericSummary struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
-	TableName  string `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
-	ColumnName string `protobuf:"bytes,3,opt,name=column_name,json=columnName,proto3" json:"column_name,omitempty"`
-	Priority   int32  `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Types that are assignable to Case:
+	//	*NumericSummary_NumericHistogramBins
+	//	*NumericSummary_NumericStatistics
+	//	*NumericSummary_NumericOutliers
+	Case isNumericSummary_Case `protobuf_oneof:"case"`
 }
 
-func (x *GetCardinalityOfColumnRequest) Reset() {
-	*x = GetCardinalityOfColumnRequest{}
+func (x *NumericSummary) Reset() {
+	*x = NumericSummary{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[77]
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetCardinalityOfColumnRequest) String() string {
+func (x *NumericSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCardinalityOfColumnRequest) ProtoMessage() {}
+func (*NumericSummary) ProtoMessage() {}
 
-func (x *GetCardinalityOfColumnRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[77]
+func (x *NumericSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5375,72 +5731,86 @@ func (x *GetCardinalityOfColumnRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCardinalityOfColumnRequest.ProtoReflect.Descriptor instead.
-func (*GetCardinalityOfColumnRequest) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{77}
+// Deprecated: Use NumericSummary.ProtoReflect.Descriptor instead.
+func (*NumericSummary) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *GetCardinalityOfColumnRequest) GetInstanceId() string {
-	if x != nil {
-		return x.InstanceId
+func (m *NumericSummary) GetCase() isNumericSummary_Case {
+	if m != nil {
+		return m.Case
 	}
-	return ""
+	return nil
 }
 
-func (x *GetCardinalityOfColumnRequest) GetTableName() string {
-	if x != nil {
-		return x.TableName
+func (x *NumericSummary) GetNumericHistogramBins() *NumericHistogramBins {
+	if x, ok := x.GetCase().(*NumericSummary_NumericHistogramBins); ok {
+		return x.NumericHistogramBins
 	}
-	return ""
+	return nil
 }
 
-func (x *GetCardinalityOfColumnRequest) GetColumnName() string {
-	if x != nil {
-		return x.ColumnName
+func (x *NumericSummary) GetNumericStatistics() *NumericStatistics {
+	if x, ok := x.GetCase().(*NumericSummary_NumericStatistics); ok {
+		return x.NumericStatistics
 	}
-	return ""
+	return nil
 }
 
-func (x *GetCardinalityOfColumnRequest) GetPriority() int32 {
-	if x != nil {
-		return x.Priority
+func (x *NumericSummary) GetNumericOutliers() *NumericOutliers {
+	if x, ok := x.GetCase().(*NumericSummary_NumericOutliers); ok {
+		return x.NumericOutliers
 	}
-	return 0
+	return nil
 }
 
-type GenerateTimeSeriesRequest struct {
+type isNumericSummary_Case interface {
+	isNumericSummary_Case()
+}
+
+type NumericSummary_NumericHistogramBins struct {
+	NumericHistogramBins *NumericHistogramBins `protobuf:"bytes,1,opt,name=numeric_histogram_bins,json=numericHistogramBins,proto3,oneof"`
+}
+
+type NumericSummary_NumericStatistics struct {
+	NumericStatistics *NumericStatistics `protobuf:"bytes,2,opt,name=numeric_statistics,json=numericStatistics,proto3,oneof"`
+}
+
+type NumericSummary_NumericOutliers struct {
+	NumericOutliers *NumericOutliers `protobuf:"bytes,3,opt,name=numeric_outliers,json=numericOutliers,proto3,oneof"`
+}
+
+func (*NumericSummary_NumericHistogramBins) isNumericSummary_Case() {}
+
+func (*NumericSummary_NumericStatistics) isNumericSummary_Case() {}
+
+func (*NumericSummary_NumericOutliers) isNumericSummary_Case() {}
+
+type GetRugHistogramResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InstanceId          string                                    `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
-	TableName           string                                    `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
-	Measures            []*GenerateTimeSeriesRequest_BasicMeasure `protobuf:"bytes,3,rep,name=measures,proto3" json:"measures,omitempty"`
-	TimestampColumnName string                                    `protobuf:"bytes,4,opt,name=timestamp_column_name,json=timestampColumnName,proto3" json:"timestamp_column_name,omitempty"`
-	TimeRange           *TimeSeriesTimeRange                      `protobuf:"bytes,5,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
-	Filters             *MetricsViewFilter                        `protobuf:"bytes,6,opt,name=filters,proto3" json:"filters,omitempty"`
-	Pixels              int32                                     `protobuf:"varint,7,opt,name=pixels,proto3" json:"pixels,omitempty"`
-	SampleSize          int32                                     `protobuf:"varint,8,opt,name=sample_size,json=sampleSize,proto3" json:"sample_size,omitempty"`
-	Priority            int32                                     `protobuf:"varint,9,opt,name=priority,proto3" json:"priority,omitempty"`
+	NumericSummary *NumericSummary `protobuf:"bytes,1,opt,name=numeric_summary,json=numericSummary,proto3" json:"numeric_summary,omitempty"`
 }
 
-func (x *GenerateTimeSeriesRequest) Reset() {
-	*x = GenerateTimeSeriesRequest{}
+func (x *GetRugHistogramResponse) Reset() {
+	*x = GetRugHistogramResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rill_runtime_v1_api_proto_msgTypes[78]
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GenerateTimeSeriesRequest) String() string {
+func (x *GetRugHistogramResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateTimeSeriesRequest) ProtoMessage() {}
+func (*GetRugHistogramResponse) ProtoMessage() {}
 
-func (x *GenerateTimeSeriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rill_runtime_v1_api_proto_msgTypes[78]
+func (x *GetRugHistogramResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5451,86 +5821,75 @@ func (x *GenerateTimeSeriesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateTimeSeriesRequest.ProtoReflect.Descriptor instead.
-func (*GenerateTimeSeriesRequest) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{78}
+// Deprecated: Use GetRugHistogramResponse.ProtoReflect.Descriptor instead.
+func (*GetRugHistogramResponse) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *GenerateTimeSeriesRequest) GetInstanceId() string {
+func (x *GetRugHistogramResponse) GetNumericSummary() *NumericSummary {
 	if x != nil {
-		return x.InstanceId
+		return x.NumericSummary
 	}
-	return ""
+	return nil
 }
 
-func (x *GenerateTimeSeriesRequest) GetTableName() string {
-	if x != nil {
-		return x.TableName
-	}
-	return ""
-}
+type GetNumericHistogramResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *GenerateTimeSeriesRequest) GetMeasures() []*GenerateTimeSeriesRequest_BasicMeasure {
-	if x != nil {
-		return x.Measures
-	}
-	return nil
+	NumericSummary *NumericSummary `protobuf:"bytes,1,opt,name=numeric_summary,json=numericSummary,proto3" json:"numeric_summary,omitempty"`
 }
 
-func (x *GenerateTimeSeriesRequest) GetTimestampColumnName() string {
-	if x != nil {
-		return x.TimestampColumnName
+func (x *GetNumericHistogramResponse) Reset() {
+	*x = GetNumericHistogramResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *GenerateTimeSeriesRequest) GetTimeRange() *TimeSeriesTimeRange {
-	if x != nil {
-		return x.TimeRange
-	}
-	return nil
+func (x *GetNumericHistogramResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GenerateTimeSeriesRequest) GetFilters() *MetricsViewFilter {
-	if x != nil {
-		return x.Filters
-	}
-	return nil
-}
+func (*GetNumericHistogramResponse) ProtoMessage() {}
 
-func (x *GenerateTimeSeriesRequest) GetPixels() int32 {
-	if x != nil {
-		return x.Pixels
+func (x *GetNumericHistogramResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GenerateTimeSeriesRequest) GetSampleSize() int32 {
-	if x != nil {
-		return x.SampleSize
-	}
-	return 0
+// Deprecated: Use GetNumericHistogramResponse.ProtoReflect.Descriptor instead.
+func (*GetNumericHistogramResponse) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *GenerateTimeSeriesRequest) GetPriority() int32 {
+func (x *GetNumericHistogramResponse) GetNumericSummary() *NumericSummary {
 	if x != nil {
-		return x.Priority
+		return x.NumericSummary
 	}
-	return 0
+	return nil
 }
 
-type TimeSeriesTimeRange struct {
+type GetDescriptiveStatisticsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Start    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
-	End      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
-	Interval TimeGrain              `protobuf:"varint,4,opt,name=interval,proto3,enum=rill.runtime.v1.TimeGrain" json:"interval,omitempty"`
+	NumericSummary *NumericSummary `protobuf:"bytes,1,opt,name=numeric_summary,json=numericSummary,proto3" json:"numeric_summary,omitempty"`
 }
 
-func (x *TimeSeriesTimeRange) Reset() {
-	*x = TimeSeriesTimeRange{}
+func (x *GetDescriptiveStatisticsResponse) Reset() {
+	*x = GetDescriptiveStatisticsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5538,13 +5897,13 @@ func (x *TimeSeriesTimeRange) Reset() {
 	}
 }
 
-func (x *TimeSeriesTimeRange) String() string {
+func (x *GetDescriptiveStatisticsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TimeSeriesTimeRange) ProtoMessage() {}
+func (*GetDescriptiveStatisticsResponse) ProtoMessage() {}
 
-func (x *TimeSeriesTimeRange) ProtoReflect() protoreflect.Message {
+func (x *GetDescriptiveStatisticsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5556,45 +5915,28 @@ func (x *TimeSeriesTimeRange) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TimeSeriesTimeRange.ProtoReflect.Descriptor instead.
-func (*TimeSeriesTimeRange) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDescriptiveStatisticsResponse.ProtoReflect.Descriptor instead.
+func (*GetDescriptiveStatisticsResponse) Descriptor() ([]byte, []int) {
 	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *TimeSeriesTimeRange) GetStart() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Start
-	}
-	return nil
-}
-
-func (x *TimeSeriesTimeRange) GetEnd() *timestamppb.Timestamp {
+func (x *GetDescriptiveStatisticsResponse) GetNumericSummary() *NumericSummary {
 	if x != nil {
-		return x.End
+		return x.NumericSummary
 	}
 	return nil
 }
 
-func (x *TimeSeriesTimeRange) GetInterval() TimeGrain {
-	if x != nil {
-		return x.Interval
-	}
-	return TimeGrain_TIME_GRAIN_UNSPECIFIED
-}
-
-type TimeSeriesResponse struct {
+type GetTimeRangeSummaryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Results    []*TimeSeriesValue   `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
-	Spark      []*TimeSeriesValue   `protobuf:"bytes,2,rep,name=spark,proto3" json:"spark,omitempty"`
-	TimeRange  *TimeSeriesTimeRange `protobuf:"bytes,3,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
-	SampleSize int32                `protobuf:"varint,4,opt,name=sample_size,json=sampleSize,proto3" json:"sample_size,omitempty"`
+	TimeRangeSummary *TimeRangeSummary `protobuf:"bytes,1,opt,name=time_range_summary,json=timeRangeSummary,proto3" json:"time_range_summary,omitempty"`
 }
 
-func (x *TimeSeriesResponse) Reset() {
-	*x = TimeSeriesResponse{}
+func (x *GetTimeRangeSummaryResponse) Reset() {
+	*x = GetTimeRangeSummaryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5602,13 +5944,13 @@ func (x *TimeSeriesResponse) Reset() {
 	}
 }
 
-func (x *TimeSeriesResponse) String() string {
+func (x *GetTimeRangeSummaryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TimeSeriesResponse) ProtoMessage() {}
+func (*GetTimeRangeSummaryResponse) ProtoMessage() {}
 
-func (x *TimeSeriesResponse) ProtoReflect() protoreflect.Message {
+func (x *GetTimeRangeSummaryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5620,49 +5962,30 @@ func (x *TimeSeriesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TimeSeriesResponse.ProtoReflect.Descriptor instead.
-func (*TimeSeriesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTimeRangeSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetTimeRangeSummaryResponse) Descriptor() ([]byte, []int) {
 	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *TimeSeriesResponse) GetResults() []*TimeSeriesValue {
-	if x != nil {
-		return x.Results
-	}
-	return nil
-}
-
-func (x *TimeSeriesResponse) GetSpark() []*TimeSeriesValue {
-	if x != nil {
-		return x.Spark
-	}
-	return nil
-}
-
-func (x *TimeSeriesResponse) GetTimeRange() *TimeSeriesTimeRange {
+func (x *GetTimeRangeSummaryResponse) GetTimeRangeSummary() *TimeRangeSummary {
 	if x != nil {
-		return x.TimeRange
+		return x.TimeRangeSummary
 	}
 	return nil
 }
 
-func (x *TimeSeriesResponse) GetSampleSize() int32 {
-	if x != nil {
-		return x.SampleSize
-	}
-	return 0
-}
-
-type GenerateTimeSeriesResponse struct {
+type TimeRangeSummary struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Rollup *TimeSeriesResponse `protobuf:"bytes,1,opt,name=rollup,proto3" json:"rollup,omitempty"`
+	Min      *timestamppb.Timestamp     `protobuf:"bytes,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max      *timestamppb.Timestamp     `protobuf:"bytes,2,opt,name=max,proto3" json:"max,omitempty"`
+	Interval *TimeRangeSummary_Interval `protobuf:"bytes,3,opt,name=interval,proto3" json:"interval,omitempty"`
 }
 
-func (x *GenerateTimeSeriesResponse) Reset() {
-	*x = GenerateTimeSeriesResponse{}
+func (x *TimeRangeSummary) Reset() {
+	*x = TimeRangeSummary{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5670,13 +5993,13 @@ func (x *GenerateTimeSeriesResponse) Reset() {
 	}
 }
 
-func (x *GenerateTimeSeriesResponse) String() string {
+func (x *TimeRangeSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateTimeSeriesResponse) ProtoMessage() {}
+func (*TimeRangeSummary) ProtoMessage() {}
 
-func (x *GenerateTimeSeriesResponse) ProtoReflect() protoreflect.Message {
+func (x *TimeRangeSummary) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5688,30 +6011,42 @@ func (x *GenerateTimeSeriesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateTimeSeriesResponse.ProtoReflect.Descriptor instead.
-func (*GenerateTimeSeriesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TimeRangeSummary.ProtoReflect.Descriptor instead.
+func (*TimeRangeSummary) Descriptor() ([]byte, []int) {
 	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *GenerateTimeSeriesResponse) GetRollup() *TimeSeriesResponse {
+func (x *TimeRangeSummary) GetMin() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Rollup
+		return x.Min
 	}
 	return nil
 }
 
-type TimeSeriesValue struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Ts      string             `protobuf:"bytes,1,opt,name=ts,proto3" json:"ts,omitempty"`
-	Bin     *float64           `protobuf:"fixed64,2,opt,name=bin,proto3,oneof" json:"bin,omitempty"`
-	Records map[string]float64 `protobuf:"bytes,3,rep,name=records,proto3" json:"records,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+func (x *TimeRangeSummary) GetMax() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Max
+	}
+	return nil
 }
 
-func (x *TimeSeriesValue) Reset() {
-	*x = TimeSeriesValue{}
+func (x *TimeRangeSummary) GetInterval() *TimeRangeSummary_Interval {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+type NumericOutliers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Outliers []*NumericOutliers_Outlier `protobuf:"bytes,1,rep,name=outliers,proto3" json:"outliers,omitempty"`
+}
+
+func (x *NumericOutliers) Reset() {
+	*x = NumericOutliers{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5719,13 +6054,13 @@ func (x *TimeSeriesValue) Reset() {
 	}
 }
 
-func (x *TimeSeriesValue) String() string {
+func (x *NumericOutliers) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TimeSeriesValue) ProtoMessage() {}
+func (*NumericOutliers) ProtoMessage() {}
 
-func (x *TimeSeriesValue) ProtoReflect() protoreflect.Message {
+func (x *NumericOutliers) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5737,44 +6072,34 @@ func (x *TimeSeriesValue) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TimeSeriesValue.ProtoReflect.Descriptor instead.
-func (*TimeSeriesValue) Descriptor() ([]byte, []int) {
+// Deprecated: Use NumericOutliers.ProtoReflect.Descriptor instead.
+func (*NumericOutliers) Descriptor() ([]byte, []int) {
 	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *TimeSeriesValue) GetTs() string {
-	if x != nil {
-		return x.Ts
-	}
-	return ""
-}
-
-func (x *TimeSeriesValue) GetBin() float64 {
-	if x != nil && x.Bin != nil {
-		return *x.Bin
-	}
-	return 0
-}
-
-func (x *TimeSeriesValue) GetRecords() map[string]float64 {
+func (x *NumericOutliers) GetOutliers() []*NumericOutliers_Outlier {
 	if x != nil {
-		return x.Records
+		return x.Outliers
 	}
 	return nil
 }
 
-type GetTableCardinalityRequest struct {
+// Request for RuntimeService.GetTopK. Returns the top K values for a given column using agg function for table table_name.
+type GetTopKRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
 	TableName  string `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
-	Priority   int32  `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	ColumnName string `protobuf:"bytes,3,opt,name=column_name,json=columnName,proto3" json:"column_name,omitempty"`
+	Agg        string `protobuf:"bytes,4,opt,name=agg,proto3" json:"agg,omitempty"` // default is count(*)
+	K          int32  `protobuf:"varint,5,opt,name=k,proto3" json:"k,omitempty"`    // default is 50
+	Priority   int32  `protobuf:"varint,6,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
-func (x *GetTableCardinalityRequest) Reset() {
-	*x = GetTableCardinalityRequest{}
+func (x *GetTopKRequest) Reset() {
+	*x = GetTopKRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5782,13 +6107,13 @@ func (x *GetTableCardinalityRequest) Reset() {
 	}
 }
 
-func (x *GetTableCardinalityRequest) String() string {
+func (x *GetTopKRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTableCardinalityRequest) ProtoMessage() {}
+func (*GetTopKRequest) ProtoMessage() {}
 
-func (x *GetTableCardinalityRequest) ProtoReflect() protoreflect.Message {
+func (x *GetTopKRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5800,42 +6125,63 @@ func (x *GetTableCardinalityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTableCardinalityRequest.ProtoReflect.Descriptor instead.
-func (*GetTableCardinalityRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTopKRequest.ProtoReflect.Descriptor instead.
+func (*GetTopKRequest) Descriptor() ([]byte, []int) {
 	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *GetTableCardinalityRequest) GetInstanceId() string {
+func (x *GetTopKRequest) GetInstanceId() string {
 	if x != nil {
 		return x.InstanceId
 	}
 	return ""
 }
 
-func (x *GetTableCardinalityRequest) GetTableName() string {
+func (x *GetTopKRequest) GetTableName() string {
 	if x != nil {
 		return x.TableName
 	}
 	return ""
 }
 
-func (x *GetTableCardinalityRequest) GetPriority() int32 {
+func (x *GetTopKRequest) GetColumnName() string {
+	if x != nil {
+		return x.ColumnName
+	}
+	return ""
+}
+
+func (x *GetTopKRequest) GetAgg() string {
+	if x != nil {
+		return x.Agg
+	}
+	return ""
+}
+
+func (x *GetTopKRequest) GetK() int32 {
+	if x != nil {
+		return x.K
+	}
+	return 0
+}
+
+func (x *GetTopKRequest) GetPriority() int32 {
 	if x != nil {
 		return x.Priority
 	}
 	return 0
 }
 
-type GetTableCardinalityResponse struct {
+type TopK struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cardinality int64 `protobuf:"varint,1,opt,name=cardinality,proto3" json:"cardinality,omitempty"`
+	Entries []*TopK_Entry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
 }
 
-func (x *GetTableCardinalityResponse) Reset() {
-	*x = GetTableCardinalityResponse{}
+func (x *TopK) Reset() {
+	*x = TopK{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5843,13 +6189,13 @@ func (x *GetTableCardinalityResponse) Reset() {
 	}
 }
 
-func (x *GetTableCardinalityResponse) String() string {
+func (x *TopK) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTableCardinalityResponse) ProtoMessage() {}
+func (*TopK) ProtoMessage() {}
 
-func (x *GetTableCardinalityResponse) ProtoReflect() protoreflect.Message {
+func (x *TopK) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5861,30 +6207,32 @@ func (x *GetTableCardinalityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTableCardinalityResponse.ProtoReflect.Descriptor instead.
-func (*GetTableCardinalityResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TopK.ProtoReflect.Descriptor instead.
+func (*TopK) Descriptor() ([]byte, []int) {
 	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *GetTableCardinalityResponse) GetCardinality() int64 {
+func (x *TopK) GetEntries() []*TopK_Entry {
 	if x != nil {
-		return x.Cardinality
+		return x.Entries
 	}
-	return 0
+	return nil
 }
 
-type ProfileColumnsRequest struct {
+// Request for RuntimeService.GetNullCount. Returns the null count for a given column for table table_name
+type GetNullCountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
 	TableName  string `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
-	Priority   int32  `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	ColumnName string `protobuf:"bytes,3,opt,name=column_name,json=columnName,proto3" json:"column_name,omitempty"`
+	Priority   int32  `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
-func (x *ProfileColumnsRequest) Reset() {
-	*x = ProfileColumnsRequest{}
+func (x *GetNullCountRequest) Reset() {
+	*x = GetNullCountRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5892,13 +6240,13 @@ func (x *ProfileColumnsRequest) Reset() {
 	}
 }
 
-func (x *ProfileColumnsRequest) String() string {
+func (x *GetNullCountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProfileColumnsRequest) ProtoMessage() {}
+func (*GetNullCountRequest) ProtoMessage() {}
 
-func (x *ProfileColumnsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetNullCountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5910,42 +6258,50 @@ func (x *ProfileColumnsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProfileColumnsRequest.ProtoReflect.Descriptor instead.
-func (*ProfileColumnsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetNullCountRequest.ProtoReflect.Descriptor instead.
+func (*GetNullCountRequest) Descriptor() ([]byte, []int) {
 	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *ProfileColumnsRequest) GetInstanceId() string {
+func (x *GetNullCountRequest) GetInstanceId() string {
 	if x != nil {
 		return x.InstanceId
 	}
 	return ""
 }
 
-func (x *ProfileColumnsRequest) GetTableName() string {
+func (x *GetNullCountRequest) GetTableName() string {
 	if x != nil {
 		return x.TableName
 	}
 	return ""
 }
 
-func (x *ProfileColumnsRequest) GetPriority() int32 {
+func (x *GetNullCountRequest) GetColumnName() string {
+	if x != nil {
+		return x.ColumnName
+	}
+	return ""
+}
+
+func (x *GetNullCountRequest) GetPriority() int32 {
 	if x != nil {
 		return x.Priority
 	}
 	return 0
 }
 
-type ProfileColumnsResponse struct {
+// Response for RuntimeService.GetNullCount
+type GetNullCountResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProfileColumns []*ProfileColumn `protobuf:"bytes,1,rep,name=profile_columns,json=profileColumns,proto3" json:"profile_columns,omitempty"`
+	Count float64 `protobuf:"fixed64,1,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *ProfileColumnsResponse) Reset() {
-	*x = ProfileColumnsResponse{}
+func (x *GetNullCountResponse) Reset() {
+	*x = GetNullCountResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[86]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5953,13 +6309,13 @@ func (x *ProfileColumnsResponse) Reset() {
 	}
 }
 
-func (x *ProfileColumnsResponse) String() string {
+func (x *GetNullCountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProfileColumnsResponse) ProtoMessage() {}
+func (*GetNullCountResponse) ProtoMessage() {}
 
-func (x *ProfileColumnsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetNullCountResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[86]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5971,30 +6327,32 @@ func (x *ProfileColumnsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProfileColumnsResponse.ProtoReflect.Descriptor instead.
-func (*ProfileColumnsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetNullCountResponse.ProtoReflect.Descriptor instead.
+func (*GetNullCountResponse) Descriptor() ([]byte, []int) {
 	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *ProfileColumnsResponse) GetProfileColumns() []*ProfileColumn {
+func (x *GetNullCountResponse) GetCount() float64 {
 	if x != nil {
-		return x.ProfileColumns
+		return x.Count
 	}
-	return nil
+	return 0
 }
 
-type ProfileColumn struct {
+// Request for RuntimeService.GetGetDescriptiveStatisticsRequest. Returns the stats for a given column for table table_name
+type GetDescriptiveStatisticsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name                string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Type                string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	LargestStringLength int32  `protobuf:"varint,3,opt,name=largest_string_length,json=largestStringLength,proto3" json:"largest_string_length,omitempty"`
+	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	TableName  string `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	ColumnName string `protobuf:"bytes,3,opt,name=column_name,json=columnName,proto3" json:"column_name,omitempty"`
+	Priority   int32  `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
-func (x *ProfileColumn) Reset() {
-	*x = ProfileColumn{}
+func (x *GetDescriptiveStatisticsRequest) Reset() {
+	*x = GetDescriptiveStatisticsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[87]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6002,13 +6360,13 @@ func (x *ProfileColumn) Reset() {
 	}
 }
 
-func (x *ProfileColumn) String() string {
+func (x *GetDescriptiveStatisticsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProfileColumn) ProtoMessage() {}
+func (*GetDescriptiveStatisticsRequest) ProtoMessage() {}
 
-func (x *ProfileColumn) ProtoReflect() protoreflect.Message {
+func (x *GetDescriptiveStatisticsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[87]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6020,45 +6378,56 @@ func (x *ProfileColumn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProfileColumn.ProtoReflect.Descriptor instead.
-func (*ProfileColumn) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDescriptiveStatisticsRequest.ProtoReflect.Descriptor instead.
+func (*GetDescriptiveStatisticsRequest) Descriptor() ([]byte, []int) {
 	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *ProfileColumn) GetName() string {
+func (x *GetDescriptiveStatisticsRequest) GetInstanceId() string {
 	if x != nil {
-		return x.Name
+		return x.InstanceId
 	}
 	return ""
 }
 
-func (x *ProfileColumn) GetType() string {
+func (x *GetDescriptiveStatisticsRequest) GetTableName() string {
 	if x != nil {
-		return x.Type
+		return x.TableName
 	}
 	return ""
 }
 
-func (x *ProfileColumn) GetLargestStringLength() int32 {
+func (x *GetDescriptiveStatisticsRequest) GetColumnName() string {
 	if x != nil {
-		return x.LargestStringLength
+		return x.ColumnName
+	}
+	return ""
+}
+
+func (x *GetDescriptiveStatisticsRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
 	}
 	return 0
 }
 
-type GetTableRowsRequest struct {
+// Response for RuntimeService.GetDescriptiveStatistics
+type NumericStatistics struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
-	TableName  string `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
-	Limit      int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Priority   int32  `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	Min  float64 `protobuf:"fixed64,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max  float64 `protobuf:"fixed64,2,opt,name=max,proto3" json:"max,omitempty"`
+	Mean float64 `protobuf:"fixed64,3,opt,name=mean,proto3" json:"mean,omitempty"`
+	Q25  float64 `protobuf:"fixed64,4,opt,name=q25,proto3" json:"q25,omitempty"`
+	Q50  float64 `protobuf:"fixed64,5,opt,name=q50,proto3" json:"q50,omitempty"`
+	Q75  float64 `protobuf:"fixed64,6,opt,name=q75,proto3" json:"q75,omitempty"`
+	Sd   float64 `protobuf:"fixed64,7,opt,name=sd,proto3" json:"sd,omitempty"`
 }
 
-func (x *GetTableRowsRequest) Reset() {
-	*x = GetTableRowsRequest{}
+func (x *NumericStatistics) Reset() {
+	*x = NumericStatistics{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[88]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6066,13 +6435,13 @@ func (x *GetTableRowsRequest) Reset() {
 	}
 }
 
-func (x *GetTableRowsRequest) String() string {
+func (x *NumericStatistics) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTableRowsRequest) ProtoMessage() {}
+func (*NumericStatistics) ProtoMessage() {}
 
-func (x *GetTableRowsRequest) ProtoReflect() protoreflect.Message {
+func (x *NumericStatistics) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[88]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6084,49 +6453,74 @@ func (x *GetTableRowsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTableRowsRequest.ProtoReflect.Descriptor instead.
-func (*GetTableRowsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use NumericStatistics.ProtoReflect.Descriptor instead.
+func (*NumericStatistics) Descriptor() ([]byte, []int) {
 	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *GetTableRowsRequest) GetInstanceId() string {
+func (x *NumericStatistics) GetMin() float64 {
 	if x != nil {
-		return x.InstanceId
+		return x.Min
 	}
-	return ""
+	return 0
 }
 
-func (x *GetTableRowsRequest) GetTableName() string {
+func (x *NumericStatistics) GetMax() float64 {
 	if x != nil {
-		return x.TableName
+		return x.Max
 	}
-	return ""
+	return 0
 }
 
-func (x *GetTableRowsRequest) GetLimit() int32 {
+func (x *NumericStatistics) GetMean() float64 {
 	if x != nil {
-		return x.Limit
+		return x.Mean
 	}
 	return 0
 }
 
-func (x *GetTableRowsRequest) GetPriority() int32 {
+func (x *NumericStatistics) GetQ25() float64 {
 	if x != nil {
-		return x.Priority
+		return x.Q25
 	}
 	return 0
 }
 
-type GetTableRowsResponse struct {
+func (x *NumericStatistics) GetQ50() float64 {
+	if x != nil {
+		return x.Q50
+	}
+	return 0
+}
+
+func (x *NumericStatistics) GetQ75() float64 {
+	if x != nil {
+		return x.Q75
+	}
+	return 0
+}
+
+func (x *NumericStatistics) GetSd() float64 {
+	if x != nil {
+		return x.Sd
+	}
+	return 0
+}
+
+// Request for RuntimeService.EstimateSmallestTimeGrainRequest
+type EstimateSmallestTimeGrainRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data []*structpb.Struct `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	TableName  string `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	ColumnName string `protobuf:"bytes,3,opt,name=column_name,json=columnName,proto3" json:"column_name,omitempty"`
+	Priority   int32  `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
-func (x *GetTableRowsResponse) Reset() {
-	*x = GetTableRowsResponse{}
+func (x *EstimateSmallestTimeGrainRequest) Reset() {
+	*x = EstimateSmallestTimeGrainRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[89]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6134,13 +6528,13 @@ func (x *GetTableRowsResponse) Reset() {
 	}
 }
 
-func (x *GetTableRowsResponse) String() string {
+func (x *EstimateSmallestTimeGrainRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTableRowsResponse) ProtoMessage() {}
+func (*EstimateSmallestTimeGrainRequest) ProtoMessage() {}
 
-func (x *GetTableRowsResponse) ProtoReflect() protoreflect.Message {
+func (x *EstimateSmallestTimeGrainRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[89]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6152,37 +6546,50 @@ func (x *GetTableRowsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTableRowsResponse.ProtoReflect.Descriptor instead.
-func (*GetTableRowsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use EstimateSmallestTimeGrainRequest.ProtoReflect.Descriptor instead.
+func (*EstimateSmallestTimeGrainRequest) Descriptor() ([]byte, []int) {
 	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *GetTableRowsResponse) GetData() []*structpb.Struct {
+func (x *EstimateSmallestTimeGrainRequest) GetInstanceId() string {
 	if x != nil {
-		return x.Data
+		return x.InstanceId
 	}
-	return nil
+	return ""
 }
 
-// Connector represents a connector available in the runtime.
-// It should not be confused with a source.
-type Connector struct {
+func (x *EstimateSmallestTimeGrainRequest) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *EstimateSmallestTimeGrainRequest) GetColumnName() string {
+	if x != nil {
+		return x.ColumnName
+	}
+	return ""
+}
+
+func (x *EstimateSmallestTimeGrainRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+// Response for RuntimeService.EstimateSmallestTimeGrain
+type EstimateSmallestTimeGrainResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Name is the name of the connector (e.g. "CREATE SOURCE foo WITH connector = 'name'")
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Pretty display name for use in UIs
-	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	// Human readable description of the connector
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	// Properties accepted by the connector
-	Properties []*Connector_Property `protobuf:"bytes,4,rep,name=properties,proto3" json:"properties,omitempty"`
+	TimeGrain TimeGrain `protobuf:"varint,1,opt,name=time_grain,json=timeGrain,proto3,enum=rill.runtime.v1.TimeGrain" json:"time_grain,omitempty"`
 }
 
-func (x *Connector) Reset() {
-	*x = Connector{}
+func (x *EstimateSmallestTimeGrainResponse) Reset() {
+	*x = EstimateSmallestTimeGrainResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[90]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6190,13 +6597,13 @@ func (x *Connector) Reset() {
 	}
 }
 
-func (x *Connector) String() string {
+func (x *EstimateSmallestTimeGrainResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Connector) ProtoMessage() {}
+func (*EstimateSmallestTimeGrainResponse) ProtoMessage() {}
 
-func (x *Connector) ProtoReflect() protoreflect.Message {
+func (x *EstimateSmallestTimeGrainResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[90]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6208,48 +6615,32 @@ func (x *Connector) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Connector.ProtoReflect.Descriptor instead.
-func (*Connector) Descriptor() ([]byte, []int) {
+// Deprecated: Use EstimateSmallestTimeGrainResponse.ProtoReflect.Descriptor instead.
+func (*EstimateSmallestTimeGrainResponse) Descriptor() ([]byte, []int) {
 	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *Connector) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Connector) GetDisplayName() string {
-	if x != nil {
-		return x.DisplayName
-	}
-	return ""
-}
-
-func (x *Connector) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *Connector) GetProperties() []*Connector_Property {
+func (x *EstimateSmallestTimeGrainResponse) GetTimeGrain() TimeGrain {
 	if x != nil {
-		return x.Properties
+		return x.TimeGrain
 	}
-	return nil
+	return TimeGrain_TIME_GRAIN_UNSPECIFIED
 }
 
-// Request message for RuntimeService.ListConnectors
-type ListConnectorsRequest struct {
+// Request for RuntimeService.GetNumericHistogram. Returns the histogram for a given column for table table_name
+type GetNumericHistogramRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	TableName  string `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	ColumnName string `protobuf:"bytes,3,opt,name=column_name,json=columnName,proto3" json:"column_name,omitempty"`
+	Priority   int32  `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
-func (x *ListConnectorsRequest) Reset() {
-	*x = ListConnectorsRequest{}
+func (x *GetNumericHistogramRequest) Reset() {
+	*x = GetNumericHistogramRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[91]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6257,13 +6648,13 @@ func (x *ListConnectorsRequest) Reset() {
 	}
 }
 
-func (x *ListConnectorsRequest) String() string {
+func (x *GetNumericHistogramRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListConnectorsRequest) ProtoMessage() {}
+func (*GetNumericHistogramRequest) ProtoMessage() {}
 
-func (x *ListConnectorsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetNumericHistogramRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[91]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6275,22 +6666,50 @@ func (x *ListConnectorsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListConnectorsRequest.ProtoReflect.Descriptor instead.
-func (*ListConnectorsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetNumericHistogramRequest.ProtoReflect.Descriptor instead.
+func (*GetNumericHistogramRequest) Descriptor() ([]byte, []int) {
 	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{91}
 }
 
-// Response message for RuntimeService.ListConnectors
-type ListConnectorsResponse struct {
+func (x *GetNumericHistogramRequest) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *GetNumericHistogramRequest) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *GetNumericHistogramRequest) GetColumnName() string {
+	if x != nil {
+		return x.ColumnName
+	}
+	return ""
+}
+
+func (x *GetNumericHistogramRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+// Response for RuntimeService.GetNumericHistogram
+type NumericHistogramBins struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Connectors []*Connector `protobuf:"bytes,1,rep,name=connectors,proto3" json:"connectors,omitempty"`
+	Bins []*NumericHistogramBins_Bin `protobuf:"bytes,1,rep,name=bins,proto3" json:"bins,omitempty"`
 }
 
-func (x *ListConnectorsResponse) Reset() {
-	*x = ListConnectorsResponse{}
+func (x *NumericHistogramBins) Reset() {
+	*x = NumericHistogramBins{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[92]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6298,13 +6717,13 @@ func (x *ListConnectorsResponse) Reset() {
 	}
 }
 
-func (x *ListConnectorsResponse) String() string {
+func (x *NumericHistogramBins) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListConnectorsResponse) ProtoMessage() {}
+func (*NumericHistogramBins) ProtoMessage() {}
 
-func (x *ListConnectorsResponse) ProtoReflect() protoreflect.Message {
+func (x *NumericHistogramBins) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[92]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6316,30 +6735,32 @@ func (x *ListConnectorsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListConnectorsResponse.ProtoReflect.Descriptor instead.
-func (*ListConnectorsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use NumericHistogramBins.ProtoReflect.Descriptor instead.
+func (*NumericHistogramBins) Descriptor() ([]byte, []int) {
 	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *ListConnectorsResponse) GetConnectors() []*Connector {
+func (x *NumericHistogramBins) GetBins() []*NumericHistogramBins_Bin {
 	if x != nil {
-		return x.Connectors
+		return x.Bins
 	}
 	return nil
 }
 
-// CharLocation is a line and column in a code artifact
-type ReconcileError_CharLocation struct {
+// Request for RuntimeService.GetRugHistogram
+type GetRugHistogramRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Line   uint32 `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
-	Column uint32 `protobuf:"varint,2,opt,name=column,proto3" json:"column,omitempty"`
+	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	TableName  string `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	ColumnName string `protobuf:"bytes,3,opt,name=column_name,json=columnName,proto3" json:"column_name,omitempty"`
+	Priority   int32  `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
-func (x *ReconcileError_CharLocation) Reset() {
-	*x = ReconcileError_CharLocation{}
+func (x *GetRugHistogramRequest) Reset() {
+	*x = GetRugHistogramRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[93]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6347,13 +6768,13 @@ func (x *ReconcileError_CharLocation) Reset() {
 	}
 }
 
-func (x *ReconcileError_CharLocation) String() string {
+func (x *GetRugHistogramRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReconcileError_CharLocation) ProtoMessage() {}
+func (*GetRugHistogramRequest) ProtoMessage() {}
 
-func (x *ReconcileError_CharLocation) ProtoReflect() protoreflect.Message {
+func (x *GetRugHistogramRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[93]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6365,37 +6786,53 @@ func (x *ReconcileError_CharLocation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReconcileError_CharLocation.ProtoReflect.Descriptor instead.
-func (*ReconcileError_CharLocation) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{32, 0}
+// Deprecated: Use GetRugHistogramRequest.ProtoReflect.Descriptor instead.
+func (*GetRugHistogramRequest) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *ReconcileError_CharLocation) GetLine() uint32 {
+func (x *GetRugHistogramRequest) GetInstanceId() string {
 	if x != nil {
-		return x.Line
+		return x.InstanceId
 	}
-	return 0
+	return ""
 }
 
-func (x *ReconcileError_CharLocation) GetColumn() uint32 {
+func (x *GetRugHistogramRequest) GetTableName() string {
 	if x != nil {
-		return x.Column
+		return x.TableName
 	}
-	return 0
+	return ""
 }
 
-type MetricsViewFilter_Cond struct {
+func (x *GetRugHistogramRequest) GetColumnName() string {
+	if x != nil {
+		return x.ColumnName
+	}
+	return ""
+}
+
+func (x *GetRugHistogramRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+// Request for RuntimeService.GetTimeRangeSummary
+type GetTimeRangeSummaryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	In   []*structpb.Value `protobuf:"bytes,2,rep,name=in,proto3" json:"in,omitempty"`
-	Like []string          `protobuf:"bytes,3,rep,name=like,proto3" json:"like,omitempty"`
+	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	TableName  string `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	ColumnName string `protobuf:"bytes,3,opt,name=column_name,json=columnName,proto3" json:"column_name,omitempty"`
+	Priority   int32  `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
-func (x *MetricsViewFilter_Cond) Reset() {
-	*x = MetricsViewFilter_Cond{}
+func (x *GetTimeRangeSummaryRequest) Reset() {
+	*x = GetTimeRangeSummaryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[94]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6403,13 +6840,13 @@ func (x *MetricsViewFilter_Cond) Reset() {
 	}
 }
 
-func (x *MetricsViewFilter_Cond) String() string {
+func (x *GetTimeRangeSummaryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MetricsViewFilter_Cond) ProtoMessage() {}
+func (*GetTimeRangeSummaryRequest) ProtoMessage() {}
 
-func (x *MetricsViewFilter_Cond) ProtoReflect() protoreflect.Message {
+func (x *GetTimeRangeSummaryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[94]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6421,44 +6858,53 @@ func (x *MetricsViewFilter_Cond) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MetricsViewFilter_Cond.ProtoReflect.Descriptor instead.
-func (*MetricsViewFilter_Cond) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{50, 0}
+// Deprecated: Use GetTimeRangeSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetTimeRangeSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *MetricsViewFilter_Cond) GetName() string {
+func (x *GetTimeRangeSummaryRequest) GetInstanceId() string {
 	if x != nil {
-		return x.Name
+		return x.InstanceId
 	}
 	return ""
 }
 
-func (x *MetricsViewFilter_Cond) GetIn() []*structpb.Value {
+func (x *GetTimeRangeSummaryRequest) GetTableName() string {
 	if x != nil {
-		return x.In
+		return x.TableName
 	}
-	return nil
+	return ""
 }
 
-func (x *MetricsViewFilter_Cond) GetLike() []string {
+func (x *GetTimeRangeSummaryRequest) GetColumnName() string {
 	if x != nil {
-		return x.Like
+		return x.ColumnName
 	}
-	return nil
+	return ""
 }
 
-type TimeRangeSummary_Interval struct {
+func (x *GetTimeRangeSummaryRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+// Request for RuntimeService.GetCardinalityOfColumn. Returns the cardinality for a given column for table table_name
+type GetCardinalityOfColumnRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Months int32 `protobuf:"varint,1,opt,name=months,proto3" json:"months,omitempty"`
-	Days   int32 `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`
-	Micros int64 `protobuf:"varint,3,opt,name=micros,proto3" json:"micros,omitempty"`
+	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	TableName  string `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	ColumnName string `protobuf:"bytes,3,opt,name=column_name,json=columnName,proto3" json:"column_name,omitempty"`
+	Priority   int32  `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
-func (x *TimeRangeSummary_Interval) Reset() {
-	*x = TimeRangeSummary_Interval{}
+func (x *GetCardinalityOfColumnRequest) Reset() {
+	*x = GetCardinalityOfColumnRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[95]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6466,13 +6912,13 @@ func (x *TimeRangeSummary_Interval) Reset() {
 	}
 }
 
-func (x *TimeRangeSummary_Interval) String() string {
+func (x *GetCardinalityOfColumnRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TimeRangeSummary_Interval) ProtoMessage() {}
+func (*GetCardinalityOfColumnRequest) ProtoMessage() {}
 
-func (x *TimeRangeSummary_Interval) ProtoReflect() protoreflect.Message {
+func (x *GetCardinalityOfColumnRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[95]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6484,46 +6930,57 @@ func (x *TimeRangeSummary_Interval) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TimeRangeSummary_Interval.ProtoReflect.Descriptor instead.
-func (*TimeRangeSummary_Interval) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{63, 0}
+// Deprecated: Use GetCardinalityOfColumnRequest.ProtoReflect.Descriptor instead.
+func (*GetCardinalityOfColumnRequest) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *TimeRangeSummary_Interval) GetMonths() int32 {
+func (x *GetCardinalityOfColumnRequest) GetInstanceId() string {
 	if x != nil {
-		return x.Months
+		return x.InstanceId
 	}
-	return 0
+	return ""
 }
 
-func (x *TimeRangeSummary_Interval) GetDays() int32 {
+func (x *GetCardinalityOfColumnRequest) GetTableName() string {
 	if x != nil {
-		return x.Days
+		return x.TableName
 	}
-	return 0
+	return ""
 }
 
-func (x *TimeRangeSummary_Interval) GetMicros() int64 {
+func (x *GetCardinalityOfColumnRequest) GetColumnName() string {
 	if x != nil {
-		return x.Micros
+		return x.ColumnName
+	}
+	return ""
+}
+
+func (x *GetCardinalityOfColumnRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
 	}
 	return 0
 }
 
-type NumericOutliers_Outlier struct {
+type GenerateTimeSeriesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Bucket  int32   `protobuf:"varint,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
-	Low     float64 `protobuf:"fixed64,2,opt,name=low,proto3" json:"low,omitempty"`
-	High    float64 `protobuf:"fixed64,3,opt,name=high,proto3" json:"high,omitempty"`
-	Present bool    `protobuf:"varint,4,opt,name=present,proto3" json:"present,omitempty"`
-	Count   int32   `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	InstanceId          string                                    `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	TableName           string                                    `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	Measures            []*GenerateTimeSeriesRequest_BasicMeasure `protobuf:"bytes,3,rep,name=measures,proto3" json:"measures,omitempty"`
+	TimestampColumnName string                                    `protobuf:"bytes,4,opt,name=timestamp_column_name,json=timestampColumnName,proto3" json:"timestamp_column_name,omitempty"`
+	TimeRange           *TimeSeriesTimeRange                      `protobuf:"bytes,5,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	Filters             *MetricsViewFilter                        `protobuf:"bytes,6,opt,name=filters,proto3" json:"filters,omitempty"`
+	Pixels              int32                                     `protobuf:"varint,7,opt,name=pixels,proto3" json:"pixels,omitempty"`
+	SampleSize          int32                                     `protobuf:"varint,8,opt,name=sample_size,json=sampleSize,proto3" json:"sample_size,omitempty"`
+	Priority            int32                                     `protobuf:"varint,9,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
-func (x *NumericOutliers_Outlier) Reset() {
-	*x = NumericOutliers_Outlier{}
+func (x *GenerateTimeSeriesRequest) Reset() {
+	*x = GenerateTimeSeriesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[96]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6531,13 +6988,13 @@ func (x *NumericOutliers_Outlier) Reset() {
 	}
 }
 
-func (x *NumericOutliers_Outlier) String() string {
+func (x *GenerateTimeSeriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NumericOutliers_Outlier) ProtoMessage() {}
+func (*GenerateTimeSeriesRequest) ProtoMessage() {}
 
-func (x *NumericOutliers_Outlier) ProtoReflect() protoreflect.Message {
+func (x *GenerateTimeSeriesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[96]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6549,57 +7006,86 @@ func (x *NumericOutliers_Outlier) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NumericOutliers_Outlier.ProtoReflect.Descriptor instead.
-func (*NumericOutliers_Outlier) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{64, 0}
+// Deprecated: Use GenerateTimeSeriesRequest.ProtoReflect.Descriptor instead.
+func (*GenerateTimeSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *NumericOutliers_Outlier) GetBucket() int32 {
+func (x *GenerateTimeSeriesRequest) GetInstanceId() string {
 	if x != nil {
-		return x.Bucket
+		return x.InstanceId
 	}
-	return 0
+	return ""
 }
 
-func (x *NumericOutliers_Outlier) GetLow() float64 {
+func (x *GenerateTimeSeriesRequest) GetTableName() string {
 	if x != nil {
-		return x.Low
+		return x.TableName
 	}
-	return 0
+	return ""
 }
 
-func (x *NumericOutliers_Outlier) GetHigh() float64 {
+func (x *GenerateTimeSeriesRequest) GetMeasures() []*GenerateTimeSeriesRequest_BasicMeasure {
 	if x != nil {
-		return x.High
+		return x.Measures
+	}
+	return nil
+}
+
+func (x *GenerateTimeSeriesRequest) GetTimestampColumnName() string {
+	if x != nil {
+		return x.TimestampColumnName
+	}
+	return ""
+}
+
+func (x *GenerateTimeSeriesRequest) GetTimeRange() *TimeSeriesTimeRange {
+	if x != nil {
+		return x.TimeRange
+	}
+	return nil
+}
+
+func (x *GenerateTimeSeriesRequest) GetFilters() *MetricsViewFilter {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *GenerateTimeSeriesRequest) GetPixels() int32 {
+	if x != nil {
+		return x.Pixels
 	}
 	return 0
 }
 
-func (x *NumericOutliers_Outlier) GetPresent() bool {
+func (x *GenerateTimeSeriesRequest) GetSampleSize() int32 {
 	if x != nil {
-		return x.Present
+		return x.SampleSize
 	}
-	return false
+	return 0
 }
 
-func (x *NumericOutliers_Outlier) GetCount() int32 {
+func (x *GenerateTimeSeriesRequest) GetPriority() int32 {
 	if x != nil {
-		return x.Count
+		return x.Priority
 	}
 	return 0
 }
 
-type TopK_Entry struct {
+type TimeSeriesTimeRange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value *structpb.Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
-	Count float64         `protobuf:"fixed64,2,opt,name=count,proto3" json:"count,omitempty"`
+	Start    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	Interval TimeGrain              `protobuf:"varint,4,opt,name=interval,proto3,enum=rill.runtime.v1.TimeGrain" json:"interval,omitempty"`
 }
 
-func (x *TopK_Entry) Reset() {
-	*x = TopK_Entry{}
+func (x *TimeSeriesTimeRange) Reset() {
+	*x = TimeSeriesTimeRange{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[97]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6607,13 +7093,13 @@ func (x *TopK_Entry) Reset() {
 	}
 }
 
-func (x *TopK_Entry) String() string {
+func (x *TimeSeriesTimeRange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TopK_Entry) ProtoMessage() {}
+func (*TimeSeriesTimeRange) ProtoMessage() {}
 
-func (x *TopK_Entry) ProtoReflect() protoreflect.Message {
+func (x *TimeSeriesTimeRange) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[97]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6625,38 +7111,45 @@ func (x *TopK_Entry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TopK_Entry.ProtoReflect.Descriptor instead.
-func (*TopK_Entry) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{66, 0}
+// Deprecated: Use TimeSeriesTimeRange.ProtoReflect.Descriptor instead.
+func (*TimeSeriesTimeRange) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *TopK_Entry) GetValue() *structpb.Value {
+func (x *TimeSeriesTimeRange) GetStart() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Value
+		return x.Start
 	}
 	return nil
 }
 
-func (x *TopK_Entry) GetCount() float64 {
+func (x *TimeSeriesTimeRange) GetEnd() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Count
+		return x.End
 	}
-	return 0
+	return nil
 }
 
-type NumericHistogramBins_Bin struct {
+func (x *TimeSeriesTimeRange) GetInterval() TimeGrain {
+	if x != nil {
+		return x.Interval
+	}
+	return TimeGrain_TIME_GRAIN_UNSPECIFIED
+}
+
+type TimeSeriesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Bucket int32   `protobuf:"varint,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
-	Low    float64 `protobuf:"fixed64,2,opt,name=low,proto3" json:"low,omitempty"`
-	High   float64 `protobuf:"fixed64,3,opt,name=high,proto3" json:"high,omitempty"`
-	Count  float64 `protobuf:"fixed64,4,opt,name=count,proto3" json:"count,omitempty"`
+	Results    []*TimeSeriesValue   `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Spark      []*TimeSeriesValue   `protobuf:"bytes,2,rep,name=spark,proto3" json:"spark,omitempty"`
+	TimeRange  *TimeSeriesTimeRange `protobuf:"bytes,3,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	SampleSize int32                `protobuf:"varint,4,opt,name=sample_size,json=sampleSize,proto3" json:"sample_size,omitempty"`
 }
 
-func (x *NumericHistogramBins_Bin) Reset() {
-	*x = NumericHistogramBins_Bin{}
+func (x *TimeSeriesResponse) Reset() {
+	*x = TimeSeriesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[98]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6664,13 +7157,13 @@ func (x *NumericHistogramBins_Bin) Reset() {
 	}
 }
 
-func (x *NumericHistogramBins_Bin) String() string {
+func (x *TimeSeriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NumericHistogramBins_Bin) ProtoMessage() {}
+func (*TimeSeriesResponse) ProtoMessage() {}
 
-func (x *NumericHistogramBins_Bin) ProtoReflect() protoreflect.Message {
+func (x *TimeSeriesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[98]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6682,53 +7175,49 @@ func (x *NumericHistogramBins_Bin) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NumericHistogramBins_Bin.ProtoReflect.Descriptor instead.
-func (*NumericHistogramBins_Bin) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{74, 0}
+// Deprecated: Use TimeSeriesResponse.ProtoReflect.Descriptor instead.
+func (*TimeSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *NumericHistogramBins_Bin) GetBucket() int32 {
+func (x *TimeSeriesResponse) GetResults() []*TimeSeriesValue {
 	if x != nil {
-		return x.Bucket
+		return x.Results
 	}
-	return 0
+	return nil
 }
 
-func (x *NumericHistogramBins_Bin) GetLow() float64 {
+func (x *TimeSeriesResponse) GetSpark() []*TimeSeriesValue {
 	if x != nil {
-		return x.Low
+		return x.Spark
 	}
-	return 0
+	return nil
 }
 
-func (x *NumericHistogramBins_Bin) GetHigh() float64 {
+func (x *TimeSeriesResponse) GetTimeRange() *TimeSeriesTimeRange {
 	if x != nil {
-		return x.High
+		return x.TimeRange
 	}
-	return 0
+	return nil
 }
 
-func (x *NumericHistogramBins_Bin) GetCount() float64 {
+func (x *TimeSeriesResponse) GetSampleSize() int32 {
 	if x != nil {
-		return x.Count
+		return x.SampleSize
 	}
 	return 0
 }
 
-type GenerateTimeSeriesRequest_BasicMeasure struct {
+type GenerateTimeSeriesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// mandatory user defined metadata
-	Expression string `protobuf:"bytes,2,opt,name=expression,proto3" json:"expression,omitempty"`
-	// optional user defined metadata
-	SqlName string `protobuf:"bytes,3,opt,name=sql_name,json=sqlName,proto3" json:"sql_name,omitempty"`
+	Rollup *TimeSeriesResponse `protobuf:"bytes,1,opt,name=rollup,proto3" json:"rollup,omitempty"`
 }
 
-func (x *GenerateTimeSeriesRequest_BasicMeasure) Reset() {
-	*x = GenerateTimeSeriesRequest_BasicMeasure{}
+func (x *GenerateTimeSeriesResponse) Reset() {
+	*x = GenerateTimeSeriesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[99]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6736,13 +7225,13 @@ func (x *GenerateTimeSeriesRequest_BasicMeasure) Reset() {
 	}
 }
 
-func (x *GenerateTimeSeriesRequest_BasicMeasure) String() string {
+func (x *GenerateTimeSeriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateTimeSeriesRequest_BasicMeasure) ProtoMessage() {}
+func (*GenerateTimeSeriesResponse) ProtoMessage() {}
 
-func (x *GenerateTimeSeriesRequest_BasicMeasure) ProtoReflect() protoreflect.Message {
+func (x *GenerateTimeSeriesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[99]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6754,58 +7243,93 @@ func (x *GenerateTimeSeriesRequest_BasicMeasure) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateTimeSeriesRequest_BasicMeasure.ProtoReflect.Descriptor instead.
-func (*GenerateTimeSeriesRequest_BasicMeasure) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{78, 0}
+// Deprecated: Use GenerateTimeSeriesResponse.ProtoReflect.Descriptor instead.
+func (*GenerateTimeSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *GenerateTimeSeriesRequest_BasicMeasure) GetId() string {
+func (x *GenerateTimeSeriesResponse) GetRollup() *TimeSeriesResponse {
 	if x != nil {
-		return x.Id
+		return x.Rollup
 	}
-	return ""
+	return nil
 }
 
-func (x *GenerateTimeSeriesRequest_BasicMeasure) GetExpression() string {
+type TimeSeriesValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ts      string             `protobuf:"bytes,1,opt,name=ts,proto3" json:"ts,omitempty"`
+	Bin     *float64           `protobuf:"fixed64,2,opt,name=bin,proto3,oneof" json:"bin,omitempty"`
+	Records map[string]float64 `protobuf:"bytes,3,rep,name=records,proto3" json:"records,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+}
+
+func (x *TimeSeriesValue) Reset() {
+	*x = TimeSeriesValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimeSeriesValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeSeriesValue) ProtoMessage() {}
+
+func (x *TimeSeriesValue) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeSeriesValue.ProtoReflect.Descriptor instead.
+func (*TimeSeriesValue) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *TimeSeriesValue) GetTs() string {
 	if x != nil {
-		return x.Expression
+		return x.Ts
 	}
 	return ""
 }
 
-func (x *GenerateTimeSeriesRequest_BasicMeasure) GetSqlName() string {
+func (x *TimeSeriesValue) GetBin() float64 {
+	if x != nil && x.Bin != nil {
+		return *x.Bin
+	}
+	return 0
+}
+
+func (x *TimeSeriesValue) GetRecords() map[string]float64 {
 	if x != nil {
-		return x.SqlName
+		return x.Records
 	}
-	return ""
+	return nil
 }
 
-// Property represents one of the connector's config properties
-type Connector_Property struct {
+type GetTableCardinalityRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The property key
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	// Pretty name for the property
-	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	// Human readable description of the field
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	// Placeholder value for use in UIs
-	Placeholder string `protobuf:"bytes,4,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
-	// The type expected for this property
-	Type Connector_Property_Type `protobuf:"varint,5,opt,name=type,proto3,enum=rill.runtime.v1.Connector_Property_Type" json:"type,omitempty"`
-	// Nullable is true if the field is optional
-	Nullable bool `protobuf:"varint,6,opt,name=nullable,proto3" json:"nullable,omitempty"`
-	// Additional textual explanation for use in UIs
-	Hint string `protobuf:"bytes,7,opt,name=hint,proto3" json:"hint,omitempty"`
-	// Link to documentation for this property
-	Href string `protobuf:"bytes,8,opt,name=href,proto3" json:"href,omitempty"`
+	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	TableName  string `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	Priority   int32  `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
-func (x *Connector_Property) Reset() {
-	*x = Connector_Property{}
+func (x *GetTableCardinalityRequest) Reset() {
+	*x = GetTableCardinalityRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rill_runtime_v1_api_proto_msgTypes[101]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6813,13 +7337,13 @@ func (x *Connector_Property) Reset() {
 	}
 }
 
-func (x *Connector_Property) String() string {
+func (x *GetTableCardinalityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Connector_Property) ProtoMessage() {}
+func (*GetTableCardinalityRequest) ProtoMessage() {}
 
-func (x *Connector_Property) ProtoReflect() protoreflect.Message {
+func (x *GetTableCardinalityRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rill_runtime_v1_api_proto_msgTypes[101]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6831,73 +7355,1104 @@ func (x *Connector_Property) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Connector_Property.ProtoReflect.Descriptor instead.
-func (*Connector_Property) Descriptor() ([]byte, []int) {
-	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{90, 0}
+// Deprecated: Use GetTableCardinalityRequest.ProtoReflect.Descriptor instead.
+func (*GetTableCardinalityRequest) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *Connector_Property) GetKey() string {
+func (x *GetTableCardinalityRequest) GetInstanceId() string {
 	if x != nil {
-		return x.Key
+		return x.InstanceId
 	}
 	return ""
 }
 
-func (x *Connector_Property) GetDisplayName() string {
+func (x *GetTableCardinalityRequest) GetTableName() string {
 	if x != nil {
-		return x.DisplayName
+		return x.TableName
 	}
 	return ""
 }
 
-func (x *Connector_Property) GetDescription() string {
+func (x *GetTableCardinalityRequest) GetPriority() int32 {
 	if x != nil {
-		return x.Description
+		return x.Priority
 	}
-	return ""
+	return 0
 }
 
-func (x *Connector_Property) GetPlaceholder() string {
-	if x != nil {
-		return x.Placeholder
+type GetTableCardinalityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cardinality int64 `protobuf:"varint,1,opt,name=cardinality,proto3" json:"cardinality,omitempty"`
+}
+
+func (x *GetTableCardinalityResponse) Reset() {
+	*x = GetTableCardinalityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Connector_Property) GetType() Connector_Property_Type {
-	if x != nil {
-		return x.Type
+func (x *GetTableCardinalityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTableCardinalityResponse) ProtoMessage() {}
+
+func (x *GetTableCardinalityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return Connector_Property_TYPE_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *Connector_Property) GetNullable() bool {
+// Deprecated: Use GetTableCardinalityResponse.ProtoReflect.Descriptor instead.
+func (*GetTableCardinalityResponse) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *GetTableCardinalityResponse) GetCardinality() int64 {
 	if x != nil {
-		return x.Nullable
+		return x.Cardinality
 	}
-	return false
+	return 0
 }
 
-func (x *Connector_Property) GetHint() string {
+type ProfileColumnsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	TableName  string `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	Priority   int32  `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+}
+
+func (x *ProfileColumnsRequest) Reset() {
+	*x = ProfileColumnsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProfileColumnsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileColumnsRequest) ProtoMessage() {}
+
+func (x *ProfileColumnsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileColumnsRequest.ProtoReflect.Descriptor instead.
+func (*ProfileColumnsRequest) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *ProfileColumnsRequest) GetInstanceId() string {
 	if x != nil {
-		return x.Hint
+		return x.InstanceId
 	}
 	return ""
 }
 
-func (x *Connector_Property) GetHref() string {
+func (x *ProfileColumnsRequest) GetTableName() string {
 	if x != nil {
-		return x.Href
+		return x.TableName
 	}
 	return ""
 }
 
-var File_rill_runtime_v1_api_proto protoreflect.FileDescriptor
+func (x *ProfileColumnsRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
 
-var file_rill_runtime_v1_api_proto_rawDesc = []byte{
-	0x0a, 0x19, 0x72, 0x69, 0x6c, 0x6c, 0x2f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2f, 0x76,
-	0x31, 0x2f, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x72, 0x69, 0x6c,
-	0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f,
+type ProfileColumnsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProfileColumns []*ProfileColumn `protobuf:"bytes,1,rep,name=profile_columns,json=profileColumns,proto3" json:"profile_columns,omitempty"`
+}
+
+func (x *ProfileColumnsResponse) Reset() {
+	*x = ProfileColumnsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProfileColumnsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileColumnsResponse) ProtoMessage() {}
+
+func (x *ProfileColumnsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileColumnsResponse.ProtoReflect.Descriptor instead.
+func (*ProfileColumnsResponse) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *ProfileColumnsResponse) GetProfileColumns() []*ProfileColumn {
+	if x != nil {
+		return x.ProfileColumns
+	}
+	return nil
+}
+
+type ProfileColumn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type                string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	LargestStringLength int32  `protobuf:"varint,3,opt,name=largest_string_length,json=largestStringLength,proto3" json:"largest_string_length,omitempty"`
+}
+
+func (x *ProfileColumn) Reset() {
+	*x = ProfileColumn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProfileColumn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileColumn) ProtoMessage() {}
+
+func (x *ProfileColumn) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileColumn.ProtoReflect.Descriptor instead.
+func (*ProfileColumn) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *ProfileColumn) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProfileColumn) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ProfileColumn) GetLargestStringLength() int32 {
+	if x != nil {
+		return x.LargestStringLength
+	}
+	return 0
+}
+
+type GetTableRowsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	TableName  string `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	Limit      int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Priority   int32  `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+}
+
+func (x *GetTableRowsRequest) Reset() {
+	*x = GetTableRowsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTableRowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTableRowsRequest) ProtoMessage() {}
+
+func (x *GetTableRowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTableRowsRequest.ProtoReflect.Descriptor instead.
+func (*GetTableRowsRequest) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *GetTableRowsRequest) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *GetTableRowsRequest) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *GetTableRowsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetTableRowsRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+type GetTableRowsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*structpb.Struct `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetTableRowsResponse) Reset() {
+	*x = GetTableRowsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTableRowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTableRowsResponse) ProtoMessage() {}
+
+func (x *GetTableRowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTableRowsResponse.ProtoReflect.Descriptor instead.
+func (*GetTableRowsResponse) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *GetTableRowsResponse) GetData() []*structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Connector represents a connector available in the runtime.
+// It should not be confused with a source.
+type Connector struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name is the name of the connector (e.g. "CREATE SOURCE foo WITH connector = 'name'")
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Pretty display name for use in UIs
+	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// Human readable description of the connector
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Properties accepted by the connector
+	Properties []*Connector_Property `protobuf:"bytes,4,rep,name=properties,proto3" json:"properties,omitempty"`
+}
+
+func (x *Connector) Reset() {
+	*x = Connector{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Connector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Connector) ProtoMessage() {}
+
+func (x *Connector) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Connector.ProtoReflect.Descriptor instead.
+func (*Connector) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *Connector) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Connector) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Connector) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Connector) GetProperties() []*Connector_Property {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+// Request message for RuntimeService.ListConnectors
+type ListConnectorsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListConnectorsRequest) Reset() {
+	*x = ListConnectorsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListConnectorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConnectorsRequest) ProtoMessage() {}
+
+func (x *ListConnectorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConnectorsRequest.ProtoReflect.Descriptor instead.
+func (*ListConnectorsRequest) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{109}
+}
+
+// Response message for RuntimeService.ListConnectors
+type ListConnectorsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Connectors []*Connector `protobuf:"bytes,1,rep,name=connectors,proto3" json:"connectors,omitempty"`
+}
+
+func (x *ListConnectorsResponse) Reset() {
+	*x = ListConnectorsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListConnectorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConnectorsResponse) ProtoMessage() {}
+
+func (x *ListConnectorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConnectorsResponse.ProtoReflect.Descriptor instead.
+func (*ListConnectorsResponse) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *ListConnectorsResponse) GetConnectors() []*Connector {
+	if x != nil {
+		return x.Connectors
+	}
+	return nil
+}
+
+// CharLocation is a line and column in a code artifact
+type ReconcileError_CharLocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Line   uint32 `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Column uint32 `protobuf:"varint,2,opt,name=column,proto3" json:"column,omitempty"`
+}
+
+func (x *ReconcileError_CharLocation) Reset() {
+	*x = ReconcileError_CharLocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReconcileError_CharLocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileError_CharLocation) ProtoMessage() {}
+
+func (x *ReconcileError_CharLocation) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileError_CharLocation.ProtoReflect.Descriptor instead.
+func (*ReconcileError_CharLocation) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{48, 0}
+}
+
+func (x *ReconcileError_CharLocation) GetLine() uint32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ReconcileError_CharLocation) GetColumn() uint32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+type MetricsViewFilter_Cond struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	In   []*structpb.Value `protobuf:"bytes,2,rep,name=in,proto3" json:"in,omitempty"`
+	Like []string          `protobuf:"bytes,3,rep,name=like,proto3" json:"like,omitempty"`
+}
+
+func (x *MetricsViewFilter_Cond) Reset() {
+	*x = MetricsViewFilter_Cond{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetricsViewFilter_Cond) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricsViewFilter_Cond) ProtoMessage() {}
+
+func (x *MetricsViewFilter_Cond) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricsViewFilter_Cond.ProtoReflect.Descriptor instead.
+func (*MetricsViewFilter_Cond) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{68, 0}
+}
+
+func (x *MetricsViewFilter_Cond) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MetricsViewFilter_Cond) GetIn() []*structpb.Value {
+	if x != nil {
+		return x.In
+	}
+	return nil
+}
+
+func (x *MetricsViewFilter_Cond) GetLike() []string {
+	if x != nil {
+		return x.Like
+	}
+	return nil
+}
+
+type TimeRangeSummary_Interval struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Months int32 `protobuf:"varint,1,opt,name=months,proto3" json:"months,omitempty"`
+	Days   int32 `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`
+	Micros int64 `protobuf:"varint,3,opt,name=micros,proto3" json:"micros,omitempty"`
+}
+
+func (x *TimeRangeSummary_Interval) Reset() {
+	*x = TimeRangeSummary_Interval{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimeRangeSummary_Interval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeRangeSummary_Interval) ProtoMessage() {}
+
+func (x *TimeRangeSummary_Interval) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeRangeSummary_Interval.ProtoReflect.Descriptor instead.
+func (*TimeRangeSummary_Interval) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{81, 0}
+}
+
+func (x *TimeRangeSummary_Interval) GetMonths() int32 {
+	if x != nil {
+		return x.Months
+	}
+	return 0
+}
+
+func (x *TimeRangeSummary_Interval) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *TimeRangeSummary_Interval) GetMicros() int64 {
+	if x != nil {
+		return x.Micros
+	}
+	return 0
+}
+
+type NumericOutliers_Outlier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bucket  int32   `protobuf:"varint,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Low     float64 `protobuf:"fixed64,2,opt,name=low,proto3" json:"low,omitempty"`
+	High    float64 `protobuf:"fixed64,3,opt,name=high,proto3" json:"high,omitempty"`
+	Present bool    `protobuf:"varint,4,opt,name=present,proto3" json:"present,omitempty"`
+	Count   int32   `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *NumericOutliers_Outlier) Reset() {
+	*x = NumericOutliers_Outlier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NumericOutliers_Outlier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NumericOutliers_Outlier) ProtoMessage() {}
+
+func (x *NumericOutliers_Outlier) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NumericOutliers_Outlier.ProtoReflect.Descriptor instead.
+func (*NumericOutliers_Outlier) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{82, 0}
+}
+
+func (x *NumericOutliers_Outlier) GetBucket() int32 {
+	if x != nil {
+		return x.Bucket
+	}
+	return 0
+}
+
+func (x *NumericOutliers_Outlier) GetLow() float64 {
+	if x != nil {
+		return x.Low
+	}
+	return 0
+}
+
+func (x *NumericOutliers_Outlier) GetHigh() float64 {
+	if x != nil {
+		return x.High
+	}
+	return 0
+}
+
+func (x *NumericOutliers_Outlier) GetPresent() bool {
+	if x != nil {
+		return x.Present
+	}
+	return false
+}
+
+func (x *NumericOutliers_Outlier) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type TopK_Entry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value *structpb.Value `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Count float64         `protobuf:"fixed64,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *TopK_Entry) Reset() {
+	*x = TopK_Entry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopK_Entry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopK_Entry) ProtoMessage() {}
+
+func (x *TopK_Entry) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopK_Entry.ProtoReflect.Descriptor instead.
+func (*TopK_Entry) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{84, 0}
+}
+
+func (x *TopK_Entry) GetValue() *structpb.Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *TopK_Entry) GetCount() float64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type NumericHistogramBins_Bin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bucket int32   `protobuf:"varint,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Low    float64 `protobuf:"fixed64,2,opt,name=low,proto3" json:"low,omitempty"`
+	High   float64 `protobuf:"fixed64,3,opt,name=high,proto3" json:"high,omitempty"`
+	Count  float64 `protobuf:"fixed64,4,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *NumericHistogramBins_Bin) Reset() {
+	*x = NumericHistogramBins_Bin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NumericHistogramBins_Bin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NumericHistogramBins_Bin) ProtoMessage() {}
+
+func (x *NumericHistogramBins_Bin) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NumericHistogramBins_Bin.ProtoReflect.Descriptor instead.
+func (*NumericHistogramBins_Bin) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{92, 0}
+}
+
+func (x *NumericHistogramBins_Bin) GetBucket() int32 {
+	if x != nil {
+		return x.Bucket
+	}
+	return 0
+}
+
+func (x *NumericHistogramBins_Bin) GetLow() float64 {
+	if x != nil {
+		return x.Low
+	}
+	return 0
+}
+
+func (x *NumericHistogramBins_Bin) GetHigh() float64 {
+	if x != nil {
+		return x.High
+	}
+	return 0
+}
+
+func (x *NumericHistogramBins_Bin) GetCount() float64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GenerateTimeSeriesRequest_BasicMeasure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// mandatory user defined metadata
+	Expression string `protobuf:"bytes,2,opt,name=expression,proto3" json:"expression,omitempty"`
+	// optional user defined metadata
+	SqlName string `protobuf:"bytes,3,opt,name=sql_name,json=sqlName,proto3" json:"sql_name,omitempty"`
+}
+
+func (x *GenerateTimeSeriesRequest_BasicMeasure) Reset() {
+	*x = GenerateTimeSeriesRequest_BasicMeasure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateTimeSeriesRequest_BasicMeasure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateTimeSeriesRequest_BasicMeasure) ProtoMessage() {}
+
+func (x *GenerateTimeSeriesRequest_BasicMeasure) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateTimeSeriesRequest_BasicMeasure.ProtoReflect.Descriptor instead.
+func (*GenerateTimeSeriesRequest_BasicMeasure) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{96, 0}
+}
+
+func (x *GenerateTimeSeriesRequest_BasicMeasure) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GenerateTimeSeriesRequest_BasicMeasure) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *GenerateTimeSeriesRequest_BasicMeasure) GetSqlName() string {
+	if x != nil {
+		return x.SqlName
+	}
+	return ""
+}
+
+// Property represents one of the connector's config properties
+type Connector_Property struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The property key
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Pretty name for the property
+	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// Human readable description of the field
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Placeholder value for use in UIs
+	Placeholder string `protobuf:"bytes,4,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
+	// The type expected for this property
+	Type Connector_Property_Type `protobuf:"varint,5,opt,name=type,proto3,enum=rill.runtime.v1.Connector_Property_Type" json:"type,omitempty"`
+	// Nullable is true if the field is optional
+	Nullable bool `protobuf:"varint,6,opt,name=nullable,proto3" json:"nullable,omitempty"`
+	// Additional textual explanation for use in UIs
+	Hint string `protobuf:"bytes,7,opt,name=hint,proto3" json:"hint,omitempty"`
+	// Link to documentation for this property
+	Href string `protobuf:"bytes,8,opt,name=href,proto3" json:"href,omitempty"`
+}
+
+func (x *Connector_Property) Reset() {
+	*x = Connector_Property{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rill_runtime_v1_api_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Connector_Property) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Connector_Property) ProtoMessage() {}
+
+func (x *Connector_Property) ProtoReflect() protoreflect.Message {
+	mi := &file_rill_runtime_v1_api_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Connector_Property.ProtoReflect.Descriptor instead.
+func (*Connector_Property) Descriptor() ([]byte, []int) {
+	return file_rill_runtime_v1_api_proto_rawDescGZIP(), []int{108, 0}
+}
+
+func (x *Connector_Property) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Connector_Property) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Connector_Property) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Connector_Property) GetPlaceholder() string {
+	if x != nil {
+		return x.Placeholder
+	}
+	return ""
+}
+
+func (x *Connector_Property) GetType() Connector_Property_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Connector_Property_TYPE_UNSPECIFIED
+}
+
+func (x *Connector_Property) GetNullable() bool {
+	if x != nil {
+		return x.Nullable
+	}
+	return false
+}
+
+func (x *Connector_Property) GetHint() string {
+	if x != nil {
+		return x.Hint
+	}
+	return ""
+}
+
+func (x *Connector_Property) GetHref() string {
+	if x != nil {
+		return x.Href
+	}
+	return ""
+}
+
+var File_rill_runtime_v1_api_proto protoreflect.FileDescriptor
+
+var file_rill_runtime_v1_api_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x72, 0x69, 0x6c, 0x6c, 0x2f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2f, 0x76,
+	0x31, 0x2f, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x72, 0x69, 0x6c,
+	0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
 	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75,
@@ -6914,7 +8469,7 @@ var file_rill_runtime_v1_api_proto_rawDesc = []byte{
 	0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
 	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22,
-	0xc8, 0x01, 0x0a, 0x08, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0xd3, 0x03, 0x0a, 0x08, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
 	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a,
 	0x0b, 0x6f, 0x6c, 0x61, 0x70, 0x5f, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
@@ -6926,437 +8481,908 @@ var file_rill_runtime_v1_api_proto_rawDesc = []byte{
 	0x70, 0x6f, 0x5f, 0x64, 0x73, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65,
 	0x70, 0x6f, 0x44, 0x73, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x5f, 0x63,
 	0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x6d,
-	0x62, 0x65, 0x64, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x22, 0x52, 0x0a, 0x14, 0x4c, 0x69,
-	0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
-	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x78,
-	0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x69, 0x6c,
-	0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73,
-	0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50,
-	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x35, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x62, 0x65, 0x64, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x12, 0x28, 0x0a, 0x10, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x4d, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x6d, 0x61,
+	0x78, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x4d, 0x61, 0x78, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x4d, 0x61, 0x78, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x46, 0x0a, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x56, 0x61,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x76, 0x61,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x1a, 0x3c, 0x0a, 0x0e, 0x56, 0x61, 0x72, 0x69, 0x61,
+	0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x52, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x78, 0x0a, 0x15, 0x4c, 0x69, 0x73,
+	0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x35, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x4c, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x35, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xed, 0x03, 0x0a, 0x15, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x6c, 0x61, 0x70, 0x5f, 0x64, 0x72, 0x69, 0x76,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x6c, 0x61, 0x70, 0x44, 0x72,
+	0x69, 0x76, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x6c, 0x61, 0x70, 0x5f, 0x64, 0x73, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x6c, 0x61, 0x70, 0x44, 0x73, 0x6e, 0x12,
+	0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72,
+	0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x64, 0x73, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x44, 0x73, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x65,
+	0x6d, 0x62, 0x65, 0x64, 0x5f, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67,
+	0x12, 0x28, 0x0a, 0x10, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x5f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x71, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x61, 0x78, 0x52, 0x6f, 0x77, 0x73,
+	0x12, 0x33, 0x0a, 0x16, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x13, 0x71, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x61, 0x78, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x53, 0x0a, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
+	0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e,
+	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x1a, 0x3c, 0x0a, 0x0e, 0x56, 0x61,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x4f, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xc9, 0x05, 0x0a, 0x13, 0x45, 0x64,
+	0x69, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x49, 0x64, 0x12, 0x24, 0x0a, 0x0b, 0x6f, 0x6c, 0x61, 0x70, 0x5f, 0x64, 0x72, 0x69, 0x76, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x6f, 0x6c, 0x61, 0x70, 0x44,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x6f, 0x6c, 0x61, 0x70,
+	0x5f, 0x64, 0x73, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x6f, 0x6c,
+	0x61, 0x70, 0x44, 0x73, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6f,
+	0x5f, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52,
+	0x0a, 0x72, 0x65, 0x70, 0x6f, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x1e,
+	0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x64, 0x73, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x03, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x44, 0x73, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x28,
+	0x0a, 0x0d, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x5f, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x04, 0x52, 0x0c, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x43, 0x61,
+	0x74, 0x61, 0x6c, 0x6f, 0x67, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x10, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x05, 0x52, 0x0e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x4d, 0x73, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0e, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x48,
+	0x06, 0x52, 0x0c, 0x71, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x61, 0x78, 0x52, 0x6f, 0x77, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x38, 0x0a, 0x16, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x6d, 0x61, 0x78, 0x5f,
+	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x07, 0x52, 0x13, 0x71, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x61, 0x78, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x51, 0x0a, 0x09,
+	0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x33, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12,
+	0x27, 0x0a, 0x0f, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
+	0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x56,
+	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x1a, 0x3c, 0x0a, 0x0e, 0x56, 0x61, 0x72, 0x69,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6f, 0x6c, 0x61, 0x70, 0x5f,
+	0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6f, 0x6c, 0x61, 0x70, 0x5f,
+	0x64, 0x73, 0x6e, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x64, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x64, 0x73, 0x6e,
+	0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x5f, 0x63, 0x61, 0x74, 0x61, 0x6c,
+	0x6f, 0x67, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x22, 0x4d, 0x0a, 0x14, 0x45, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a,
+	0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x22, 0x55, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x64, 0x72, 0x6f, 0x70, 0x44, 0x61, 0x74, 0x61, 0x22, 0x93, 0x02, 0x0a, 0x16,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x65, 0x76, 0x69, 0x63, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x45, 0x76, 0x69, 0x63, 0x74, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x1a, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x70,
+	0x75, 0x72, 0x67, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x17, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x43, 0x61, 0x63, 0x68, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x50, 0x75,
+	0x72, 0x67, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x5f,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x45, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f,
+	0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6f, 0x6c, 0x61, 0x70, 0x5f, 0x64, 0x61,
+	0x74, 0x61, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0f, 0x6f, 0x6c, 0x61, 0x70, 0x44, 0x61, 0x74, 0x61, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65,
+	0x64, 0x22, 0x83, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x6c, 0x6f, 0x62, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x67, 0x6c, 0x6f, 0x62, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x51, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x74,
+	0x68, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x61, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x7a, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x62, 0x6c, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x62, 0x6c, 0x6f, 0x62, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x4f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xbd, 0x01, 0x0a, 0x0e, 0x50, 0x75,
+	0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6c, 0x6f, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x62, 0x6c, 0x6f, 0x62, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x29,
+	0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x2e, 0x0a, 0x0f, 0x50, 0x75, 0x74,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x22, 0x48, 0x0a, 0x11, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
 	0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22,
-	0x4c, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e,
-	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xd5, 0x01,
-	0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x6c, 0x61, 0x70,
-	0x5f, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f,
-	0x6c, 0x61, 0x70, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x6c, 0x61,
-	0x70, 0x5f, 0x64, 0x73, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x6c, 0x61,
-	0x70, 0x44, 0x73, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x64, 0x72, 0x69,
-	0x76, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x44,
-	0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x64, 0x73,
-	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x44, 0x73, 0x6e,
-	0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x5f, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f,
-	0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x43, 0x61,
-	0x74, 0x61, 0x6c, 0x6f, 0x67, 0x22, 0x4f, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x35, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x38, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64,
-	0x22, 0x18, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47, 0x0a, 0x10, 0x4c, 0x69,
-	0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6a, 0x0a, 0x11, 0x52, 0x65, 0x6e,
+	0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
 	0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x67, 0x6c, 0x6f, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x67,
-	0x6c, 0x6f, 0x62, 0x22, 0x29, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x22, 0x45,
-	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x60, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6c, 0x6f, 0x62,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6c, 0x6f, 0x62, 0x12, 0x39, 0x0a, 0x0a,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x4f, 0x6e, 0x22, 0x92, 0x01, 0x0a, 0x0e, 0x50, 0x75, 0x74, 0x46,
-	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12,
-	0x12, 0x0a, 0x04, 0x62, 0x6c, 0x6f, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62,
-	0x6c, 0x6f, 0x62, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x2e, 0x0a, 0x0f,
-	0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x22, 0x48, 0x0a, 0x11,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x1b, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x50, 0x61, 0x74, 0x68, 0x12, 0x17, 0x0a, 0x07,
+	0x74, 0x6f, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
+	0x6f, 0x50, 0x61, 0x74, 0x68, 0x22, 0x14, 0x0a, 0x12, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc3, 0x01, 0x0a, 0x0c,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4f,
+	0x6e, 0x22, 0x4f, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x22, 0x58, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3b, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x64, 0x0a, 0x11,
+	0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
 	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6a, 0x0a, 0x11,
-	0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x50, 0x61, 0x74, 0x68, 0x12,
-	0x17, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x74, 0x6f, 0x50, 0x61, 0x74, 0x68, 0x22, 0x14, 0x0a, 0x12, 0x52, 0x65, 0x6e, 0x61,
-	0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xcb,
-	0x03, 0x0a, 0x0c, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x05, 0x74, 0x61,
-	0x62, 0x6c, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x06,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e,
-	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x48, 0x00, 0x52,
-	0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x41, 0x0a, 0x0c, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x73, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72,
-	0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x48, 0x00, 0x52, 0x0b, 0x6d, 0x65,
-	0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
-	0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x39, 0x0a,
-	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x14, 0x0a, 0x12, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe3, 0x03, 0x0a, 0x0c, 0x43, 0x61, 0x74,
+	0x61, 0x6c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a,
+	0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72,
+	0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x31, 0x0a,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x2e, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x48, 0x00, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x12, 0x41, 0x0a, 0x0c, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x76, 0x69, 0x65, 0x77,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x56, 0x69, 0x65, 0x77, 0x48, 0x00, 0x52, 0x0b, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56,
+	0x69, 0x65, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x4f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x6e,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x4f, 0x6e, 0x12, 0x3d, 0x0a,
+	0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0b, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x65, 0x64, 0x4f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f,
+	0x6c, 0x64, 0x65, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x6d,
+	0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x45, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x72, 0x69, 0x6c,
+	0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x55, 0x0a,
+	0x1a, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x72,
+	0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x22, 0x4d, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c,
+	0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x4e, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f,
+	0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33,
+	0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e,
+	0x74, 0x72, 0x79, 0x22, 0xad, 0x03, 0x0a, 0x13, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e,
+	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x74, 0x61, 0x6c,
+	0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f,
+	0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x4f, 0x6e, 0x12, 0x3d, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x65, 0x64,
-	0x5f, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x65, 0x64,
-	0x4f, 0x6e, 0x42, 0x08, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x6d, 0x0a, 0x19,
-	0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x69,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e,
-	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x55, 0x0a, 0x1a, 0x4c,
-	0x69, 0x73, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x65, 0x6e, 0x74,
-	0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x69, 0x6c,
-	0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x74,
-	0x61, 0x6c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69,
-	0x65, 0x73, 0x22, 0x4d, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
-	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x22, 0x4e, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x05,
-	0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x69,
-	0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61,
-	0x74, 0x61, 0x6c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72,
-	0x79, 0x22, 0x4c, 0x0a, 0x15, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x66, 0x72,
-	0x65, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
-	0x18, 0x0a, 0x16, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
-	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x0a, 0x12, 0x54, 0x72, 0x69,
-	0x67, 0x67, 0x65, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64,
-	0x22, 0xd2, 0x01, 0x0a, 0x13, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x53, 0x79, 0x6e, 0x63,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x0c, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a,
-	0x13, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x73, 0x41, 0x64, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a,
-	0x15, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x6f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x32, 0x0a, 0x15, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x5f, 0x72, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x13, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa5, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63,
-	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x4f, 0x6e, 0x22, 0x7e, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x19, 0x0a, 0x15, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x4f,
+	0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10,
+	0x01, 0x12, 0x14, 0x0a, 0x10, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55,
+	0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x4f, 0x50, 0x45, 0x52, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x03, 0x12, 0x14, 0x0a,
+	0x10, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54,
+	0x45, 0x10, 0x04, 0x22, 0x56, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c,
+	0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x64, 0x0a, 0x20, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x40, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x72, 0x0a, 0x21, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x61, 0x74, 0x61,
+	0x6c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x84, 0x01, 0x0a, 0x22, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72,
+	0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x61,
+	0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x73, 0x22, 0x4c, 0x0a, 0x15,
+	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x54, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x0a, 0x12, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x53,
+	0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e,
 	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x73,
-	0x12, 0x21, 0x0a, 0x0c, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x64, 0x50, 0x61,
-	0x74, 0x68, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x03, 0x64, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x22, 0x73, 0x0a,
-	0x11, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0xd2, 0x01, 0x0a, 0x13,
+	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x6f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x41, 0x64,
+	0x64, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x6f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x73, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x15,
+	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x6f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0xa5, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x66,
+	0x6f, 0x72, 0x63, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0b, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x10,
+	0x0a, 0x03, 0x64, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x64, 0x72, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x22, 0x73, 0x0a, 0x11, 0x52, 0x65, 0x63, 0x6f,
+	0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a,
+	0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d,
+	0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x73, 0x22, 0x9c, 0x01,
+	0x0a, 0x17, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e,
+	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e,
+	0x63, 0x69, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe8, 0x02, 0x0a,
+	0x0e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x38, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e,
+	0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x73, 0x12, 0x37, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03,
 	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
 	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x61,
-	0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x61, 0x74,
-	0x68, 0x73, 0x22, 0x81, 0x04, 0x0a, 0x0e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x38, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c,
-	0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69,
-	0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72,
-	0x74, 0x79, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x70,
-	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x50, 0x61, 0x74, 0x68, 0x12, 0x53, 0x0a, 0x0e, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x4f, 0x0a, 0x0c, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75,
-	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69,
-	0x6c, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x65, 0x6e, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x1a, 0x3a, 0x0a, 0x0c, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x22, 0x77, 0x0a,
-	0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e,
-	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x43,
-	0x4f, 0x44, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x54, 0x41, 0x58, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f,
-	0x43, 0x4f, 0x44, 0x45, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10,
-	0x02, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44,
-	0x45, 0x4e, 0x43, 0x59, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4f,
-	0x4c, 0x41, 0x50, 0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x4f,
-	0x55, 0x52, 0x43, 0x45, 0x10, 0x05, 0x22, 0xc8, 0x01, 0x0a, 0x1a, 0x50, 0x75, 0x74, 0x46, 0x69,
+	0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x04,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x44, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x10, 0x0a,
+	0x0c, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12,
+	0x10, 0x0a, 0x0c, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10,
+	0x04, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x4e, 0x41, 0x4d, 0x45,
+	0x44, 0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x52, 0x4f, 0x50,
+	0x50, 0x45, 0x44, 0x10, 0x06, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x45, 0x44, 0x10, 0x07, 0x22, 0x38, 0x0a, 0x15, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49,
+	0x64, 0x22, 0x78, 0x0a, 0x16, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x69,
+	0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x73, 0x22, 0x81, 0x04, 0x0a, 0x0e,
+	0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x38,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x72,
+	0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x5f, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79,
+	0x50, 0x61, 0x74, 0x68, 0x12, 0x53, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x72,
+	0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x43, 0x68,
+	0x61, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4f, 0x0a, 0x0c, 0x65, 0x6e, 0x64,
+	0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2c, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x2e, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x65,
+	0x6e, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x3a, 0x0a, 0x0c, 0x43, 0x68,
+	0x61, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69,
+	0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x22, 0x77, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14,
+	0x0a, 0x10, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x59, 0x4e,
+	0x54, 0x41, 0x58, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x4f,
+	0x44, 0x45, 0x5f, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x10, 0x03, 0x12,
+	0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4f, 0x4c, 0x41, 0x50, 0x10, 0x04, 0x12, 0x0f,
+	0x0a, 0x0b, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x10, 0x05, 0x22,
+	0xf3, 0x01, 0x0a, 0x1a, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x41, 0x6e, 0x64, 0x52, 0x65,
+	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6c, 0x6f, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x62, 0x6c, 0x6f, 0x62, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x6c, 0x79,
+	0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x64,
+	0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x64, 0x72, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73,
+	0x74, 0x72, 0x69, 0x63, 0x74, 0x22, 0x7d, 0x0a, 0x1b, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65,
+	0x41, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x25, 0x0a,
+	0x0e, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50,
+	0x61, 0x74, 0x68, 0x73, 0x22, 0x7e, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69,
 	0x6c, 0x65, 0x41, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
 	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74,
 	0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6c,
-	0x6f, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6c, 0x6f, 0x62, 0x12, 0x16,
-	0x0a, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x72, 0x79, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x64, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72,
-	0x69, 0x63, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63,
-	0x74, 0x22, 0x7d, 0x0a, 0x1b, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x41, 0x6e, 0x64, 0x52,
-	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x37, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1f, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x66, 0x66,
-	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0d, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x73,
-	0x22, 0x7e, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x41, 0x6e,
-	0x64, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x72, 0x79, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x03, 0x64, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69,
-	0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74,
-	0x22, 0x80, 0x01, 0x0a, 0x1e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x41,
-	0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e,
-	0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x61,
-	0x74, 0x68, 0x73, 0x22, 0xa0, 0x01, 0x0a, 0x1d, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x69,
-	0x6c, 0x65, 0x41, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x70,
-	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x50,
-	0x61, 0x74, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x50, 0x61, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03,
-	0x64, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x64, 0x72, 0x79, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
-	0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x22, 0x80, 0x01, 0x0a, 0x1e, 0x52, 0x65, 0x6e, 0x61, 0x6d,
-	0x65, 0x46, 0x69, 0x6c, 0x65, 0x41, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x72,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x64, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74,
+	0x72, 0x69, 0x63, 0x74, 0x22, 0x80, 0x01, 0x0a, 0x1e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46,
+	0x69, 0x6c, 0x65, 0x41, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x12, 0x25, 0x0a, 0x0e, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x74,
+	0x68, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x73, 0x22, 0xa0, 0x01, 0x0a, 0x1d, 0x52, 0x65, 0x6e, 0x61,
+	0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x41, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x72,
+	0x6f, 0x6d, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66,
+	0x72, 0x6f, 0x6d, 0x50, 0x61, 0x74, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x10, 0x0a, 0x03, 0x64, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x64,
+	0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x22, 0x80, 0x01, 0x0a, 0x1e, 0x52,
+	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x41, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f,
+	0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a,
+	0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d,
+	0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x73, 0x22, 0x7b, 0x0a,
+	0x1a, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x41, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6e,
+	0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x12, 0x10, 0x0a, 0x03, 0x64, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x64,
+	0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x22, 0x7d, 0x0a, 0x1b, 0x52, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x41, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c,
 	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x65, 0x72, 0x72,
 	0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x69, 0x6c, 0x6c,
 	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f,
 	0x6e, 0x63, 0x69, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f,
 	0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70,
 	0x61, 0x74, 0x68, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x66, 0x66, 0x65,
-	0x63, 0x74, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x73, 0x22, 0x7b, 0x0a, 0x1a, 0x52, 0x65, 0x66,
-	0x72, 0x65, 0x73, 0x68, 0x41, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03,
-	0x64, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x64, 0x72, 0x79, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
-	0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x22, 0x7d, 0x0a, 0x1b, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
-	0x68, 0x41, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e,
-	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c,
-	0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x25,
-	0x0a, 0x0e, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64,
-	0x50, 0x61, 0x74, 0x68, 0x73, 0x22, 0xa2, 0x01, 0x0a, 0x0c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x71, 0x6c, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x71, 0x6c, 0x12, 0x2a, 0x0a, 0x04, 0x61, 0x72, 0x67,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
-	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
-	0x79, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x6d, 0x0a, 0x0d, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x6d,
-	0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x69, 0x6c, 0x6c,
-	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x75,
-	0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x2b, 0x0a, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
-	0x75, 0x63, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xe2, 0x03, 0x0a, 0x19, 0x4d, 0x65,
-	0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x54, 0x6f, 0x70, 0x6c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x73, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f,
-	0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x69,
-	0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d,
-	0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0c, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73,
-	0x12, 0x39, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x74,
-	0x69, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x63, 0x74, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x73, 0x22, 0xc1, 0x01, 0x0a, 0x0c, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73,
+	0x71, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x71, 0x6c, 0x12, 0x2a, 0x0a,
+	0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x1d,
+	0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x22, 0x6d, 0x0a,
+	0x0d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f,
+	0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72,
+	0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12,
+	0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x45,
-	0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
-	0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
-	0x12, 0x34, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
+	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xad, 0x01, 0x0a,
+	0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x72, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x71, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x73, 0x71, 0x6c, 0x12, 0x2a, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x61, 0x72, 0x67,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a,
+	0x0a, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x22, 0x31, 0x0a, 0x12,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x72, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x5f, 0x69, 0x70, 0x63, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x49, 0x70, 0x63, 0x22,
+	0x81, 0x04, 0x0a, 0x19, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x54,
+	0x6f, 0x70, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2a,
+	0x0a, 0x11, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x69,
+	0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x12, 0x35, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x07, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x34, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69,
+	0x65, 0x77, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x3a, 0x0a, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72,
+	0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f,
+	0x6d, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x4d, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x1a, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56,
+	0x69, 0x65, 0x77, 0x54, 0x6f, 0x70, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x36, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x43, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xa4, 0x03, 0x0a, 0x1c, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65,
+	0x77, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x65,
+	0x61, 0x73, 0x75, 0x72, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x65, 0x6e,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x12, 0x29, 0x0a, 0x10,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x47, 0x72, 0x61, 0x6e,
+	0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x3a, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x56, 0x69, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x22, 0x84,
+	0x01, 0x0a, 0x1d, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x54, 0x69,
+	0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x36, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
 	0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x53, 0x6f, 0x72, 0x74,
-	0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x3a, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75,
+	0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xf5, 0x02, 0x0a, 0x18, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x56, 0x69, 0x65, 0x77, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x76,
+	0x69, 0x65, 0x77, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12,
+	0x35, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x74,
+	0x69, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x12, 0x3a, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75,
 	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
 	0x56, 0x69, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x81,
-	0x01, 0x0a, 0x1a, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x54, 0x6f,
-	0x70, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a,
-	0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x69,
-	0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65,
-	0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52,
-	0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x22, 0x85, 0x03, 0x0a, 0x1c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69,
-	0x65, 0x77, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f,
-	0x76, 0x69, 0x65, 0x77, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x12, 0x35, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07,
-	0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x5f,
-	0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69,
-	0x74, 0x79, 0x12, 0x3a, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1a,
-	0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x84, 0x01, 0x0a, 0x1d, 0x4d,
-	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65,
-	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x04,
-	0x6d, 0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x69, 0x6c,
-	0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x04,
-	0x6d, 0x65, 0x74, 0x61, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x22, 0xd6, 0x02, 0x0a, 0x18, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65,
-	0x77, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
-	0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12,
-	0x2a, 0x0a, 0x11, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d,
-	0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0c, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73,
-	0x12, 0x39, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x74,
-	0x69, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x45,
-	0x6e, 0x64, 0x12, 0x3a, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1a,
-	0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x80, 0x01, 0x0a, 0x19, 0x4d,
-	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75,
-	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
-	0x56, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61,
-	0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x43, 0x0a,
-	0x0f, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x53, 0x6f, 0x72, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x22, 0xf1, 0x01, 0x0a, 0x11, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69,
-	0x65, 0x77, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x07, 0x69, 0x6e, 0x63, 0x6c,
-	0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x72, 0x69, 0x6c, 0x6c,
+	0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1d,
+	0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x22, 0x80, 0x01,
+	0x0a, 0x19, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x04, 0x6d,
+	0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x69, 0x6c, 0x6c,
 	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f,
-	0x6e, 0x64, 0x52, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x12, 0x41, 0x0a, 0x07, 0x65,
-	0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x72,
+	0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x04, 0x6d,
+	0x65, 0x74, 0x61, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0x43, 0x0a, 0x0f, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x53,
+	0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73, 0x63, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x73, 0x63, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0xf1, 0x01, 0x0a, 0x11, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x56, 0x69, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x07, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x72,
 	0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
 	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x52, 0x07, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x1a, 0x56,
-	0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x02, 0x69, 0x6e,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x02,
-	0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6b, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x04, 0x6c, 0x69, 0x6b, 0x65, 0x22, 0x57, 0x0a, 0x11, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x73, 0x56, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x22,
-	0x9c, 0x01, 0x0a, 0x1d, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x6c,
-	0x75, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x52, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x12, 0x41,
+	0x0a, 0x07, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x52, 0x07, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x1a, 0x56, 0x0a, 0x04, 0x43, 0x6f, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a,
+	0x02, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6b, 0x65, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6b, 0x65, 0x22, 0x57, 0x0a, 0x11, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x75, 0x6c, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6e, 0x75, 0x6c, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x22, 0x9c, 0x01, 0x0a, 0x1d, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x52,
+	0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x22, 0xb8, 0x01, 0x0a, 0x1e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x52, 0x6f,
+	0x6c, 0x6c, 0x75, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x03, 0x65, 0x6e, 0x64, 0x12, 0x36, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x47, 0x72, 0x61,
+	0x69, 0x6e, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x95, 0x02, 0x0a,
+	0x14, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x56, 0x0a, 0x13, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x69, 0x63, 0x61, 0x6c, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c,
+	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x48, 0x00, 0x52, 0x12, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x4a, 0x0a,
+	0x0f, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63,
+	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x48, 0x00, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x65, 0x72,
+	0x69, 0x63, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x51, 0x0a, 0x12, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x48, 0x00, 0x52, 0x10, 0x74, 0x69, 0x6d, 0x65,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x42, 0x06, 0x0a, 0x04,
+	0x63, 0x61, 0x73, 0x65, 0x22, 0x6e, 0x0a, 0x12, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69,
+	0x63, 0x61, 0x6c, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x74, 0x6f,
+	0x70, 0x5f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x69, 0x6c, 0x6c,
+	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x4b,
+	0x48, 0x00, 0x52, 0x04, 0x74, 0x6f, 0x70, 0x4b, 0x12, 0x22, 0x0a, 0x0b, 0x63, 0x61, 0x72, 0x64,
+	0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52,
+	0x0b, 0x63, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x42, 0x06, 0x0a, 0x04,
+	0x63, 0x61, 0x73, 0x65, 0x22, 0x76, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x69,
+	0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x4f, 0x66, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x13, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x63, 0x61,
+	0x6c, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x12, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x69, 0x63, 0x61, 0x6c, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x22, 0x67, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x4b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x54, 0x0a, 0x13, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x73,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x72,
+	0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x52, 0x12, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x22, 0x9b, 0x02, 0x0a, 0x0e, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69,
+	0x63, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x5d, 0x0a, 0x16, 0x6e, 0x75, 0x6d, 0x65,
+	0x72, 0x69, 0x63, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x5f, 0x62, 0x69,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e,
+	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x75, 0x6d, 0x65, 0x72,
+	0x69, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x42, 0x69, 0x6e, 0x73, 0x48,
+	0x00, 0x52, 0x14, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67,
+	0x72, 0x61, 0x6d, 0x42, 0x69, 0x6e, 0x73, 0x12, 0x53, 0x0a, 0x12, 0x6e, 0x75, 0x6d, 0x65, 0x72,
+	0x69, 0x63, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x48, 0x00, 0x52, 0x11, 0x6e, 0x75, 0x6d, 0x65, 0x72,
+	0x69, 0x63, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x4d, 0x0a, 0x10,
+	0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x5f, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63,
+	0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x73, 0x48, 0x00, 0x52, 0x0f, 0x6e, 0x75, 0x6d, 0x65,
+	0x72, 0x69, 0x63, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x63,
+	0x61, 0x73, 0x65, 0x22, 0x63, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x75, 0x67, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48,
+	0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69,
+	0x63, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69,
+	0x63, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x22, 0x67, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4e,
+	0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x65, 0x72,
+	0x69, 0x63, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x22, 0x6c, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63,
+	0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52,
+	0x0e, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x22,
+	0x6e, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f,
+	0x0a, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x73, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x72, 0x69, 0x6c,
+	0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x10, 0x74,
+	0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x22,
+	0x86, 0x02, 0x0a, 0x10, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x12, 0x2c, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x6d,
+	0x69, 0x6e, 0x12, 0x2c, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x6d, 0x61, 0x78,
+	0x12, 0x46, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x08,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x1a, 0x4e, 0x0a, 0x08, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x22, 0xd0, 0x01, 0x0a, 0x0f, 0x4e, 0x75, 0x6d,
+	0x65, 0x72, 0x69, 0x63, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x73, 0x12, 0x44, 0x0a, 0x08,
+	0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x73,
+	0x2e, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65,
+	0x72, 0x73, 0x1a, 0x77, 0x0a, 0x07, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x03, 0x6c, 0x6f, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x67, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x68, 0x69, 0x67, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72,
+	0x65, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xad, 0x01, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x4b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x61, 0x67, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x67,
+	0x67, 0x12, 0x0c, 0x0a, 0x01, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x6b, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x8a, 0x01, 0x0a, 0x04,
+	0x54, 0x6f, 0x70, 0x4b, 0x12, 0x35, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x4b, 0x2e, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x1a, 0x4b, 0x0a, 0x05, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x92, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x4e, 0x75, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x2c, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x9e, 0x01, 0x0a, 0x1f,
+	0x47, 0x65, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x91, 0x01, 0x0a,
+	0x11, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x71, 0x32,
+	0x35, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x71, 0x32, 0x35, 0x12, 0x10, 0x0a, 0x03,
+	0x71, 0x35, 0x30, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x71, 0x35, 0x30, 0x12, 0x10,
+	0x0a, 0x03, 0x71, 0x37, 0x35, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x71, 0x37, 0x35,
+	0x12, 0x0e, 0x0a, 0x02, 0x73, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x73, 0x64,
+	0x22, 0x9f, 0x01, 0x0a, 0x20, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x53, 0x6d, 0x61,
+	0x6c, 0x6c, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x47, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x22, 0x5e, 0x0a, 0x21, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x53, 0x6d,
+	0x61, 0x6c, 0x6c, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x47, 0x72, 0x61, 0x69, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x67, 0x72, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x72, 0x69,
+	0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x47, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x47, 0x72, 0x61,
+	0x69, 0x6e, 0x22, 0x99, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69,
+	0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
 	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
@@ -7364,166 +9390,20 @@ var file_rill_runtime_v1_api_proto_rawDesc = []byte{
 	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
 	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4e, 0x61,
 	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0xb8,
-	0x01, 0x0a, 0x1e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x6c, 0x75,
-	0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x65, 0x6e,
-	0x64, 0x12, 0x36, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x47, 0x72, 0x61, 0x69, 0x6e, 0x52,
-	0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x95, 0x02, 0x0a, 0x14, 0x50, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61,
-	0x72, 0x79, 0x12, 0x56, 0x0a, 0x13, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x63, 0x61,
-	0x6c, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x23, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x53, 0x75, 0x6d,
-	0x6d, 0x61, 0x72, 0x79, 0x48, 0x00, 0x52, 0x12, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69,
-	0x63, 0x61, 0x6c, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x4a, 0x0a, 0x0f, 0x6e, 0x75,
-	0x6d, 0x65, 0x72, 0x69, 0x63, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x53, 0x75, 0x6d,
-	0x6d, 0x61, 0x72, 0x79, 0x48, 0x00, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x53,
-	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x51, 0x0a, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72,
-	0x61, 0x6e, 0x67, 0x65, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x75,
-	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x48, 0x00, 0x52, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e,
-	0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x42, 0x06, 0x0a, 0x04, 0x63, 0x61, 0x73,
-	0x65, 0x22, 0x6e, 0x0a, 0x12, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c,
-	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x5f, 0x6b,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75,
-	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x4b, 0x48, 0x00, 0x52,
-	0x04, 0x74, 0x6f, 0x70, 0x4b, 0x12, 0x22, 0x0a, 0x0b, 0x63, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61,
-	0x6c, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x61,
-	0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x42, 0x06, 0x0a, 0x04, 0x63, 0x61, 0x73,
-	0x65, 0x22, 0x76, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c,
-	0x69, 0x74, 0x79, 0x4f, 0x66, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x13, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x63,
-	0x61, 0x6c, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x23, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x53, 0x75,
-	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x12, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x63,
-	0x61, 0x6c, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x22, 0x67, 0x0a, 0x0f, 0x47, 0x65, 0x74,
-	0x54, 0x6f, 0x70, 0x4b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x13,
-	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x73, 0x75, 0x6d, 0x6d,
-	0x61, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x72, 0x69, 0x6c, 0x6c,
-	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x74, 0x65,
-	0x67, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x12,
-	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x53, 0x75, 0x6d, 0x6d, 0x61,
-	0x72, 0x79, 0x22, 0x9b, 0x02, 0x0a, 0x0e, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x53, 0x75,
-	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x5d, 0x0a, 0x16, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63,
-	0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x5f, 0x62, 0x69, 0x6e, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0xb0,
+	0x01, 0x0a, 0x14, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67,
+	0x72, 0x61, 0x6d, 0x42, 0x69, 0x6e, 0x73, 0x12, 0x3d, 0x0a, 0x04, 0x62, 0x69, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e,
 	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x48,
-	0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x42, 0x69, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x14,
-	0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d,
-	0x42, 0x69, 0x6e, 0x73, 0x12, 0x53, 0x0a, 0x12, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x5f,
-	0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x22, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73,
-	0x74, 0x69, 0x63, 0x73, 0x48, 0x00, 0x52, 0x11, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x53,
-	0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x4d, 0x0a, 0x10, 0x6e, 0x75, 0x6d,
-	0x65, 0x72, 0x69, 0x63, 0x5f, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x4f, 0x75, 0x74,
-	0x6c, 0x69, 0x65, 0x72, 0x73, 0x48, 0x00, 0x52, 0x0f, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63,
-	0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x63, 0x61, 0x73, 0x65,
-	0x22, 0x63, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x75, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67,
-	0x72, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0f, 0x6e,
-	0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74,
-	0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x53, 0x75,
-	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x53, 0x75,
-	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x22, 0x67, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4e, 0x75, 0x6d, 0x65,
-	0x72, 0x69, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x5f,
-	0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
-	0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x0e,
-	0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x22, 0x6c,
-	0x0a, 0x20, 0x47, 0x65, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x76, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x48, 0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x5f, 0x73, 0x75,
-	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x69,
-	0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x75,
-	0x6d, 0x65, 0x72, 0x69, 0x63, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x0e, 0x6e, 0x75,
-	0x6d, 0x65, 0x72, 0x69, 0x63, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x22, 0x6e, 0x0a, 0x1b,
-	0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d,
-	0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x12, 0x74,
-	0x69, 0x6d, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72,
-	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61,
-	0x6e, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x10, 0x74, 0x69, 0x6d, 0x65,
-	0x52, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x22, 0x86, 0x02, 0x0a,
-	0x10, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
-	0x79, 0x12, 0x2c, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12,
-	0x2c, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x46, 0x0a,
-	0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x2a, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61,
-	0x72, 0x79, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x08, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x76, 0x61, 0x6c, 0x1a, 0x4e, 0x0a, 0x08, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
-	0x6c, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x06, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x12, 0x16, 0x0a,
-	0x06, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d,
-	0x69, 0x63, 0x72, 0x6f, 0x73, 0x22, 0xd0, 0x01, 0x0a, 0x0f, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69,
-	0x63, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x73, 0x12, 0x44, 0x0a, 0x08, 0x6f, 0x75, 0x74,
-	0x6c, 0x69, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x72, 0x69,
-	0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x75,
-	0x6d, 0x65, 0x72, 0x69, 0x63, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x73, 0x2e, 0x4f, 0x75,
-	0x74, 0x6c, 0x69, 0x65, 0x72, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x73, 0x1a,
-	0x77, 0x0a, 0x07, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75,
-	0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b,
-	0x65, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x03, 0x6c, 0x6f, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x67, 0x68, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x04, 0x68, 0x69, 0x67, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x73,
-	0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x65, 0x73, 0x65,
-	0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xad, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74,
-	0x54, 0x6f, 0x70, 0x4b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
-	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03,
-	0x61, 0x67, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x67, 0x67, 0x12, 0x0c,
-	0x0a, 0x01, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x6b, 0x12, 0x1a, 0x0a, 0x08,
-	0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
-	0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x8a, 0x01, 0x0a, 0x04, 0x54, 0x6f, 0x70,
-	0x4b, 0x12, 0x35, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x4b, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x1a, 0x4b, 0x0a, 0x05, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x92, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4e, 0x75, 0x6c,
-	0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
-	0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d,
-	0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a,
-	0x0b, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x2c, 0x0a, 0x14, 0x47, 0x65,
-	0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x9e, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74,
-	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69,
-	0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x42, 0x69, 0x6e, 0x73, 0x2e, 0x42, 0x69, 0x6e,
+	0x52, 0x04, 0x62, 0x69, 0x6e, 0x73, 0x1a, 0x59, 0x0a, 0x03, 0x42, 0x69, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x03, 0x6c, 0x6f, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x67, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x68, 0x69, 0x67, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x95, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x52, 0x75, 0x67, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
 	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a,
 	0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
@@ -7531,374 +9411,347 @@ var file_rill_runtime_v1_api_proto_rawDesc = []byte{
 	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
 	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x91, 0x01, 0x0a, 0x11, 0x4e, 0x75,
-	0x6d, 0x65, 0x72, 0x69, 0x63, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12,
-	0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x69,
-	0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03,
-	0x6d, 0x61, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x71, 0x32, 0x35, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x71, 0x32, 0x35, 0x12, 0x10, 0x0a, 0x03, 0x71, 0x35, 0x30,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x71, 0x35, 0x30, 0x12, 0x10, 0x0a, 0x03, 0x71,
-	0x37, 0x35, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x71, 0x37, 0x35, 0x12, 0x0e, 0x0a,
-	0x02, 0x73, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x73, 0x64, 0x22, 0x9f, 0x01,
-	0x0a, 0x20, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x53, 0x6d, 0x61, 0x6c, 0x6c, 0x65,
-	0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x47, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x99, 0x01, 0x0a, 0x1a, 0x47, 0x65,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x9c, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72,
+	0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x4f, 0x66, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x22, 0x97, 0x04, 0x0a, 0x19, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
 	0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
 	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22,
-	0x5e, 0x0a, 0x21, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x53, 0x6d, 0x61, 0x6c, 0x6c,
-	0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x47, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x67, 0x72, 0x61,
-	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e,
+	0x6d, 0x65, 0x12, 0x53, 0x0a, 0x08, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x42, 0x61, 0x73, 0x69, 0x63, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x52, 0x08, 0x6d,
+	0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x54, 0x69, 0x6d, 0x65,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x3c, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x69, 0x78, 0x65, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x70, 0x69, 0x78, 0x65, 0x6c, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x1a, 0x59, 0x0a, 0x0c, 0x42, 0x61, 0x73, 0x69, 0x63, 0x4d, 0x65, 0x61, 0x73,
+	0x75, 0x72, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x71, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x71, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xad,
+	0x01, 0x0a, 0x13, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x54, 0x69, 0x6d,
+	0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x36, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e,
 	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x47,
-	0x72, 0x61, 0x69, 0x6e, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x47, 0x72, 0x61, 0x69, 0x6e, 0x22,
-	0x99, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x48, 0x69,
-	0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
-	0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12,
-	0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f,
-	0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0xb0, 0x01, 0x0a, 0x14,
-	0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d,
-	0x42, 0x69, 0x6e, 0x73, 0x12, 0x3d, 0x0a, 0x04, 0x62, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x48, 0x69, 0x73, 0x74,
-	0x6f, 0x67, 0x72, 0x61, 0x6d, 0x42, 0x69, 0x6e, 0x73, 0x2e, 0x42, 0x69, 0x6e, 0x52, 0x04, 0x62,
-	0x69, 0x6e, 0x73, 0x1a, 0x59, 0x0a, 0x03, 0x42, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75,
-	0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b,
-	0x65, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x03, 0x6c, 0x6f, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x67, 0x68, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x04, 0x68, 0x69, 0x67, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x95,
-	0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x52, 0x75, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72,
-	0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61,
-	0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6c,
-	0x75, 0x6d, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
-	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72,
-	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x99, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x54, 0x69,
-	0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
-	0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
-	0x74, 0x79, 0x22, 0x9c, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x69, 0x6e,
-	0x61, 0x6c, 0x69, 0x74, 0x79, 0x4f, 0x66, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
-	0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
-	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
-	0x79, 0x22, 0x97, 0x04, 0x0a, 0x19, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x69,
-	0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64,
-	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x53, 0x0a, 0x08, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x37, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
-	0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x42, 0x61,
-	0x73, 0x69, 0x63, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x61, 0x73,
-	0x75, 0x72, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x13, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x43, 0x6f,
-	0x6c, 0x75, 0x6d, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65,
-	0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x72,
-	0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e,
-	0x67, 0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x3c, 0x0a,
-	0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
-	0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70,
-	0x69, 0x78, 0x65, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x69, 0x78,
-	0x65, 0x6c, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x73, 0x69,
-	0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65,
-	0x53, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
-	0x1a, 0x59, 0x0a, 0x0c, 0x42, 0x61, 0x73, 0x69, 0x63, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x19, 0x0a, 0x08, 0x73, 0x71, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x73, 0x71, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xad, 0x01, 0x0a, 0x13,
+	0x72, 0x61, 0x69, 0x6e, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0xee,
+	0x01, 0x0a, 0x12, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x12, 0x36, 0x0a, 0x05, 0x73, 0x70, 0x61, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x05, 0x73, 0x70, 0x61, 0x72, 0x6b, 0x12, 0x43, 0x0a, 0x0a, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e,
 	0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61,
-	0x6e, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03,
-	0x65, 0x6e, 0x64, 0x12, 0x36, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e,
-	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x47, 0x72, 0x61, 0x69,
-	0x6e, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0xee, 0x01, 0x0a, 0x12,
-	0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x36,
-	0x0a, 0x05, 0x73, 0x70, 0x61, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x6e, 0x67, 0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22,
+	0x59, 0x0a, 0x1a, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x53,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a,
+	0x06, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
 	0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x05, 0x73, 0x70, 0x61, 0x72, 0x6b, 0x12, 0x43, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72,
-	0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x72, 0x69, 0x6c,
-	0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65,
-	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
-	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0a, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x59, 0x0a, 0x1a,
-	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x72, 0x6f,
-	0x6c, 0x6c, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x72, 0x69, 0x6c,
-	0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
-	0x06, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x22, 0xc5, 0x01, 0x0a, 0x0f, 0x54, 0x69, 0x6d, 0x65,
-	0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x73, 0x12, 0x15, 0x0a, 0x03, 0x62,
-	0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x03, 0x62, 0x69, 0x6e, 0x88,
-	0x01, 0x01, 0x12, 0x47, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x52,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x62, 0x69, 0x6e, 0x22,
-	0x78, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x61, 0x72, 0x64, 0x69,
-	0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
-	0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d,
-	0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x3f, 0x0a, 0x1b, 0x47, 0x65, 0x74,
-	0x54, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x61, 0x72, 0x64,
-	0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63,
-	0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x22, 0x73, 0x0a, 0x15, 0x50, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22,
-	0x61, 0x0a, 0x16, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0f, 0x70, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
-	0x6e, 0x73, 0x22, 0x6b, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6c,
-	0x75, 0x6d, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x6c,
-	0x61, 0x72, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x65,
-	0x6e, 0x67, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x6c, 0x61, 0x72, 0x67,
-	0x65, 0x73, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22,
-	0x87, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x6f, 0x77, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c,
-	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61,
-	0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1a, 0x0a,
-	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x43, 0x0a, 0x14, 0x47, 0x65, 0x74,
-	0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x9b,
-	0x04, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
-	0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x72, 0x69, 0x6c, 0x6c,
-	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x0a,
-	0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x1a, 0xef, 0x02, 0x0a, 0x08, 0x50,
-	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73,
-	0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20,
-	0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72,
-	0x12, 0x3c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28,
-	0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65,
-	0x72, 0x74, 0x79, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x6e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x08, 0x6e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69,
-	0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x69, 0x6e, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x68, 0x72, 0x65, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x72,
-	0x65, 0x66, 0x22, 0x68, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
-	0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10,
-	0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52,
-	0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x4f, 0x4f, 0x4c, 0x45,
-	0x41, 0x4e, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x46,
-	0x4f, 0x52, 0x4d, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x41, 0x4c, 0x10, 0x04, 0x22, 0x17, 0x0a, 0x15,
-	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x54, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x3a, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52,
-	0x0a, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x2a, 0xda, 0x01, 0x0a, 0x09,
-	0x54, 0x69, 0x6d, 0x65, 0x47, 0x72, 0x61, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x16, 0x54, 0x49, 0x4d,
-	0x45, 0x5f, 0x47, 0x52, 0x41, 0x49, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
-	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x47, 0x52,
-	0x41, 0x49, 0x4e, 0x5f, 0x4d, 0x49, 0x4c, 0x4c, 0x49, 0x53, 0x45, 0x43, 0x4f, 0x4e, 0x44, 0x10,
-	0x01, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x47, 0x52, 0x41, 0x49, 0x4e, 0x5f,
-	0x53, 0x45, 0x43, 0x4f, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x49, 0x4d, 0x45,
-	0x5f, 0x47, 0x52, 0x41, 0x49, 0x4e, 0x5f, 0x4d, 0x49, 0x4e, 0x55, 0x54, 0x45, 0x10, 0x03, 0x12,
-	0x13, 0x0a, 0x0f, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x47, 0x52, 0x41, 0x49, 0x4e, 0x5f, 0x48, 0x4f,
-	0x55, 0x52, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x47, 0x52, 0x41,
-	0x49, 0x4e, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x49, 0x4d, 0x45,
-	0x5f, 0x47, 0x52, 0x41, 0x49, 0x4e, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x10, 0x06, 0x12, 0x14, 0x0a,
-	0x10, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x47, 0x52, 0x41, 0x49, 0x4e, 0x5f, 0x4d, 0x4f, 0x4e, 0x54,
-	0x48, 0x10, 0x07, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x47, 0x52, 0x41, 0x49,
-	0x4e, 0x5f, 0x59, 0x45, 0x41, 0x52, 0x10, 0x08, 0x2a, 0xf3, 0x02, 0x0a, 0x0d, 0x54, 0x69, 0x6d,
-	0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x49,
-	0x4d, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x55, 0x4e,
-	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x54,
-	0x49, 0x4d, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x4c,
-	0x41, 0x53, 0x54, 0x5f, 0x48, 0x4f, 0x55, 0x52, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x49,
-	0x4d, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x4c, 0x41,
-	0x53, 0x54, 0x5f, 0x36, 0x5f, 0x48, 0x4f, 0x55, 0x52, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x54,
-	0x49, 0x4d, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x4c,
-	0x41, 0x53, 0x54, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x49, 0x4d,
-	0x45, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x4c, 0x41, 0x53,
-	0x54, 0x5f, 0x32, 0x5f, 0x44, 0x41, 0x59, 0x53, 0x10, 0x04, 0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x49,
-	0x4d, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x4c, 0x41,
-	0x53, 0x54, 0x5f, 0x35, 0x5f, 0x44, 0x41, 0x59, 0x53, 0x10, 0x05, 0x12, 0x1d, 0x0a, 0x19, 0x54,
-	0x49, 0x4d, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x4c,
-	0x41, 0x53, 0x54, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x10, 0x06, 0x12, 0x20, 0x0a, 0x1c, 0x54, 0x49,
-	0x4d, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x4c, 0x41,
-	0x53, 0x54, 0x5f, 0x32, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x53, 0x10, 0x07, 0x12, 0x20, 0x0a, 0x1c,
+	0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x22, 0xc5, 0x01, 0x0a, 0x0f, 0x54,
+	0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x73, 0x12, 0x15,
+	0x0a, 0x03, 0x62, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x03, 0x62,
+	0x69, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x1a, 0x3a,
+	0x0a, 0x0c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x62,
+	0x69, 0x6e, 0x22, 0x78, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x61,
+	0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x3f, 0x0a, 0x1b,
+	0x47, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63,
+	0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x63, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x22, 0x73, 0x0a,
+	0x15, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x22, 0x61, 0x0a, 0x16, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0f,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x22, 0x6b, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x32,
+	0x0a, 0x15, 0x6c, 0x61, 0x72, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x6c,
+	0x61, 0x72, 0x67, 0x65, 0x73, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x22, 0x87, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52,
+	0x6f, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x43, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0x9b, 0x04, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x72,
+	0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
+	0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x1a, 0xef, 0x02,
+	0x0a, 0x08, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x68, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x12, 0x3c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x28, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x6e, 0x75, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x69, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x69, 0x6e,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x72, 0x65, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x68, 0x72, 0x65, 0x66, 0x22, 0x68, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a,
+	0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x49,
+	0x4e, 0x47, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x55, 0x4d,
+	0x42, 0x45, 0x52, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x4f,
+	0x4f, 0x4c, 0x45, 0x41, 0x4e, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x49, 0x4e, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x41, 0x4c, 0x10, 0x04, 0x22,
+	0x17, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x54, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x2a, 0xda,
+	0x01, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x47, 0x72, 0x61, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x16,
+	0x54, 0x49, 0x4d, 0x45, 0x5f, 0x47, 0x52, 0x41, 0x49, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x54, 0x49, 0x4d, 0x45,
+	0x5f, 0x47, 0x52, 0x41, 0x49, 0x4e, 0x5f, 0x4d, 0x49, 0x4c, 0x4c, 0x49, 0x53, 0x45, 0x43, 0x4f,
+	0x4e, 0x44, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x47, 0x52, 0x41,
+	0x49, 0x4e, 0x5f, 0x53, 0x45, 0x43, 0x4f, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x54,
+	0x49, 0x4d, 0x45, 0x5f, 0x47, 0x52, 0x41, 0x49, 0x4e, 0x5f, 0x4d, 0x49, 0x4e, 0x55, 0x54, 0x45,
+	0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x47, 0x52, 0x41, 0x49, 0x4e,
+	0x5f, 0x48, 0x4f, 0x55, 0x52, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x49, 0x4d, 0x45, 0x5f,
+	0x47, 0x52, 0x41, 0x49, 0x4e, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x54,
+	0x49, 0x4d, 0x45, 0x5f, 0x47, 0x52, 0x41, 0x49, 0x4e, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x10, 0x06,
+	0x12, 0x14, 0x0a, 0x10, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x47, 0x52, 0x41, 0x49, 0x4e, 0x5f, 0x4d,
+	0x4f, 0x4e, 0x54, 0x48, 0x10, 0x07, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x47,
+	0x52, 0x41, 0x49, 0x4e, 0x5f, 0x59, 0x45, 0x41, 0x52, 0x10, 0x08, 0x2a, 0xf3, 0x02, 0x0a, 0x0d,
+	0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a,
+	0x1b, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4e, 0x41, 0x4d, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d,
+	0x0a, 0x19, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4e, 0x41, 0x4d,
+	0x45, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x48, 0x4f, 0x55, 0x52, 0x10, 0x01, 0x12, 0x1f, 0x0a,
+	0x1b, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4e, 0x41, 0x4d, 0x45,
+	0x5f, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x36, 0x5f, 0x48, 0x4f, 0x55, 0x52, 0x10, 0x02, 0x12, 0x1c,
+	0x0a, 0x18, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4e, 0x41, 0x4d,
+	0x45, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b,
 	0x54, 0x49, 0x4d, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f,
-	0x4c, 0x41, 0x53, 0x54, 0x5f, 0x33, 0x30, 0x5f, 0x44, 0x41, 0x59, 0x53, 0x10, 0x08, 0x12, 0x20,
-	0x0a, 0x1c, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4e, 0x41, 0x4d,
-	0x45, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x36, 0x30, 0x5f, 0x44, 0x41, 0x59, 0x53, 0x10, 0x09,
-	0x12, 0x1c, 0x0a, 0x18, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4e,
-	0x41, 0x4d, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x0a, 0x2a, 0x9c,
-	0x01, 0x0a, 0x12, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x20, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53,
-	0x45, 0x5f, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e,
-	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x44,
-	0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x44,
-	0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x56, 0x49, 0x45, 0x57, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x44, 0x41,
-	0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x46, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x32, 0xdc, 0x2e,
-	0x0a, 0x0e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x55, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e,
-	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75,
-	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x10, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0a, 0x12, 0x08, 0x2f,
-	0x76, 0x31, 0x2f, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x75, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e,
-	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x26, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12,
-	0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x7d,
-	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x23, 0x2e,
-	0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d,
-	0x12, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f,
-	0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x7b, 0x0a,
-	0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12,
-	0x26, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72,
-	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x3a, 0x01, 0x2a, 0x22, 0x0d, 0x2f, 0x76, 0x31,
-	0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x86, 0x01, 0x0a, 0x0e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x26, 0x2e,
-	0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e,
-	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x2a, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74,
+	0x4c, 0x41, 0x53, 0x54, 0x5f, 0x32, 0x5f, 0x44, 0x41, 0x59, 0x53, 0x10, 0x04, 0x12, 0x1f, 0x0a,
+	0x1b, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4e, 0x41, 0x4d, 0x45,
+	0x5f, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x35, 0x5f, 0x44, 0x41, 0x59, 0x53, 0x10, 0x05, 0x12, 0x1d,
+	0x0a, 0x19, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4e, 0x41, 0x4d,
+	0x45, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x10, 0x06, 0x12, 0x20, 0x0a,
+	0x1c, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4e, 0x41, 0x4d, 0x45,
+	0x5f, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x32, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x53, 0x10, 0x07, 0x12,
+	0x20, 0x0a, 0x1c, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4e, 0x41,
+	0x4d, 0x45, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x33, 0x30, 0x5f, 0x44, 0x41, 0x59, 0x53, 0x10,
+	0x08, 0x12, 0x20, 0x0a, 0x1c, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x5f,
+	0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x36, 0x30, 0x5f, 0x44, 0x41, 0x59,
+	0x53, 0x10, 0x09, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x47,
+	0x45, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10,
+	0x0a, 0x2a, 0x9c, 0x01, 0x0a, 0x12, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x20, 0x44, 0x41, 0x54, 0x41,
+	0x42, 0x41, 0x53, 0x45, 0x5f, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e,
+	0x0a, 0x1a, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x4f, 0x42, 0x4a, 0x45, 0x43,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x1d,
+	0x0a, 0x19, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x4f, 0x42, 0x4a, 0x45, 0x43,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x49, 0x45, 0x57, 0x10, 0x02, 0x12, 0x21, 0x0a,
+	0x1d, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03,
+	0x32, 0x84, 0x39, 0x0a, 0x0e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x55, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x2e, 0x72, 0x69,
+	0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x72, 0x69, 0x6c, 0x6c,
+	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x10, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0a,
+	0x12, 0x08, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x75, 0x0a, 0x0d, 0x4c, 0x69,
+	0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x72, 0x69,
+	0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x12, 0x7d, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x23, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d,
+	0x12, 0x7b, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x26, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x72, 0x69, 0x6c,
+	0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x3a, 0x01, 0x2a, 0x22, 0x0d,
+	0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x83, 0x01,
+	0x0a, 0x0c, 0x45, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x24,
+	0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a, 0x32, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74,
 	0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f,
-	0x69, 0x64, 0x7d, 0x12, 0x7d, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73,
-	0x12, 0x21, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12,
-	0x21, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b,
-	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x66, 0x69, 0x6c,
-	0x65, 0x73, 0x12, 0x83, 0x01, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1f,
+	0x69, 0x64, 0x7d, 0x12, 0x86, 0x01, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x26, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
 	0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x20, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x76, 0x31, 0x2f, 0x69,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x2f, 0x2d, 0x2f, 0x7b,
-	0x70, 0x61, 0x74, 0x68, 0x3d, 0x2a, 0x2a, 0x7d, 0x12, 0x86, 0x01, 0x0a, 0x07, 0x50, 0x75, 0x74,
-	0x46, 0x69, 0x6c, 0x65, 0x12, 0x1f, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74,
-	0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e,
-	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x3a,
-	0x01, 0x2a, 0x22, 0x2d, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x2a,
+	0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x7d, 0x0a, 0x09,
+	0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x72, 0x69, 0x6c, 0x6c,
+	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x72,
+	0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x83, 0x01, 0x0a, 0x07,
+	0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1f, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e,
+	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
 	0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
 	0x66, 0x69, 0x6c, 0x65, 0x73, 0x2f, 0x2d, 0x2f, 0x7b, 0x70, 0x61, 0x74, 0x68, 0x3d, 0x2a, 0x2a,
-	0x7d, 0x12, 0x8c, 0x01, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65,
-	0x12, 0x22, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74,
-	0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x2f, 0x2a, 0x2d, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73,
-	0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x66,
-	0x69, 0x6c, 0x65, 0x73, 0x2f, 0x2d, 0x2f, 0x7b, 0x70, 0x61, 0x74, 0x68, 0x3d, 0x2a, 0x2a, 0x7d,
-	0x12, 0x8a, 0x01, 0x0a, 0x0a, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12,
-	0x22, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d,
-	0x3a, 0x01, 0x2a, 0x22, 0x28, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d,
-	0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x2f, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x9a, 0x01,
+	0x7d, 0x12, 0x86, 0x01, 0x0a, 0x07, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1f, 0x2e,
+	0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x38, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x3a, 0x01, 0x2a, 0x22, 0x2d, 0x2f, 0x76, 0x31,
+	0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x2f, 0x2d,
+	0x2f, 0x7b, 0x70, 0x61, 0x74, 0x68, 0x3d, 0x2a, 0x2a, 0x7d, 0x12, 0x8c, 0x01, 0x0a, 0x0a, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x22, 0x2e, 0x72, 0x69, 0x6c, 0x6c,
+	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x2a, 0x2d, 0x2f, 0x76, 0x31, 0x2f,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x2f, 0x2d, 0x2f,
+	0x7b, 0x70, 0x61, 0x74, 0x68, 0x3d, 0x2a, 0x2a, 0x7d, 0x12, 0x8a, 0x01, 0x0a, 0x0a, 0x52, 0x65,
+	0x6e, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x22, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e,
+	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x72,
+	0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x3a, 0x01, 0x2a, 0x22, 0x28, 0x2f, 0x76,
+	0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x2f,
+	0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0xab, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x2e, 0x72,
+	0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x3f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x39, 0x12, 0x37, 0x2f, 0x76, 0x31,
+	0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x2f, 0x72,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x2d, 0x2f, 0x7b, 0x70, 0x61, 0x74, 0x68,
+	0x3d, 0x2a, 0x2a, 0x7d, 0x12, 0x96, 0x01, 0x0a, 0x0a, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x46,
+	0x69, 0x6c, 0x65, 0x12, 0x22, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x39, 0x3a, 0x01, 0x2a, 0x22, 0x34, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x2f, 0x72, 0x65, 0x76, 0x65, 0x72,
+	0x74, 0x2f, 0x2d, 0x2f, 0x7b, 0x70, 0x61, 0x74, 0x68, 0x3d, 0x2a, 0x2a, 0x7d, 0x12, 0x9a, 0x01,
 	0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x45, 0x6e, 0x74,
 	0x72, 0x69, 0x65, 0x73, 0x12, 0x2a, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74,
 	0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c,
@@ -7917,293 +9770,347 @@ var file_rill_runtime_v1_api_proto_rawDesc = []byte{
 	0x61, 0x6c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
 	0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x76, 0x31, 0x2f, 0x69,
 	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x2f, 0x7b,
-	0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x9d, 0x01, 0x0a, 0x0e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
-	0x72, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x12, 0x26, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e,
-	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67,
-	0x65, 0x72, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x27, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
-	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x34, 0x22, 0x32, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73,
-	0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63,
-	0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x72, 0x65,
-	0x66, 0x72, 0x65, 0x73, 0x68, 0x12, 0x82, 0x01, 0x0a, 0x0b, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
-	0x72, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x23, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e,
-	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x53,
-	0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x72, 0x69, 0x6c,
-	0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69,
-	0x67, 0x67, 0x65, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x22, 0x20, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x84, 0x01, 0x0a, 0x09, 0x52,
-	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x12, 0x21, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e,
-	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e,
-	0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x72, 0x69,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x2f, 0x7b,
+	0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xbc, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61,
+	0x74, 0x61, 0x6c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x30, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x74, 0x61, 0x6c,
+	0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x12,
+	0x33, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x61, 0x74,
+	0x61, 0x6c, 0x6f, 0x67, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0xd4, 0x01, 0x0a, 0x1a, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x32, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x61, 0x74,
+	0x61, 0x6c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4d, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x47, 0x22, 0x45, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x7d, 0x2f, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
+	0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x9d, 0x01, 0x0a, 0x0e,
+	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x12, 0x26,
+	0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x22, 0x32, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x2f, 0x7b, 0x6e, 0x61,
+	0x6d, 0x65, 0x7d, 0x2f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x12, 0x82, 0x01, 0x0a, 0x0b,
+	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x23, 0x2e, 0x72, 0x69,
+	0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x22, 0x20,
+	0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x79, 0x6e, 0x63,
+	0x12, 0x84, 0x01, 0x0a, 0x09, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x12, 0x21,
+	0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x3a, 0x01, 0x2a,
+	0x22, 0x25, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f,
+	0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65,
+	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x12, 0x99, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x63, 0x6f,
+	0x6e, 0x63, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x21, 0x2e, 0x72, 0x69,
 	0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
-	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x3a, 0x01, 0x2a, 0x22, 0x25, 0x2f, 0x76, 0x31, 0x2f,
-	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c,
-	0x65, 0x12, 0x92, 0x01, 0x0a, 0x13, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x41, 0x6e, 0x64,
-	0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x12, 0x2b, 0x2e, 0x72, 0x69, 0x6c, 0x6c,
-	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x74, 0x46,
-	0x69, 0x6c, 0x65, 0x41, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75,
+	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
+	0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31,
+	0x3a, 0x01, 0x2a, 0x22, 0x2c, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x30, 0x01, 0x12, 0x98, 0x01, 0x0a, 0x0e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x63,
+	0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x12, 0x26, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x12,
+	0x2b, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x63,
+	0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x2f, 0x77, 0x61, 0x74, 0x63, 0x68, 0x30, 0x01, 0x12, 0x92,
+	0x01, 0x0a, 0x13, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x41, 0x6e, 0x64, 0x52, 0x65, 0x63,
+	0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x12, 0x2b, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75,
 	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65,
-	0x41, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x22,
-	0x15, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x75, 0x74, 0x2d, 0x61, 0x6e, 0x64, 0x2d, 0x72, 0x65, 0x63,
-	0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x12, 0x9e, 0x01, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x46, 0x69, 0x6c, 0x65, 0x41, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c,
-	0x65, 0x12, 0x2e, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x41, 0x6e,
-	0x64, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2f, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x41, 0x6e,
-	0x64, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x22, 0x18, 0x2f,
-	0x76, 0x31, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x2d, 0x61, 0x6e, 0x64, 0x2d, 0x72, 0x65,
-	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x12, 0x9e, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x6e, 0x61,
-	0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x41, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69,
-	0x6c, 0x65, 0x12, 0x2e, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x41,
-	0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x41,
-	0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x22, 0x18,
-	0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x2d, 0x61, 0x6e, 0x64, 0x2d, 0x72,
-	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x12, 0x96, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x66,
-	0x72, 0x65, 0x73, 0x68, 0x41, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65,
-	0x12, 0x2b, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x41, 0x6e, 0x64, 0x52, 0x65, 0x63,
-	0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
+	0x41, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x41, 0x6e, 0x64,
+	0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x22, 0x15, 0x2f, 0x76,
+	0x31, 0x2f, 0x70, 0x75, 0x74, 0x2d, 0x61, 0x6e, 0x64, 0x2d, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x65, 0x12, 0x9e, 0x01, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69,
+	0x6c, 0x65, 0x41, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x12, 0x2e,
+	0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x41, 0x6e, 0x64, 0x52, 0x65,
+	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f,
+	0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x41, 0x6e, 0x64, 0x52, 0x65,
+	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x22, 0x18, 0x2f, 0x76, 0x31, 0x2f,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x2d, 0x61, 0x6e, 0x64, 0x2d, 0x72, 0x65, 0x63, 0x6f, 0x6e,
+	0x63, 0x69, 0x6c, 0x65, 0x12, 0x9e, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46,
+	0x69, 0x6c, 0x65, 0x41, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x12,
+	0x2e, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x41, 0x6e, 0x64, 0x52,
+	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2f, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x41, 0x6e, 0x64, 0x52,
+	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x22, 0x18, 0x2f, 0x76, 0x31,
+	0x2f, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x2d, 0x61, 0x6e, 0x64, 0x2d, 0x72, 0x65, 0x63, 0x6f,
+	0x6e, 0x63, 0x69, 0x6c, 0x65, 0x12, 0x96, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x41, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x12, 0x2b, 0x2e,
 	0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e,
 	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x41, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63,
-	0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1e, 0x3a, 0x01, 0x2a, 0x22, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x66, 0x72,
-	0x65, 0x73, 0x68, 0x2d, 0x61, 0x6e, 0x64, 0x2d, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c,
-	0x65, 0x12, 0x74, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1d, 0x2e, 0x72, 0x69, 0x6c,
-	0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x72, 0x69, 0x6c, 0x6c,
-	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x26, 0x3a, 0x01, 0x2a, 0x22, 0x21, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64,
-	0x7d, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0xbf, 0x01, 0x0a, 0x12, 0x4d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x54, 0x6f, 0x70, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x2a,
-	0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x54, 0x6f, 0x70, 0x6c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x72, 0x69, 0x6c,
-	0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x54, 0x6f, 0x70, 0x6c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x50, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4a, 0x3a,
-	0x01, 0x2a, 0x22, 0x45, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
-	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2d, 0x76, 0x69, 0x65, 0x77, 0x73, 0x2f, 0x7b, 0x6d,
-	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x7d, 0x2f, 0x74, 0x6f, 0x70, 0x6c, 0x69, 0x73, 0x74, 0x12, 0xcb, 0x01, 0x0a, 0x15, 0x4d, 0x65,
-	0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72,
-	0x69, 0x65, 0x73, 0x12, 0x2d, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65,
-	0x77, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77,
-	0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x53, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4d, 0x3a, 0x01, 0x2a, 0x22, 0x48, 0x2f,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x72, 0x69, 0x6c,
+	0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x41, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e,
+	0x3a, 0x01, 0x2a, 0x22, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x2d, 0x61, 0x6e, 0x64, 0x2d, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x12, 0x74,
+	0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1d, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x3a, 0x01,
+	0x2a, 0x22, 0x21, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x12, 0x8b, 0x01, 0x0a, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x72,
+	0x72, 0x6f, 0x77, 0x12, 0x22, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x72, 0x72, 0x6f, 0x77,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
+	0x72, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x2c, 0x3a, 0x01, 0x2a, 0x22, 0x27, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x61, 0x72, 0x72, 0x6f, 0x77,
+	0x30, 0x01, 0x12, 0xbf, 0x01, 0x0a, 0x12, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69,
+	0x65, 0x77, 0x54, 0x6f, 0x70, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x2a, 0x2e, 0x72, 0x69, 0x6c, 0x6c,
+	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x54, 0x6f, 0x70, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56,
+	0x69, 0x65, 0x77, 0x54, 0x6f, 0x70, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x50, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4a, 0x3a, 0x01, 0x2a, 0x22, 0x45, 0x2f,
 	0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x6e,
 	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x65, 0x74, 0x72, 0x69,
 	0x63, 0x73, 0x2d, 0x76, 0x69, 0x65, 0x77, 0x73, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x73, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0xbb, 0x01, 0x0a, 0x11, 0x4d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x12, 0x29, 0x2e,
+	0x73, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x74, 0x6f, 0x70,
+	0x6c, 0x69, 0x73, 0x74, 0x12, 0xcb, 0x01, 0x0a, 0x15, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x56, 0x69, 0x65, 0x77, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2d,
+	0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x54, 0x69, 0x6d, 0x65,
+	0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e,
 	0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x54, 0x6f, 0x74, 0x61, 0x6c,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x54, 0x69, 0x6d, 0x65, 0x53,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x53, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x4d, 0x3a, 0x01, 0x2a, 0x22, 0x48, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2d, 0x76, 0x69,
+	0x65, 0x77, 0x73, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x76, 0x69, 0x65,
+	0x77, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0xbb, 0x01, 0x0a, 0x11, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69,
+	0x65, 0x77, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x12, 0x29, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e,
 	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69,
-	0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x49, 0x3a, 0x01, 0x2a, 0x22,
-	0x44, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b,
-	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x73, 0x2d, 0x76, 0x69, 0x65, 0x77, 0x73, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x73, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x73, 0x12, 0xcd, 0x01, 0x0a, 0x16, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61,
-	0x74, 0x65, 0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
-	0x12, 0x2e, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x6c, 0x75,
-	0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2f, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x6c, 0x75,
-	0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x52, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4c, 0x3a, 0x01, 0x2a, 0x22, 0x47, 0x2f, 0x76,
+	0x63, 0x73, 0x56, 0x69, 0x65, 0x77, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x56, 0x69, 0x65,
+	0x77, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x4f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x49, 0x3a, 0x01, 0x2a, 0x22, 0x44, 0x2f, 0x76, 0x31, 0x2f,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2d,
+	0x76, 0x69, 0x65, 0x77, 0x73, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x76,
+	0x69, 0x65, 0x77, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x73,
+	0x12, 0xcd, 0x01, 0x0a, 0x16, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c,
+	0x6c, 0x75, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x2e, 0x2e, 0x72, 0x69,
+	0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73,
+	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x72, 0x69,
+	0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73,
+	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x52, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x4c, 0x3a, 0x01, 0x2a, 0x22, 0x47, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x72, 0x6f, 0x6c,
+	0x6c, 0x75, 0x70, 0x2d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x2f, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
+	0x12, 0x95, 0x01, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x4b, 0x12, 0x1f, 0x2e, 0x72,
+	0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x6f, 0x70, 0x4b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x4b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x47, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x41, 0x3a, 0x01, 0x2a, 0x22, 0x3c, 0x2f, 0x76, 0x31, 0x2f,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2f,
+	0x74, 0x6f, 0x70, 0x6b, 0x2f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xa7, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74,
+	0x4e, 0x75, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x2e, 0x72, 0x69, 0x6c, 0x6c,
+	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e,
+	0x75, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x44, 0x12, 0x42,
+	0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x71, 0x75, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x2f, 0x6e, 0x75, 0x6c, 0x6c, 0x2d, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2f, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x7d, 0x12, 0xd7, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12,
+	0x30, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x76, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x31, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x56, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x50, 0x12, 0x4e, 0x2f, 0x76,
 	0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73,
 	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65,
-	0x73, 0x2f, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x2d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
-	0x6c, 0x2f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x95, 0x01, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70,
-	0x4b, 0x12, 0x1f, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x4b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x4b, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x41, 0x3a, 0x01, 0x2a, 0x22,
-	0x3c, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b,
-	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x71, 0x75, 0x65,
-	0x72, 0x69, 0x65, 0x73, 0x2f, 0x74, 0x6f, 0x70, 0x6b, 0x2f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73,
-	0x2f, 0x7b, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xa7, 0x01,
-	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24,
+	0x73, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x76, 0x65, 0x2d, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2f,
+	0x7b, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xd7, 0x01, 0x0a,
+	0x19, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x53, 0x6d, 0x61, 0x6c, 0x6c, 0x65, 0x73,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x47, 0x72, 0x61, 0x69, 0x6e, 0x12, 0x31, 0x2e, 0x72, 0x69, 0x6c,
+	0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x74,
+	0x69, 0x6d, 0x61, 0x74, 0x65, 0x53, 0x6d, 0x61, 0x6c, 0x6c, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x47, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e,
+	0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x53, 0x6d, 0x61, 0x6c, 0x6c, 0x65, 0x73, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x47, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x53, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4d, 0x12, 0x4b, 0x2f, 0x76, 0x31, 0x2f, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x73,
+	0x6d, 0x61, 0x6c, 0x6c, 0x65, 0x73, 0x74, 0x2d, 0x74, 0x69, 0x6d, 0x65, 0x2d, 0x67, 0x72, 0x61,
+	0x69, 0x6e, 0x2f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xc3, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4e, 0x75,
+	0x6d, 0x65, 0x72, 0x69, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x2b,
 	0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74,
-	0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4a, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x44, 0x12, 0x42, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d,
-	0x2f, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x6e, 0x75, 0x6c, 0x6c, 0x2d, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x2f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x74, 0x61, 0x62, 0x6c,
-	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xd7, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x44,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73,
-	0x74, 0x69, 0x63, 0x73, 0x12, 0x30, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74,
-	0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75,
-	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x56, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x50, 0x12, 0x4e, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x2e, 0x47, 0x65, 0x74, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x72, 0x69,
+	0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x4b, 0x12, 0x49, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73,
 	0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x71,
-	0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x76, 0x65, 0x2d, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2f, 0x74, 0x61,
-	0x62, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x7d, 0x12, 0xd7, 0x01, 0x0a, 0x19, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x53, 0x6d,
-	0x61, 0x6c, 0x6c, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x47, 0x72, 0x61, 0x69, 0x6e, 0x12,
-	0x31, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x53, 0x6d, 0x61, 0x6c, 0x6c, 0x65,
-	0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x47, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x32, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x53, 0x6d, 0x61,
-	0x6c, 0x6c, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x47, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x53, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4d, 0x12, 0x4b,
-	0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x71, 0x75, 0x65, 0x72,
-	0x69, 0x65, 0x73, 0x2f, 0x73, 0x6d, 0x61, 0x6c, 0x6c, 0x65, 0x73, 0x74, 0x2d, 0x74, 0x69, 0x6d,
-	0x65, 0x2d, 0x67, 0x72, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2f, 0x7b,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xc3, 0x01, 0x0a, 0x13,
-	0x47, 0x65, 0x74, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67,
-	0x72, 0x61, 0x6d, 0x12, 0x2b, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63,
-	0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2c, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x48, 0x69, 0x73,
-	0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4b, 0x12, 0x49, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f,
-	0x69, 0x64, 0x7d, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x6e, 0x75, 0x6d, 0x65,
-	0x72, 0x69, 0x63, 0x2d, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x2f, 0x74, 0x61,
-	0x62, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x7d, 0x12, 0xb3, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x75, 0x67, 0x48, 0x69, 0x73, 0x74,
-	0x6f, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x27, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e,
-	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x67, 0x48, 0x69,
-	0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
-	0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x47,
-	0x12, 0x45, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f,
-	0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x71, 0x75,
-	0x65, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x72, 0x75, 0x67, 0x2d, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x67,
-	0x72, 0x61, 0x6d, 0x2f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x74, 0x61, 0x62, 0x6c,
-	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xc4, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54,
-	0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12,
-	0x2b, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x75,
-	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x72,
+	0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x2d, 0x68,
+	0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x2f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2f,
+	0x7b, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xb3, 0x01, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x52, 0x75, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d,
+	0x12, 0x27, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72,
+	0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x72, 0x69, 0x6c, 0x6c,
+	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x75, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x4d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x47, 0x12, 0x45, 0x2f, 0x76, 0x31,
+	0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x2f, 0x72, 0x75, 0x67, 0x2d, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x2f, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x7d, 0x12, 0xc4, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x2b, 0x2e, 0x72, 0x69, 0x6c,
+	0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x52, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4c, 0x12, 0x4a, 0x2f,
+	0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x2d, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x2d, 0x73, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x2f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xcd, 0x01, 0x0a, 0x16, 0x47, 0x65,
+	0x74, 0x43, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x4f, 0x66, 0x43, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x2e, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x69, 0x6e,
+	0x61, 0x6c, 0x69, 0x74, 0x79, 0x4f, 0x66, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x69, 0x6e,
+	0x61, 0x6c, 0x69, 0x74, 0x79, 0x4f, 0x66, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x52, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4c, 0x12, 0x4a, 0x2f,
+	0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x2f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x2d, 0x63, 0x61, 0x72, 0x64, 0x69, 0x6e,
+	0x61, 0x6c, 0x69, 0x74, 0x79, 0x2f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xbc, 0x01, 0x0a, 0x12, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x2a, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x53,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x72,
 	0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61,
-	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x52, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x4c, 0x12, 0x4a, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
-	0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x2d, 0x72, 0x61, 0x6e,
-	0x67, 0x65, 0x2d, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x2f, 0x74, 0x61, 0x62, 0x6c, 0x65,
-	0x73, 0x2f, 0x7b, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xcd,
-	0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74,
-	0x79, 0x4f, 0x66, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x2e, 0x2e, 0x72, 0x69, 0x6c, 0x6c,
-	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43,
-	0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x4f, 0x66, 0x43, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x72, 0x69, 0x6c, 0x6c,
-	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43,
-	0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x4f, 0x66, 0x43, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x52, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x4c, 0x12, 0x4a, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
-	0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x2d, 0x63,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4d, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x47, 0x3a, 0x01, 0x2a, 0x22, 0x42, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x7d, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x2f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xc3, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79,
+	0x12, 0x2b, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x61, 0x72, 0x64, 0x69,
+	0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
+	0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x4b, 0x12, 0x49, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2d, 0x63,
 	0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x2f, 0x74, 0x61, 0x62, 0x6c, 0x65,
-	0x73, 0x2f, 0x7b, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xbc,
-	0x01, 0x0a, 0x12, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x53,
-	0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2a, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e,
-	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
-	0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2b, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
-	0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4d,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x47, 0x3a, 0x01, 0x2a, 0x22, 0x42, 0x2f, 0x76, 0x31, 0x2f, 0x69,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73,
-	0x2f, 0x7b, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xc3, 0x01,
-	0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x61, 0x72, 0x64, 0x69, 0x6e,
-	0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x2b, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e,
-	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65,
-	0x43, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x61, 0x72,
-	0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x51, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4b, 0x12, 0x49, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x74, 0x61,
-	0x62, 0x6c, 0x65, 0x2d, 0x63, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x2f,
+	0x73, 0x2f, 0x7b, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xb2,
+	0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x73, 0x12, 0x26, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x72, 0x69, 0x6c, 0x6c,
+	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x4f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x49, 0x22, 0x47, 0x2f, 0x76, 0x31, 0x2f,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2f,
+	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x2d, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2f,
 	0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x7d, 0x12, 0xb2, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43,
-	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x26, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75,
-	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
-	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
-	0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x49, 0x22,
-	0x47, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b,
-	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x71, 0x75, 0x65,
-	0x72, 0x69, 0x65, 0x73, 0x2f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x2d, 0x70, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x2f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x74, 0x61, 0x62,
-	0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xa1, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74,
-	0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x24, 0x2e, 0x72, 0x69, 0x6c, 0x6c,
-	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54,
-	0x61, 0x62, 0x6c, 0x65, 0x52, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x25, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x6f, 0x77, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3e, 0x12, 0x3c,
-	0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x71, 0x75, 0x65, 0x72,
-	0x69, 0x65, 0x73, 0x2f, 0x72, 0x6f, 0x77, 0x73, 0x2f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2f,
-	0x7b, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x7e, 0x0a, 0x0e,
-	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x26,
-	0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75,
-	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x42, 0xb1, 0x01, 0x0a,
-	0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
-	0x65, 0x2e, 0x76, 0x31, 0x42, 0x08, 0x41, 0x70, 0x69, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x69, 0x6c,
-	0x6c, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x72, 0x69, 0x6c, 0x6c, 0x2f, 0x72, 0x69, 0x6c, 0x6c, 0x2f,
-	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x52, 0x52, 0x58, 0xaa, 0x02, 0x0f, 0x52, 0x69, 0x6c,
-	0x6c, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0f, 0x52,
-	0x69, 0x6c, 0x6c, 0x5c, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02,
-	0x1b, 0x52, 0x69, 0x6c, 0x6c, 0x5c, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5c, 0x56, 0x31,
-	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x52,
-	0x69, 0x6c, 0x6c, 0x3a, 0x3a, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x3a, 0x3a, 0x56, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6d, 0x65, 0x7d, 0x12, 0xa1, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x52, 0x6f, 0x77, 0x73, 0x12, 0x24, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52,
+	0x6f, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x72, 0x69, 0x6c,
+	0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x44, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3e, 0x12, 0x3c, 0x2f, 0x76, 0x31, 0x2f, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x72,
+	0x6f, 0x77, 0x73, 0x2f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x7e, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x26, 0x2e, 0x72, 0x69, 0x6c, 0x6c,
+	0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x15, 0x12, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x73, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x42, 0xb1, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e,
+	0x72, 0x69, 0x6c, 0x6c, 0x2e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x76, 0x31, 0x42,
+	0x08, 0x41, 0x70, 0x69, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x32, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x69, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61,
+	0x2f, 0x72, 0x69, 0x6c, 0x6c, 0x2f, 0x72, 0x69, 0x6c, 0x6c, 0x2f, 0x72, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x76, 0x31, 0xa2,
+	0x02, 0x03, 0x52, 0x52, 0x58, 0xaa, 0x02, 0x0f, 0x52, 0x69, 0x6c, 0x6c, 0x2e, 0x52, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0f, 0x52, 0x69, 0x6c, 0x6c, 0x5c, 0x52,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1b, 0x52, 0x69, 0x6c, 0x6c,
+	0x5c, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x52, 0x69, 0x6c, 0x6c, 0x3a, 0x3a,
+	0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -8218,291 +10125,347 @@ func file_rill_runtime_v1_api_proto_rawDescGZIP() []byte {
 	return file_rill_runtime_v1_api_proto_rawDescData
 }
 
-var file_rill_runtime_v1_api_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_rill_runtime_v1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 102)
+var file_rill_runtime_v1_api_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_rill_runtime_v1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 123)
 var file_rill_runtime_v1_api_proto_goTypes = []interface{}{
 	(TimeGrain)(0),                                 // 0: rill.runtime.v1.TimeGrain
 	(TimeRangeName)(0),                             // 1: rill.runtime.v1.TimeRangeName
 	(DatabaseObjectType)(0),                        // 2: rill.runtime.v1.DatabaseObjectType
-	(ReconcileError_Code)(0),                       // 3: rill.runtime.v1.ReconcileError.Code
-	(Connector_Property_Type)(0),                   // 4: rill.runtime.v1.Connector.Property.Type
-	(*PingRequest)(nil),                            // 5: rill.runtime.v1.PingRequest
-	(*PingResponse)(nil),                           // 6: rill.runtime.v1.PingResponse
-	(*Instance)(nil),                               // 7: rill.runtime.v1.Instance
-	(*ListInstancesRequest)(nil),                   // 8: rill.runtime.v1.ListInstancesRequest
-	(*ListInstancesResponse)(nil),                  // 9: rill.runtime.v1.ListInstancesResponse
-	(*GetInstanceRequest)(nil),                     // 10: rill.runtime.v1.GetInstanceRequest
-	(*GetInstanceResponse)(nil),                    // 11: rill.runtime.v1.GetInstanceResponse
-	(*CreateInstanceRequest)(nil),                  // 12: rill.runtime.v1.CreateInstanceRequest
-	(*CreateInstanceResponse)(nil),                 // 13: rill.runtime.v1.CreateInstanceResponse
-	(*DeleteInstanceRequest)(nil),                  // 14: rill.runtime.v1.DeleteInstanceRequest
-	(*DeleteInstanceResponse)(nil),                 // 15: rill.runtime.v1.DeleteInstanceResponse
-	(*ListFilesRequest)(nil),                       // 16: rill.runtime.v1.ListFilesRequest
-	(*ListFilesResponse)(nil),                      // 17: rill.runtime.v1.ListFilesResponse
-	(*GetFileRequest)(nil),                         // 18: rill.runtime.v1.GetFileRequest
-	(*GetFileResponse)(nil),                        // 19: rill.runtime.v1.GetFileResponse
-	(*PutFileRequest)(nil),                         // 20: rill.runtime.v1.PutFileRequest
-	(*PutFileResponse)(nil),                        // 21: rill.runtime.v1.PutFileResponse
-	(*DeleteFileRequest)(nil),                      // 22: rill.runtime.v1.DeleteFileRequest
-	(*DeleteFileResponse)(nil),                     // 23: rill.runtime.v1.DeleteFileResponse
-	(*RenameFileRequest)(nil),                      // 24: rill.runtime.v1.RenameFileRequest
-	(*RenameFileResponse)(nil),                     // 25: rill.runtime.v1.RenameFileResponse
-	(*CatalogEntry)(nil),                           // 26: rill.runtime.v1.CatalogEntry
-	(*ListCatalogEntriesRequest)(nil),              // 27: rill.runtime.v1.ListCatalogEntriesRequest
-	(*ListCatalogEntriesResponse)(nil),             // 28: rill.runtime.v1.ListCatalogEntriesResponse
-	(*GetCatalogEntryRequest)(nil),                 // 29: rill.runtime.v1.GetCatalogEntryRequest
-	(*GetCatalogEntryResponse)(nil),                // 30: rill.runtime.v1.GetCatalogEntryResponse
-	(*TriggerRefreshRequest)(nil),                  // 31: rill.runtime.v1.TriggerRefreshRequest
-	(*TriggerRefreshResponse)(nil),                 // 32: rill.runtime.v1.TriggerRefreshResponse
-	(*TriggerSyncRequest)(nil),                     // 33: rill.runtime.v1.TriggerSyncRequest
-	(*TriggerSyncResponse)(nil),                    // 34: rill.runtime.v1.TriggerSyncResponse
-	(*ReconcileRequest)(nil),                       // 35: rill.runtime.v1.ReconcileRequest
-	(*ReconcileResponse)(nil),                      // 36: rill.runtime.v1.ReconcileResponse
-	(*ReconcileError)(nil),                         // 37: rill.runtime.v1.ReconcileError
-	(*PutFileAndReconcileRequest)(nil),             // 38: rill.runtime.v1.PutFileAndReconcileRequest
-	(*PutFileAndReconcileResponse)(nil),            // 39: rill.runtime.v1.PutFileAndReconcileResponse
-	(*DeleteFileAndReconcileRequest)(nil),          // 40: rill.runtime.v1.DeleteFileAndReconcileRequest
-	(*DeleteFileAndReconcileResponse)(nil),         // 41: rill.runtime.v1.DeleteFileAndReconcileResponse
-	(*RenameFileAndReconcileRequest)(nil),          // 42: rill.runtime.v1.RenameFileAndReconcileRequest
-	(*RenameFileAndReconcileResponse)(nil),         // 43: rill.runtime.v1.RenameFileAndReconcileResponse
-	(*RefreshAndReconcileRequest)(nil),             // 44: rill.runtime.v1.RefreshAndReconcileRequest
-	(*RefreshAndReconcileResponse)(nil),            // 45: rill.runtime.v1.RefreshAndReconcileResponse
-	(*QueryRequest)(nil),                           // 46: rill.runtime.v1.QueryRequest
-	(*QueryResponse)(nil),                          // 47: rill.runtime.v1.QueryResponse
-	(*MetricsViewToplistRequest)(nil),              // 48: rill.runtime.v1.MetricsViewToplistRequest
-	(*MetricsViewToplistResponse)(nil),             // 49: rill.runtime.v1.MetricsViewToplistResponse
-	(*MetricsViewTimeSeriesRequest)(nil),           // 50: rill.runtime.v1.MetricsViewTimeSeriesRequest
-	(*MetricsViewTimeSeriesResponse)(nil),          // 51: rill.runtime.v1.MetricsViewTimeSeriesResponse
-	(*MetricsViewTotalsRequest)(nil),               // 52: rill.runtime.v1.MetricsViewTotalsRequest
-	(*MetricsViewTotalsResponse)(nil),              // 53: rill.runtime.v1.MetricsViewTotalsResponse
-	(*MetricsViewSort)(nil),                        // 54: rill.runtime.v1.MetricsViewSort
-	(*MetricsViewFilter)(nil),                      // 55: rill.runtime.v1.MetricsViewFilter
-	(*MetricsViewColumn)(nil),                      // 56: rill.runtime.v1.MetricsViewColumn
-	(*EstimateRollupIntervalRequest)(nil),          // 57: rill.runtime.v1.EstimateRollupIntervalRequest
-	(*EstimateRollupIntervalResponse)(nil),         // 58: rill.runtime.v1.EstimateRollupIntervalResponse
-	(*ProfileColumnSummary)(nil),                   // 59: rill.runtime.v1.ProfileColumnSummary
-	(*CategoricalSummary)(nil),                     // 60: rill.runtime.v1.CategoricalSummary
-	(*GetCardinalityOfColumnResponse)(nil),         // 61: rill.runtime.v1.GetCardinalityOfColumnResponse
-	(*GetTopKResponse)(nil),                        // 62: rill.runtime.v1.GetTopKResponse
-	(*NumericSummary)(nil),                         // 63: rill.runtime.v1.NumericSummary
-	(*GetRugHistogramResponse)(nil),                // 64: rill.runtime.v1.GetRugHistogramResponse
-	(*GetNumericHistogramResponse)(nil),            // 65: rill.runtime.v1.GetNumericHistogramResponse
-	(*GetDescriptiveStatisticsResponse)(nil),       // 66: rill.runtime.v1.GetDescriptiveStatisticsResponse
-	(*GetTimeRangeSummaryResponse)(nil),            // 67: rill.runtime.v1.GetTimeRangeSummaryResponse
-	(*TimeRangeSummary)(nil),                       // 68: rill.runtime.v1.TimeRangeSummary
-	(*NumericOutliers)(nil),                        // 69: rill.runtime.v1.NumericOutliers
-	(*GetTopKRequest)(nil),                         // 70: rill.runtime.v1.GetTopKRequest
-	(*TopK)(nil),                                   // 71: rill.runtime.v1.TopK
-	(*GetNullCountRequest)(nil),                    // 72: rill.runtime.v1.GetNullCountRequest
-	(*GetNullCountResponse)(nil),                   // 73: rill.runtime.v1.GetNullCountResponse
-	(*GetDescriptiveStatisticsRequest)(nil),        // 74: rill.runtime.v1.GetDescriptiveStatisticsRequest
-	(*NumericStatistics)(nil),                      // 75: rill.runtime.v1.NumericStatistics
-	(*EstimateSmallestTimeGrainRequest)(nil),       // 76: rill.runtime.v1.EstimateSmallestTimeGrainRequest
-	(*EstimateSmallestTimeGrainResponse)(nil),      // 77: rill.runtime.v1.EstimateSmallestTimeGrainResponse
-	(*GetNumericHistogramRequest)(nil),             // 78: rill.runtime.v1.GetNumericHistogramRequest
-	(*NumericHistogramBins)(nil),                   // 79: rill.runtime.v1.NumericHistogramBins
-	(*GetRugHistogramRequest)(nil),                 // 80: rill.runtime.v1.GetRugHistogramRequest
-	(*GetTimeRangeSummaryRequest)(nil),             // 81: rill.runtime.v1.GetTimeRangeSummaryRequest
-	(*GetCardinalityOfColumnRequest)(nil),          // 82: rill.runtime.v1.GetCardinalityOfColumnRequest
-	(*GenerateTimeSeriesRequest)(nil),              // 83: rill.runtime.v1.GenerateTimeSeriesRequest
-	(*TimeSeriesTimeRange)(nil),                    // 84: rill.runtime.v1.TimeSeriesTimeRange
-	(*TimeSeriesResponse)(nil),                     // 85: rill.runtime.v1.TimeSeriesResponse
-	(*GenerateTimeSeriesResponse)(nil),             // 86: rill.runtime.v1.GenerateTimeSeriesResponse
-	(*TimeSeriesValue)(nil),                        // 87: rill.runtime.v1.TimeSeriesValue
-	(*GetTableCardinalityRequest)(nil),             // 88: rill.runtime.v1.GetTableCardinalityRequest
-	(*GetTableCardinalityResponse)(nil),            // 89: rill.runtime.v1.GetTableCardinalityResponse
-	(*ProfileColumnsRequest)(nil),                  // 90: rill.runtime.v1.ProfileColumnsRequest
-	(*ProfileColumnsResponse)(nil),                 // 91: rill.runtime.v1.ProfileColumnsResponse
-	(*ProfileColumn)(nil),                          // 92: rill.runtime.v1.ProfileColumn
-	(*GetTableRowsRequest)(nil),                    // 93: rill.runtime.v1.GetTableRowsRequest
-	(*GetTableRowsResponse)(nil),                   // 94: rill.runtime.v1.GetTableRowsResponse
-	(*Connector)(nil),                              // 95: rill.runtime.v1.Connector
-	(*ListConnectorsRequest)(nil),                  // 96: rill.runtime.v1.ListConnectorsRequest
-	(*ListConnectorsResponse)(nil),                 // 97: rill.runtime.v1.ListConnectorsResponse
-	(*ReconcileError_CharLocation)(nil),            // 98: rill.runtime.v1.ReconcileError.CharLocation
-	(*MetricsViewFilter_Cond)(nil),                 // 99: rill.runtime.v1.MetricsViewFilter.Cond
-	(*TimeRangeSummary_Interval)(nil),              // 100: rill.runtime.v1.TimeRangeSummary.Interval
-	(*NumericOutliers_Outlier)(nil),                // 101: rill.runtime.v1.NumericOutliers.Outlier
-	(*TopK_Entry)(nil),                             // 102: rill.runtime.v1.TopK.Entry
-	(*NumericHistogramBins_Bin)(nil),               // 103: rill.runtime.v1.NumericHistogramBins.Bin
-	(*GenerateTimeSeriesRequest_BasicMeasure)(nil), // 104: rill.runtime.v1.GenerateTimeSeriesRequest.BasicMeasure
-	nil,                           // 105: rill.runtime.v1.TimeSeriesValue.RecordsEntry
-	(*Connector_Property)(nil),    // 106: rill.runtime.v1.Connector.Property
-	(*timestamppb.Timestamp)(nil), // 107: google.protobuf.Timestamp
-	(*Table)(nil),                 // 108: rill.runtime.v1.Table
-	(*Source)(nil),                // 109: rill.runtime.v1.Source
-	(*Model)(nil),                 // 110: rill.runtime.v1.Model
-	(*MetricsView)(nil),           // 111: rill.runtime.v1.MetricsView
-	(ObjectType)(0),               // 112: rill.runtime.v1.ObjectType
-	(*structpb.Value)(nil),        // 113: google.protobuf.Value
-	(*StructType)(nil),            // 114: rill.runtime.v1.StructType
-	(*structpb.Struct)(nil),       // 115: google.protobuf.Struct
+	(CatalogEntryVersion_Operation)(0),             // 3: rill.runtime.v1.CatalogEntryVersion.Operation
+	(ReconcileEvent_Type)(0),                       // 4: rill.runtime.v1.ReconcileEvent.Type
+	(ReconcileError_Code)(0),                       // 5: rill.runtime.v1.ReconcileError.Code
+	(Connector_Property_Type)(0),                   // 6: rill.runtime.v1.Connector.Property.Type
+	(*PingRequest)(nil),                            // 7: rill.runtime.v1.PingRequest
+	(*PingResponse)(nil),                           // 8: rill.runtime.v1.PingResponse
+	(*Instance)(nil),                               // 9: rill.runtime.v1.Instance
+	(*ListInstancesRequest)(nil),                   // 10: rill.runtime.v1.ListInstancesRequest
+	(*ListInstancesResponse)(nil),                  // 11: rill.runtime.v1.ListInstancesResponse
+	(*GetInstanceRequest)(nil),                     // 12: rill.runtime.v1.GetInstanceRequest
+	(*GetInstanceResponse)(nil),                    // 13: rill.runtime.v1.GetInstanceResponse
+	(*CreateInstanceRequest)(nil),                  // 14: rill.runtime.v1.CreateInstanceRequest
+	(*CreateInstanceResponse)(nil),                 // 15: rill.runtime.v1.CreateInstanceResponse
+	(*EditInstanceRequest)(nil),                    // 16: rill.runtime.v1.EditInstanceRequest
+	(*EditInstanceResponse)(nil),                   // 17: rill.runtime.v1.EditInstanceResponse
+	(*DeleteInstanceRequest)(nil),                  // 18: rill.runtime.v1.DeleteInstanceRequest
+	(*DeleteInstanceResponse)(nil),                 // 19: rill.runtime.v1.DeleteInstanceResponse
+	(*ListFilesRequest)(nil),                       // 20: rill.runtime.v1.ListFilesRequest
+	(*ListFilesResponse)(nil),                      // 21: rill.runtime.v1.ListFilesResponse
+	(*GetFileRequest)(nil),                         // 22: rill.runtime.v1.GetFileRequest
+	(*GetFileResponse)(nil),                        // 23: rill.runtime.v1.GetFileResponse
+	(*PutFileRequest)(nil),                         // 24: rill.runtime.v1.PutFileRequest
+	(*PutFileResponse)(nil),                        // 25: rill.runtime.v1.PutFileResponse
+	(*DeleteFileRequest)(nil),                      // 26: rill.runtime.v1.DeleteFileRequest
+	(*DeleteFileResponse)(nil),                     // 27: rill.runtime.v1.DeleteFileResponse
+	(*RenameFileRequest)(nil),                      // 28: rill.runtime.v1.RenameFileRequest
+	(*RenameFileResponse)(nil),                     // 29: rill.runtime.v1.RenameFileResponse
+	(*FileRevision)(nil),                           // 30: rill.runtime.v1.FileRevision
+	(*ListFileRevisionsRequest)(nil),               // 31: rill.runtime.v1.ListFileRevisionsRequest
+	(*ListFileRevisionsResponse)(nil),              // 32: rill.runtime.v1.ListFileRevisionsResponse
+	(*RevertFileRequest)(nil),                      // 33: rill.runtime.v1.RevertFileRequest
+	(*RevertFileResponse)(nil),                     // 34: rill.runtime.v1.RevertFileResponse
+	(*CatalogEntry)(nil),                           // 35: rill.runtime.v1.CatalogEntry
+	(*ListCatalogEntriesRequest)(nil),              // 36: rill.runtime.v1.ListCatalogEntriesRequest
+	(*ListCatalogEntriesResponse)(nil),             // 37: rill.runtime.v1.ListCatalogEntriesResponse
+	(*GetCatalogEntryRequest)(nil),                 // 38: rill.runtime.v1.GetCatalogEntryRequest
+	(*GetCatalogEntryResponse)(nil),                // 39: rill.runtime.v1.GetCatalogEntryResponse
+	(*CatalogEntryVersion)(nil),                    // 40: rill.runtime.v1.CatalogEntryVersion
+	(*ListCatalogEntryVersionsRequest)(nil),        // 41: rill.runtime.v1.ListCatalogEntryVersionsRequest
+	(*ListCatalogEntryVersionsResponse)(nil),       // 42: rill.runtime.v1.ListCatalogEntryVersionsResponse
+	(*RestoreCatalogEntryVersionRequest)(nil),      // 43: rill.runtime.v1.RestoreCatalogEntryVersionRequest
+	(*RestoreCatalogEntryVersionResponse)(nil),     // 44: rill.runtime.v1.RestoreCatalogEntryVersionResponse
+	(*TriggerRefreshRequest)(nil),                  // 45: rill.runtime.v1.TriggerRefreshRequest
+	(*TriggerRefreshResponse)(nil),                 // 46: rill.runtime.v1.TriggerRefreshResponse
+	(*TriggerSyncRequest)(nil),                     // 47: rill.runtime.v1.TriggerSyncRequest
+	(*TriggerSyncResponse)(nil),                    // 48: rill.runtime.v1.TriggerSyncResponse
+	(*ReconcileRequest)(nil),                       // 49: rill.runtime.v1.ReconcileRequest
+	(*ReconcileResponse)(nil),                      // 50: rill.runtime.v1.ReconcileResponse
+	(*ReconcileStreamResponse)(nil),                // 51: rill.runtime.v1.ReconcileStreamResponse
+	(*ReconcileEvent)(nil),                         // 52: rill.runtime.v1.ReconcileEvent
+	(*WatchReconcileRequest)(nil),                  // 53: rill.runtime.v1.WatchReconcileRequest
+	(*WatchReconcileResponse)(nil),                 // 54: rill.runtime.v1.WatchReconcileResponse
+	(*ReconcileError)(nil),                         // 55: rill.runtime.v1.ReconcileError
+	(*PutFileAndReconcileRequest)(nil),             // 56: rill.runtime.v1.PutFileAndReconcileRequest
+	(*PutFileAndReconcileResponse)(nil),            // 57: rill.runtime.v1.PutFileAndReconcileResponse
+	(*DeleteFileAndReconcileRequest)(nil),          // 58: rill.runtime.v1.DeleteFileAndReconcileRequest
+	(*DeleteFileAndReconcileResponse)(nil),         // 59: rill.runtime.v1.DeleteFileAndReconcileResponse
+	(*RenameFileAndReconcileRequest)(nil),          // 60: rill.runtime.v1.RenameFileAndReconcileRequest
+	(*RenameFileAndReconcileResponse)(nil),         // 61: rill.runtime.v1.RenameFileAndReconcileResponse
+	(*RefreshAndReconcileRequest)(nil),             // 62: rill.runtime.v1.RefreshAndReconcileRequest
+	(*RefreshAndReconcileResponse)(nil),            // 63: rill.runtime.v1.RefreshAndReconcileResponse
+	(*QueryRequest)(nil),                           // 64: rill.runtime.v1.QueryRequest
+	(*QueryResponse)(nil),                          // 65: rill.runtime.v1.QueryResponse
+	(*QueryArrowRequest)(nil),                      // 66: rill.runtime.v1.QueryArrowRequest
+	(*QueryArrowResponse)(nil),                     // 67: rill.runtime.v1.QueryArrowResponse
+	(*MetricsViewToplistRequest)(nil),              // 68: rill.runtime.v1.MetricsViewToplistRequest
+	(*MetricsViewToplistResponse)(nil),             // 69: rill.runtime.v1.MetricsViewToplistResponse
+	(*MetricsViewTimeSeriesRequest)(nil),           // 70: rill.runtime.v1.MetricsViewTimeSeriesRequest
+	(*MetricsViewTimeSeriesResponse)(nil),          // 71: rill.runtime.v1.MetricsViewTimeSeriesResponse
+	(*MetricsViewTotalsRequest)(nil),               // 72: rill.runtime.v1.MetricsViewTotalsRequest
+	(*MetricsViewTotalsResponse)(nil),              // 73: rill.runtime.v1.MetricsViewTotalsResponse
+	(*MetricsViewSort)(nil),                        // 74: rill.runtime.v1.MetricsViewSort
+	(*MetricsViewFilter)(nil),                      // 75: rill.runtime.v1.MetricsViewFilter
+	(*MetricsViewColumn)(nil),                      // 76: rill.runtime.v1.MetricsViewColumn
+	(*EstimateRollupIntervalRequest)(nil),          // 77: rill.runtime.v1.EstimateRollupIntervalRequest
+	(*EstimateRollupIntervalResponse)(nil),         // 78: rill.runtime.v1.EstimateRollupIntervalResponse
+	(*ProfileColumnSummary)(nil),                   // 79: rill.runtime.v1.ProfileColumnSummary
+	(*CategoricalSummary)(nil),                     // 80: rill.runtime.v1.CategoricalSummary
+	(*GetCardinalityOfColumnResponse)(nil),         // 81: rill.runtime.v1.GetCardinalityOfColumnResponse
+	(*GetTopKResponse)(nil),                        // 82: rill.runtime.v1.GetTopKResponse
+	(*NumericSummary)(nil),                         // 83: rill.runtime.v1.NumericSummary
+	(*GetRugHistogramResponse)(nil),                // 84: rill.runtime.v1.GetRugHistogramResponse
+	(*GetNumericHistogramResponse)(nil),            // 85: rill.runtime.v1.GetNumericHistogramResponse
+	(*GetDescriptiveStatisticsResponse)(nil),       // 86: rill.runtime.v1.GetDescriptiveStatisticsResponse
+	(*GetTimeRangeSummaryResponse)(nil),            // 87: rill.runtime.v1.GetTimeRangeSummaryResponse
+	(*TimeRangeSummary)(nil),                       // 88: rill.runtime.v1.TimeRangeSummary
+	(*NumericOutliers)(nil),                        // 89: rill.runtime.v1.NumericOutliers
+	(*GetTopKRequest)(nil),                         // 90: rill.runtime.v1.GetTopKRequest
+	(*TopK)(nil),                                   // 91: rill.runtime.v1.TopK
+	(*GetNullCountRequest)(nil),                    // 92: rill.runtime.v1.GetNullCountRequest
+	(*GetNullCountResponse)(nil),                   // 93: rill.runtime.v1.GetNullCountResponse
+	(*GetDescriptiveStatisticsRequest)(nil),        // 94: rill.runtime.v1.GetDescriptiveStatisticsRequest
+	(*NumericStatistics)(nil),                      // 95: rill.runtime.v1.NumericStatistics
+	(*EstimateSmallestTimeGrainRequest)(nil),       // 96: rill.runtime.v1.EstimateSmallestTimeGrainRequest
+	(*EstimateSmallestTimeGrainResponse)(nil),      // 97: rill.runtime.v1.EstimateSmallestTimeGrainResponse
+	(*GetNumericHistogramRequest)(nil),             // 98: rill.runtime.v1.GetNumericHistogramRequest
+	(*NumericHistogramBins)(nil),                   // 99: rill.runtime.v1.NumericHistogramBins
+	(*GetRugHistogramRequest)(nil),                 // 100: rill.runtime.v1.GetRugHistogramRequest
+	(*GetTimeRangeSummaryRequest)(nil),             // 101: rill.runtime.v1.GetTimeRangeSummaryRequest
+	(*GetCardinalityOfColumnRequest)(nil),          // 102: rill.runtime.v1.GetCardinalityOfColumnRequest
+	(*GenerateTimeSeriesRequest)(nil),              // 103: rill.runtime.v1.GenerateTimeSeriesRequest
+	(*TimeSeriesTimeRange)(nil),                    // 104: rill.runtime.v1.TimeSeriesTimeRange
+	(*TimeSeriesResponse)(nil),                     // 105: rill.runtime.v1.TimeSeriesResponse
+	(*GenerateTimeSeriesResponse)(nil),             // 106: rill.runtime.v1.GenerateTimeSeriesResponse
+	(*TimeSeriesValue)(nil),                        // 107: rill.runtime.v1.TimeSeriesValue
+	(*GetTableCardinalityRequest)(nil),             // 108: rill.runtime.v1.GetTableCardinalityRequest
+	(*GetTableCardinalityResponse)(nil),            // 109: rill.runtime.v1.GetTableCardinalityResponse
+	(*ProfileColumnsRequest)(nil),                  // 110: rill.runtime.v1.ProfileColumnsRequest
+	(*ProfileColumnsResponse)(nil),                 // 111: rill.runtime.v1.ProfileColumnsResponse
+	(*ProfileColumn)(nil),                          // 112: rill.runtime.v1.ProfileColumn
+	(*GetTableRowsRequest)(nil),                    // 113: rill.runtime.v1.GetTableRowsRequest
+	(*GetTableRowsResponse)(nil),                   // 114: rill.runtime.v1.GetTableRowsResponse
+	(*Connector)(nil),                              // 115: rill.runtime.v1.Connector
+	(*ListConnectorsRequest)(nil),                  // 116: rill.runtime.v1.ListConnectorsRequest
+	(*ListConnectorsResponse)(nil),                 // 117: rill.runtime.v1.ListConnectorsResponse
+	nil,                                            // 118: rill.runtime.v1.Instance.VariablesEntry
+	nil,                                            // 119: rill.runtime.v1.CreateInstanceRequest.VariablesEntry
+	nil,                                            // 120: rill.runtime.v1.EditInstanceRequest.VariablesEntry
+	(*ReconcileError_CharLocation)(nil),            // 121: rill.runtime.v1.ReconcileError.CharLocation
+	(*MetricsViewFilter_Cond)(nil),                 // 122: rill.runtime.v1.MetricsViewFilter.Cond
+	(*TimeRangeSummary_Interval)(nil),              // 123: rill.runtime.v1.TimeRangeSummary.Interval
+	(*NumericOutliers_Outlier)(nil),                // 124: rill.runtime.v1.NumericOutliers.Outlier
+	(*TopK_Entry)(nil),                             // 125: rill.runtime.v1.TopK.Entry
+	(*NumericHistogramBins_Bin)(nil),               // 126: rill.runtime.v1.NumericHistogramBins.Bin
+	(*GenerateTimeSeriesRequest_BasicMeasure)(nil), // 127: rill.runtime.v1.GenerateTimeSeriesRequest.BasicMeasure
+	nil,                           // 128: rill.runtime.v1.TimeSeriesValue.RecordsEntry
+	(*Connector_Property)(nil),    // 129: rill.runtime.v1.Connector.Property
+	(*timestamppb.Timestamp)(nil), // 130: google.protobuf.Timestamp
+	(*Table)(nil),                 // 131: rill.runtime.v1.Table
+	(*Source)(nil),                // 132: rill.runtime.v1.Source
+	(*Model)(nil),                 // 133: rill.runtime.v1.Model
+	(*MetricsView)(nil),           // 134: rill.runtime.v1.MetricsView
+	(ObjectType)(0),               // 135: rill.runtime.v1.ObjectType
+	(*structpb.Value)(nil),        // 136: google.protobuf.Value
+	(*StructType)(nil),            // 137: rill.runtime.v1.StructType
+	(*structpb.Struct)(nil),       // 138: google.protobuf.Struct
 }
 var file_rill_runtime_v1_api_proto_depIdxs = []int32{
-	107, // 0: rill.runtime.v1.PingResponse.time:type_name -> google.protobuf.Timestamp
-	7,   // 1: rill.runtime.v1.ListInstancesResponse.instances:type_name -> rill.runtime.v1.Instance
-	7,   // 2: rill.runtime.v1.GetInstanceResponse.instance:type_name -> rill.runtime.v1.Instance
-	7,   // 3: rill.runtime.v1.CreateInstanceResponse.instance:type_name -> rill.runtime.v1.Instance
-	107, // 4: rill.runtime.v1.GetFileResponse.updated_on:type_name -> google.protobuf.Timestamp
-	108, // 5: rill.runtime.v1.CatalogEntry.table:type_name -> rill.runtime.v1.Table
-	109, // 6: rill.runtime.v1.CatalogEntry.source:type_name -> rill.runtime.v1.Source
-	110, // 7: rill.runtime.v1.CatalogEntry.model:type_name -> rill.runtime.v1.Model
-	111, // 8: rill.runtime.v1.CatalogEntry.metrics_view:type_name -> rill.runtime.v1.MetricsView
-	107, // 9: rill.runtime.v1.CatalogEntry.created_on:type_name -> google.protobuf.Timestamp
-	107, // 10: rill.runtime.v1.CatalogEntry.updated_on:type_name -> google.protobuf.Timestamp
-	107, // 11: rill.runtime.v1.CatalogEntry.refreshed_on:type_name -> google.protobuf.Timestamp
-	112, // 12: rill.runtime.v1.ListCatalogEntriesRequest.type:type_name -> rill.runtime.v1.ObjectType
-	26,  // 13: rill.runtime.v1.ListCatalogEntriesResponse.entries:type_name -> rill.runtime.v1.CatalogEntry
-	26,  // 14: rill.runtime.v1.GetCatalogEntryResponse.entry:type_name -> rill.runtime.v1.CatalogEntry
-	37,  // 15: rill.runtime.v1.ReconcileResponse.errors:type_name -> rill.runtime.v1.ReconcileError
-	3,   // 16: rill.runtime.v1.ReconcileError.code:type_name -> rill.runtime.v1.ReconcileError.Code
-	98,  // 17: rill.runtime.v1.ReconcileError.start_location:type_name -> rill.runtime.v1.ReconcileError.CharLocation
-	98,  // 18: rill.runtime.v1.ReconcileError.end_location:type_name -> rill.runtime.v1.ReconcileError.CharLocation
-	37,  // 19: rill.runtime.v1.PutFileAndReconcileResponse.errors:type_name -> rill.runtime.v1.ReconcileError
-	37,  // 20: rill.runtime.v1.DeleteFileAndReconcileResponse.errors:type_name -> rill.runtime.v1.ReconcileError
-	37,  // 21: rill.runtime.v1.RenameFileAndReconcileResponse.errors:type_name -> rill.runtime.v1.ReconcileError
-	37,  // 22: rill.runtime.v1.RefreshAndReconcileResponse.errors:type_name -> rill.runtime.v1.ReconcileError
-	113, // 23: rill.runtime.v1.QueryRequest.args:type_name -> google.protobuf.Value
-	114, // 24: rill.runtime.v1.QueryResponse.meta:type_name -> rill.runtime.v1.StructType
-	115, // 25: rill.runtime.v1.QueryResponse.data:type_name -> google.protobuf.Struct
-	107, // 26: rill.runtime.v1.MetricsViewToplistRequest.time_start:type_name -> google.protobuf.Timestamp
-	107, // 27: rill.runtime.v1.MetricsViewToplistRequest.time_end:type_name -> google.protobuf.Timestamp
-	54,  // 28: rill.runtime.v1.MetricsViewToplistRequest.sort:type_name -> rill.runtime.v1.MetricsViewSort
-	55,  // 29: rill.runtime.v1.MetricsViewToplistRequest.filter:type_name -> rill.runtime.v1.MetricsViewFilter
-	56,  // 30: rill.runtime.v1.MetricsViewToplistResponse.meta:type_name -> rill.runtime.v1.MetricsViewColumn
-	115, // 31: rill.runtime.v1.MetricsViewToplistResponse.data:type_name -> google.protobuf.Struct
-	107, // 32: rill.runtime.v1.MetricsViewTimeSeriesRequest.time_start:type_name -> google.protobuf.Timestamp
-	107, // 33: rill.runtime.v1.MetricsViewTimeSeriesRequest.time_end:type_name -> google.protobuf.Timestamp
-	55,  // 34: rill.runtime.v1.MetricsViewTimeSeriesRequest.filter:type_name -> rill.runtime.v1.MetricsViewFilter
-	56,  // 35: rill.runtime.v1.MetricsViewTimeSeriesResponse.meta:type_name -> rill.runtime.v1.MetricsViewColumn
-	115, // 36: rill.runtime.v1.MetricsViewTimeSeriesResponse.data:type_name -> google.protobuf.Struct
-	107, // 37: rill.runtime.v1.MetricsViewTotalsRequest.time_start:type_name -> google.protobuf.Timestamp
-	107, // 38: rill.runtime.v1.MetricsViewTotalsRequest.time_end:type_name -> google.protobuf.Timestamp
-	55,  // 39: rill.runtime.v1.MetricsViewTotalsRequest.filter:type_name -> rill.runtime.v1.MetricsViewFilter
-	56,  // 40: rill.runtime.v1.MetricsViewTotalsResponse.meta:type_name -> rill.runtime.v1.MetricsViewColumn
-	115, // 41: rill.runtime.v1.MetricsViewTotalsResponse.data:type_name -> google.protobuf.Struct
-	99,  // 42: rill.runtime.v1.MetricsViewFilter.include:type_name -> rill.runtime.v1.MetricsViewFilter.Cond
-	99,  // 43: rill.runtime.v1.MetricsViewFilter.exclude:type_name -> rill.runtime.v1.MetricsViewFilter.Cond
-	107, // 44: rill.runtime.v1.EstimateRollupIntervalResponse.start:type_name -> google.protobuf.Timestamp
-	107, // 45: rill.runtime.v1.EstimateRollupIntervalResponse.end:type_name -> google.protobuf.Timestamp
-	0,   // 46: rill.runtime.v1.EstimateRollupIntervalResponse.interval:type_name -> rill.runtime.v1.TimeGrain
-	60,  // 47: rill.runtime.v1.ProfileColumnSummary.categorical_summary:type_name -> rill.runtime.v1.CategoricalSummary
-	63,  // 48: rill.runtime.v1.ProfileColumnSummary.numeric_summary:type_name -> rill.runtime.v1.NumericSummary
-	68,  // 49: rill.runtime.v1.ProfileColumnSummary.time_range_summary:type_name -> rill.runtime.v1.TimeRangeSummary
-	71,  // 50: rill.runtime.v1.CategoricalSummary.top_k:type_name -> rill.runtime.v1.TopK
-	60,  // 51: rill.runtime.v1.GetCardinalityOfColumnResponse.categorical_summary:type_name -> rill.runtime.v1.CategoricalSummary
-	60,  // 52: rill.runtime.v1.GetTopKResponse.categorical_summary:type_name -> rill.runtime.v1.CategoricalSummary
-	79,  // 53: rill.runtime.v1.NumericSummary.numeric_histogram_bins:type_name -> rill.runtime.v1.NumericHistogramBins
-	75,  // 54: rill.runtime.v1.NumericSummary.numeric_statistics:type_name -> rill.runtime.v1.NumericStatistics
-	69,  // 55: rill.runtime.v1.NumericSummary.numeric_outliers:type_name -> rill.runtime.v1.NumericOutliers
-	63,  // 56: rill.runtime.v1.GetRugHistogramResponse.numeric_summary:type_name -> rill.runtime.v1.NumericSummary
-	63,  // 57: rill.runtime.v1.GetNumericHistogramResponse.numeric_summary:type_name -> rill.runtime.v1.NumericSummary
-	63,  // 58: rill.runtime.v1.GetDescriptiveStatisticsResponse.numeric_summary:type_name -> rill.runtime.v1.NumericSummary
-	68,  // 59: rill.runtime.v1.GetTimeRangeSummaryResponse.time_range_summary:type_name -> rill.runtime.v1.TimeRangeSummary
-	107, // 60: rill.runtime.v1.TimeRangeSummary.min:type_name -> google.protobuf.Timestamp
-	107, // 61: rill.runtime.v1.TimeRangeSummary.max:type_name -> google.protobuf.Timestamp
-	100, // 62: rill.runtime.v1.TimeRangeSummary.interval:type_name -> rill.runtime.v1.TimeRangeSummary.Interval
-	101, // 63: rill.runtime.v1.NumericOutliers.outliers:type_name -> rill.runtime.v1.NumericOutliers.Outlier
-	102, // 64: rill.runtime.v1.TopK.entries:type_name -> rill.runtime.v1.TopK.Entry
-	0,   // 65: rill.runtime.v1.EstimateSmallestTimeGrainResponse.time_grain:type_name -> rill.runtime.v1.TimeGrain
-	103, // 66: rill.runtime.v1.NumericHistogramBins.bins:type_name -> rill.runtime.v1.NumericHistogramBins.Bin
-	104, // 67: rill.runtime.v1.GenerateTimeSeriesRequest.measures:type_name -> rill.runtime.v1.GenerateTimeSeriesRequest.BasicMeasure
-	84,  // 68: rill.runtime.v1.GenerateTimeSeriesRequest.time_range:type_name -> rill.runtime.v1.TimeSeriesTimeRange
-	55,  // 69: rill.runtime.v1.GenerateTimeSeriesRequest.filters:type_name -> rill.runtime.v1.MetricsViewFilter
-	107, // 70: rill.runtime.v1.TimeSeriesTimeRange.start:type_name -> google.protobuf.Timestamp
-	107, // 71: rill.runtime.v1.TimeSeriesTimeRange.end:type_name -> google.protobuf.Timestamp
-	0,   // 72: rill.runtime.v1.TimeSeriesTimeRange.interval:type_name -> rill.runtime.v1.TimeGrain
-	87,  // 73: rill.runtime.v1.TimeSeriesResponse.results:type_name -> rill.runtime.v1.TimeSeriesValue
-	87,  // 74: rill.runtime.v1.TimeSeriesResponse.spark:type_name -> rill.runtime.v1.TimeSeriesValue
-	84,  // 75: rill.runtime.v1.TimeSeriesResponse.time_range:type_name -> rill.runtime.v1.TimeSeriesTimeRange
-	85,  // 76: rill.runtime.v1.GenerateTimeSeriesResponse.rollup:type_name -> rill.runtime.v1.TimeSeriesResponse
-	105, // 77: rill.runtime.v1.TimeSeriesValue.records:type_name -> rill.runtime.v1.TimeSeriesValue.RecordsEntry
-	92,  // 78: rill.runtime.v1.ProfileColumnsResponse.profile_columns:type_name -> rill.runtime.v1.ProfileColumn
-	115, // 79: rill.runtime.v1.GetTableRowsResponse.data:type_name -> google.protobuf.Struct
-	106, // 80: rill.runtime.v1.Connector.properties:type_name -> rill.runtime.v1.Connector.Property
-	95,  // 81: rill.runtime.v1.ListConnectorsResponse.connectors:type_name -> rill.runtime.v1.Connector
-	113, // 82: rill.runtime.v1.MetricsViewFilter.Cond.in:type_name -> google.protobuf.Value
-	113, // 83: rill.runtime.v1.TopK.Entry.value:type_name -> google.protobuf.Value
-	4,   // 84: rill.runtime.v1.Connector.Property.type:type_name -> rill.runtime.v1.Connector.Property.Type
-	5,   // 85: rill.runtime.v1.RuntimeService.Ping:input_type -> rill.runtime.v1.PingRequest
-	8,   // 86: rill.runtime.v1.RuntimeService.ListInstances:input_type -> rill.runtime.v1.ListInstancesRequest
-	10,  // 87: rill.runtime.v1.RuntimeService.GetInstance:input_type -> rill.runtime.v1.GetInstanceRequest
-	12,  // 88: rill.runtime.v1.RuntimeService.CreateInstance:input_type -> rill.runtime.v1.CreateInstanceRequest
-	14,  // 89: rill.runtime.v1.RuntimeService.DeleteInstance:input_type -> rill.runtime.v1.DeleteInstanceRequest
-	16,  // 90: rill.runtime.v1.RuntimeService.ListFiles:input_type -> rill.runtime.v1.ListFilesRequest
-	18,  // 91: rill.runtime.v1.RuntimeService.GetFile:input_type -> rill.runtime.v1.GetFileRequest
-	20,  // 92: rill.runtime.v1.RuntimeService.PutFile:input_type -> rill.runtime.v1.PutFileRequest
-	22,  // 93: rill.runtime.v1.RuntimeService.DeleteFile:input_type -> rill.runtime.v1.DeleteFileRequest
-	24,  // 94: rill.runtime.v1.RuntimeService.RenameFile:input_type -> rill.runtime.v1.RenameFileRequest
-	27,  // 95: rill.runtime.v1.RuntimeService.ListCatalogEntries:input_type -> rill.runtime.v1.ListCatalogEntriesRequest
-	29,  // 96: rill.runtime.v1.RuntimeService.GetCatalogEntry:input_type -> rill.runtime.v1.GetCatalogEntryRequest
-	31,  // 97: rill.runtime.v1.RuntimeService.TriggerRefresh:input_type -> rill.runtime.v1.TriggerRefreshRequest
-	33,  // 98: rill.runtime.v1.RuntimeService.TriggerSync:input_type -> rill.runtime.v1.TriggerSyncRequest
-	35,  // 99: rill.runtime.v1.RuntimeService.Reconcile:input_type -> rill.runtime.v1.ReconcileRequest
-	38,  // 100: rill.runtime.v1.RuntimeService.PutFileAndReconcile:input_type -> rill.runtime.v1.PutFileAndReconcileRequest
-	40,  // 101: rill.runtime.v1.RuntimeService.DeleteFileAndReconcile:input_type -> rill.runtime.v1.DeleteFileAndReconcileRequest
-	42,  // 102: rill.runtime.v1.RuntimeService.RenameFileAndReconcile:input_type -> rill.runtime.v1.RenameFileAndReconcileRequest
-	44,  // 103: rill.runtime.v1.RuntimeService.RefreshAndReconcile:input_type -> rill.runtime.v1.RefreshAndReconcileRequest
-	46,  // 104: rill.runtime.v1.RuntimeService.Query:input_type -> rill.runtime.v1.QueryRequest
-	48,  // 105: rill.runtime.v1.RuntimeService.MetricsViewToplist:input_type -> rill.runtime.v1.MetricsViewToplistRequest
-	50,  // 106: rill.runtime.v1.RuntimeService.MetricsViewTimeSeries:input_type -> rill.runtime.v1.MetricsViewTimeSeriesRequest
-	52,  // 107: rill.runtime.v1.RuntimeService.MetricsViewTotals:input_type -> rill.runtime.v1.MetricsViewTotalsRequest
-	57,  // 108: rill.runtime.v1.RuntimeService.EstimateRollupInterval:input_type -> rill.runtime.v1.EstimateRollupIntervalRequest
-	70,  // 109: rill.runtime.v1.RuntimeService.GetTopK:input_type -> rill.runtime.v1.GetTopKRequest
-	72,  // 110: rill.runtime.v1.RuntimeService.GetNullCount:input_type -> rill.runtime.v1.GetNullCountRequest
-	74,  // 111: rill.runtime.v1.RuntimeService.GetDescriptiveStatistics:input_type -> rill.runtime.v1.GetDescriptiveStatisticsRequest
-	76,  // 112: rill.runtime.v1.RuntimeService.EstimateSmallestTimeGrain:input_type -> rill.runtime.v1.EstimateSmallestTimeGrainRequest
-	78,  // 113: rill.runtime.v1.RuntimeService.GetNumericHistogram:input_type -> rill.runtime.v1.GetNumericHistogramRequest
-	80,  // 114: rill.runtime.v1.RuntimeService.GetRugHistogram:input_type -> rill.runtime.v1.GetRugHistogramRequest
-	81,  // 115: rill.runtime.v1.RuntimeService.GetTimeRangeSummary:input_type -> rill.runtime.v1.GetTimeRangeSummaryRequest
-	82,  // 116: rill.runtime.v1.RuntimeService.GetCardinalityOfColumn:input_type -> rill.runtime.v1.GetCardinalityOfColumnRequest
-	83,  // 117: rill.runtime.v1.RuntimeService.GenerateTimeSeries:input_type -> rill.runtime.v1.GenerateTimeSeriesRequest
-	88,  // 118: rill.runtime.v1.RuntimeService.GetTableCardinality:input_type -> rill.runtime.v1.GetTableCardinalityRequest
-	90,  // 119: rill.runtime.v1.RuntimeService.ProfileColumns:input_type -> rill.runtime.v1.ProfileColumnsRequest
-	93,  // 120: rill.runtime.v1.RuntimeService.GetTableRows:input_type -> rill.runtime.v1.GetTableRowsRequest
-	96,  // 121: rill.runtime.v1.RuntimeService.ListConnectors:input_type -> rill.runtime.v1.ListConnectorsRequest
-	6,   // 122: rill.runtime.v1.RuntimeService.Ping:output_type -> rill.runtime.v1.PingResponse
-	9,   // 123: rill.runtime.v1.RuntimeService.ListInstances:output_type -> rill.runtime.v1.ListInstancesResponse
-	11,  // 124: rill.runtime.v1.RuntimeService.GetInstance:output_type -> rill.runtime.v1.GetInstanceResponse
-	13,  // 125: rill.runtime.v1.RuntimeService.CreateInstance:output_type -> rill.runtime.v1.CreateInstanceResponse
-	15,  // 126: rill.runtime.v1.RuntimeService.DeleteInstance:output_type -> rill.runtime.v1.DeleteInstanceResponse
-	17,  // 127: rill.runtime.v1.RuntimeService.ListFiles:output_type -> rill.runtime.v1.ListFilesResponse
-	19,  // 128: rill.runtime.v1.RuntimeService.GetFile:output_type -> rill.runtime.v1.GetFileResponse
-	21,  // 129: rill.runtime.v1.RuntimeService.PutFile:output_type -> rill.runtime.v1.PutFileResponse
-	23,  // 130: rill.runtime.v1.RuntimeService.DeleteFile:output_type -> rill.runtime.v1.DeleteFileResponse
-	25,  // 131: rill.runtime.v1.RuntimeService.RenameFile:output_type -> rill.runtime.v1.RenameFileResponse
-	28,  // 132: rill.runtime.v1.RuntimeService.ListCatalogEntries:output_type -> rill.runtime.v1.ListCatalogEntriesResponse
-	30,  // 133: rill.runtime.v1.RuntimeService.GetCatalogEntry:output_type -> rill.runtime.v1.GetCatalogEntryResponse
-	32,  // 134: rill.runtime.v1.RuntimeService.TriggerRefresh:output_type -> rill.runtime.v1.TriggerRefreshResponse
-	34,  // 135: rill.runtime.v1.RuntimeService.TriggerSync:output_type -> rill.runtime.v1.TriggerSyncResponse
-	36,  // 136: rill.runtime.v1.RuntimeService.Reconcile:output_type -> rill.runtime.v1.ReconcileResponse
-	39,  // 137: rill.runtime.v1.RuntimeService.PutFileAndReconcile:output_type -> rill.runtime.v1.PutFileAndReconcileResponse
-	41,  // 138: rill.runtime.v1.RuntimeService.DeleteFileAndReconcile:output_type -> rill.runtime.v1.DeleteFileAndReconcileResponse
-	43,  // 139: rill.runtime.v1.RuntimeService.RenameFileAndReconcile:output_type -> rill.runtime.v1.RenameFileAndReconcileResponse
-	45,  // 140: rill.runtime.v1.RuntimeService.RefreshAndReconcile:output_type -> rill.runtime.v1.RefreshAndReconcileResponse
-	47,  // 141: rill.runtime.v1.RuntimeService.Query:output_type -> rill.runtime.v1.QueryResponse
-	49,  // 142: rill.runtime.v1.RuntimeService.MetricsViewToplist:output_type -> rill.runtime.v1.MetricsViewToplistResponse
-	51,  // 143: rill.runtime.v1.RuntimeService.MetricsViewTimeSeries:output_type -> rill.runtime.v1.MetricsViewTimeSeriesResponse
-	53,  // 144: rill.runtime.v1.RuntimeService.MetricsViewTotals:output_type -> rill.runtime.v1.MetricsViewTotalsResponse
-	58,  // 145: rill.runtime.v1.RuntimeService.EstimateRollupInterval:output_type -> rill.runtime.v1.EstimateRollupIntervalResponse
-	62,  // 146: rill.runtime.v1.RuntimeService.GetTopK:output_type -> rill.runtime.v1.GetTopKResponse
-	73,  // 147: rill.runtime.v1.RuntimeService.GetNullCount:output_type -> rill.runtime.v1.GetNullCountResponse
-	66,  // 148: rill.runtime.v1.RuntimeService.GetDescriptiveStatistics:output_type -> rill.runtime.v1.GetDescriptiveStatisticsResponse
-	77,  // 149: rill.runtime.v1.RuntimeService.EstimateSmallestTimeGrain:output_type -> rill.runtime.v1.EstimateSmallestTimeGrainResponse
-	65,  // 150: rill.runtime.v1.RuntimeService.GetNumericHistogram:output_type -> rill.runtime.v1.GetNumericHistogramResponse
-	64,  // 151: rill.runtime.v1.RuntimeService.GetRugHistogram:output_type -> rill.runtime.v1.GetRugHistogramResponse
-	67,  // 152: rill.runtime.v1.RuntimeService.GetTimeRangeSummary:output_type -> rill.runtime.v1.GetTimeRangeSummaryResponse
-	61,  // 153: rill.runtime.v1.RuntimeService.GetCardinalityOfColumn:output_type -> rill.runtime.v1.GetCardinalityOfColumnResponse
-	86,  // 154: rill.runtime.v1.RuntimeService.GenerateTimeSeries:output_type -> rill.runtime.v1.GenerateTimeSeriesResponse
-	89,  // 155: rill.runtime.v1.RuntimeService.GetTableCardinality:output_type -> rill.runtime.v1.GetTableCardinalityResponse
-	91,  // 156: rill.runtime.v1.RuntimeService.ProfileColumns:output_type -> rill.runtime.v1.ProfileColumnsResponse
-	94,  // 157: rill.runtime.v1.RuntimeService.GetTableRows:output_type -> rill.runtime.v1.GetTableRowsResponse
-	97,  // 158: rill.runtime.v1.RuntimeService.ListConnectors:output_type -> rill.runtime.v1.ListConnectorsResponse
-	122, // [122:159] is the sub-list for method output_type
-	85,  // [85:122] is the sub-list for method input_type
-	85,  // [85:85] is the sub-list for extension type_name
-	85,  // [85:85] is the sub-list for extension extendee
-	0,   // [0:85] is the sub-list for field type_name
+	130, // 0: rill.runtime.v1.PingResponse.time:type_name -> google.protobuf.Timestamp
+	118, // 1: rill.runtime.v1.Instance.variables:type_name -> rill.runtime.v1.Instance.VariablesEntry
+	9,   // 2: rill.runtime.v1.ListInstancesResponse.instances:type_name -> rill.runtime.v1.Instance
+	9,   // 3: rill.runtime.v1.GetInstanceResponse.instance:type_name -> rill.runtime.v1.Instance
+	119, // 4: rill.runtime.v1.CreateInstanceRequest.variables:type_name -> rill.runtime.v1.CreateInstanceRequest.VariablesEntry
+	9,   // 5: rill.runtime.v1.CreateInstanceResponse.instance:type_name -> rill.runtime.v1.Instance
+	120, // 6: rill.runtime.v1.EditInstanceRequest.variables:type_name -> rill.runtime.v1.EditInstanceRequest.VariablesEntry
+	9,   // 7: rill.runtime.v1.EditInstanceResponse.instance:type_name -> rill.runtime.v1.Instance
+	130, // 8: rill.runtime.v1.GetFileResponse.updated_on:type_name -> google.protobuf.Timestamp
+	130, // 9: rill.runtime.v1.FileRevision.created_on:type_name -> google.protobuf.Timestamp
+	30,  // 10: rill.runtime.v1.ListFileRevisionsResponse.revisions:type_name -> rill.runtime.v1.FileRevision
+	131, // 11: rill.runtime.v1.CatalogEntry.table:type_name -> rill.runtime.v1.Table
+	132, // 12: rill.runtime.v1.CatalogEntry.source:type_name -> rill.runtime.v1.Source
+	133, // 13: rill.runtime.v1.CatalogEntry.model:type_name -> rill.runtime.v1.Model
+	134, // 14: rill.runtime.v1.CatalogEntry.metrics_view:type_name -> rill.runtime.v1.MetricsView
+	130, // 15: rill.runtime.v1.CatalogEntry.created_on:type_name -> google.protobuf.Timestamp
+	130, // 16: rill.runtime.v1.CatalogEntry.updated_on:type_name -> google.protobuf.Timestamp
+	130, // 17: rill.runtime.v1.CatalogEntry.refreshed_on:type_name -> google.protobuf.Timestamp
+	135, // 18: rill.runtime.v1.ListCatalogEntriesRequest.type:type_name -> rill.runtime.v1.ObjectType
+	35,  // 19: rill.runtime.v1.ListCatalogEntriesResponse.entries:type_name -> rill.runtime.v1.CatalogEntry
+	35,  // 20: rill.runtime.v1.GetCatalogEntryResponse.entry:type_name -> rill.runtime.v1.CatalogEntry
+	3,   // 21: rill.runtime.v1.CatalogEntryVersion.operation:type_name -> rill.runtime.v1.CatalogEntryVersion.Operation
+	35,  // 22: rill.runtime.v1.CatalogEntryVersion.entry:type_name -> rill.runtime.v1.CatalogEntry
+	130, // 23: rill.runtime.v1.CatalogEntryVersion.created_on:type_name -> google.protobuf.Timestamp
+	40,  // 24: rill.runtime.v1.ListCatalogEntryVersionsResponse.versions:type_name -> rill.runtime.v1.CatalogEntryVersion
+	55,  // 25: rill.runtime.v1.RestoreCatalogEntryVersionResponse.errors:type_name -> rill.runtime.v1.ReconcileError
+	55,  // 26: rill.runtime.v1.ReconcileResponse.errors:type_name -> rill.runtime.v1.ReconcileError
+	52,  // 27: rill.runtime.v1.ReconcileStreamResponse.event:type_name -> rill.runtime.v1.ReconcileEvent
+	50,  // 28: rill.runtime.v1.ReconcileStreamResponse.result:type_name -> rill.runtime.v1.ReconcileResponse
+	4,   // 29: rill.runtime.v1.ReconcileEvent.type:type_name -> rill.runtime.v1.ReconcileEvent.Type
+	55,  // 30: rill.runtime.v1.ReconcileEvent.errors:type_name -> rill.runtime.v1.ReconcileError
+	55,  // 31: rill.runtime.v1.WatchReconcileResponse.errors:type_name -> rill.runtime.v1.ReconcileError
+	5,   // 32: rill.runtime.v1.ReconcileError.code:type_name -> rill.runtime.v1.ReconcileError.Code
+	121, // 33: rill.runtime.v1.ReconcileError.start_location:type_name -> rill.runtime.v1.ReconcileError.CharLocation
+	121, // 34: rill.runtime.v1.ReconcileError.end_location:type_name -> rill.runtime.v1.ReconcileError.CharLocation
+	55,  // 35: rill.runtime.v1.PutFileAndReconcileResponse.errors:type_name -> rill.runtime.v1.ReconcileError
+	55,  // 36: rill.runtime.v1.DeleteFileAndReconcileResponse.errors:type_name -> rill.runtime.v1.ReconcileError
+	55,  // 37: rill.runtime.v1.RenameFileAndReconcileResponse.errors:type_name -> rill.runtime.v1.ReconcileError
+	55,  // 38: rill.runtime.v1.RefreshAndReconcileResponse.errors:type_name -> rill.runtime.v1.ReconcileError
+	136, // 39: rill.runtime.v1.QueryRequest.args:type_name -> google.protobuf.Value
+	137, // 40: rill.runtime.v1.QueryResponse.meta:type_name -> rill.runtime.v1.StructType
+	138, // 41: rill.runtime.v1.QueryResponse.data:type_name -> google.protobuf.Struct
+	136, // 42: rill.runtime.v1.QueryArrowRequest.args:type_name -> google.protobuf.Value
+	130, // 43: rill.runtime.v1.MetricsViewToplistRequest.time_start:type_name -> google.protobuf.Timestamp
+	130, // 44: rill.runtime.v1.MetricsViewToplistRequest.time_end:type_name -> google.protobuf.Timestamp
+	74,  // 45: rill.runtime.v1.MetricsViewToplistRequest.sort:type_name -> rill.runtime.v1.MetricsViewSort
+	75,  // 46: rill.runtime.v1.MetricsViewToplistRequest.filter:type_name -> rill.runtime.v1.MetricsViewFilter
+	76,  // 47: rill.runtime.v1.MetricsViewToplistResponse.meta:type_name -> rill.runtime.v1.MetricsViewColumn
+	138, // 48: rill.runtime.v1.MetricsViewToplistResponse.data:type_name -> google.protobuf.Struct
+	130, // 49: rill.runtime.v1.MetricsViewTimeSeriesRequest.time_start:type_name -> google.protobuf.Timestamp
+	130, // 50: rill.runtime.v1.MetricsViewTimeSeriesRequest.time_end:type_name -> google.protobuf.Timestamp
+	75,  // 51: rill.runtime.v1.MetricsViewTimeSeriesRequest.filter:type_name -> rill.runtime.v1.MetricsViewFilter
+	76,  // 52: rill.runtime.v1.MetricsViewTimeSeriesResponse.meta:type_name -> rill.runtime.v1.MetricsViewColumn
+	138, // 53: rill.runtime.v1.MetricsViewTimeSeriesResponse.data:type_name -> google.protobuf.Struct
+	130, // 54: rill.runtime.v1.MetricsViewTotalsRequest.time_start:type_name -> google.protobuf.Timestamp
+	130, // 55: rill.runtime.v1.MetricsViewTotalsRequest.time_end:type_name -> google.protobuf.Timestamp
+	75,  // 56: rill.runtime.v1.MetricsViewTotalsRequest.filter:type_name -> rill.runtime.v1.MetricsViewFilter
+	76,  // 57: rill.runtime.v1.MetricsViewTotalsResponse.meta:type_name -> rill.runtime.v1.MetricsViewColumn
+	138, // 58: rill.runtime.v1.MetricsViewTotalsResponse.data:type_name -> google.protobuf.Struct
+	122, // 59: rill.runtime.v1.MetricsViewFilter.include:type_name -> rill.runtime.v1.MetricsViewFilter.Cond
+	122, // 60: rill.runtime.v1.MetricsViewFilter.exclude:type_name -> rill.runtime.v1.MetricsViewFilter.Cond
+	130, // 61: rill.runtime.v1.EstimateRollupIntervalResponse.start:type_name -> google.protobuf.Timestamp
+	130, // 62: rill.runtime.v1.EstimateRollupIntervalResponse.end:type_name -> google.protobuf.Timestamp
+	0,   // 63: rill.runtime.v1.EstimateRollupIntervalResponse.interval:type_name -> rill.runtime.v1.TimeGrain
+	80,  // 64: rill.runtime.v1.ProfileColumnSummary.categorical_summary:type_name -> rill.runtime.v1.CategoricalSummary
+	83,  // 65: rill.runtime.v1.ProfileColumnSummary.numeric_summary:type_name -> rill.runtime.v1.NumericSummary
+	88,  // 66: rill.runtime.v1.ProfileColumnSummary.time_range_summary:type_name -> rill.runtime.v1.TimeRangeSummary
+	91,  // 67: rill.runtime.v1.CategoricalSummary.top_k:type_name -> rill.runtime.v1.TopK
+	80,  // 68: rill.runtime.v1.GetCardinalityOfColumnResponse.categorical_summary:type_name -> rill.runtime.v1.CategoricalSummary
+	80,  // 69: rill.runtime.v1.GetTopKResponse.categorical_summary:type_name -> rill.runtime.v1.CategoricalSummary
+	99,  // 70: rill.runtime.v1.NumericSummary.numeric_histogram_bins:type_name -> rill.runtime.v1.NumericHistogramBins
+	95,  // 71: rill.runtime.v1.NumericSummary.numeric_statistics:type_name -> rill.runtime.v1.NumericStatistics
+	89,  // 72: rill.runtime.v1.NumericSummary.numeric_outliers:type_name -> rill.runtime.v1.NumericOutliers
+	83,  // 73: rill.runtime.v1.GetRugHistogramResponse.numeric_summary:type_name -> rill.runtime.v1.NumericSummary
+	83,  // 74: rill.runtime.v1.GetNumericHistogramResponse.numeric_summary:type_name -> rill.runtime.v1.NumericSummary
+	83,  // 75: rill.runtime.v1.GetDescriptiveStatisticsResponse.numeric_summary:type_name -> rill.runtime.v1.NumericSummary
+	88,  // 76: rill.runtime.v1.GetTimeRangeSummaryResponse.time_range_summary:type_name -> rill.runtime.v1.TimeRangeSummary
+	130, // 77: rill.runtime.v1.TimeRangeSummary.min:type_name -> google.protobuf.Timestamp
+	130, // 78: rill.runtime.v1.TimeRangeSummary.max:type_name -> google.protobuf.Timestamp
+	123, // 79: rill.runtime.v1.TimeRangeSummary.interval:type_name -> rill.runtime.v1.TimeRangeSummary.Interval
+	124, // 80: rill.runtime.v1.NumericOutliers.outliers:type_name -> rill.runtime.v1.NumericOutliers.Outlier
+	125, // 81: rill.runtime.v1.TopK.entries:type_name -> rill.runtime.v1.TopK.Entry
+	0,   // 82: rill.runtime.v1.EstimateSmallestTimeGrainResponse.time_grain:type_name -> rill.runtime.v1.TimeGrain
+	126, // 83: rill.runtime.v1.NumericHistogramBins.bins:type_name -> rill.runtime.v1.NumericHistogramBins.Bin
+	127, // 84: rill.runtime.v1.GenerateTimeSeriesRequest.measures:type_name -> rill.runtime.v1.GenerateTimeSeriesRequest.BasicMeasure
+	104, // 85: rill.runtime.v1.GenerateTimeSeriesRequest.time_range:type_name -> rill.runtime.v1.TimeSeriesTimeRange
+	75,  // 86: rill.runtime.v1.GenerateTimeSeriesRequest.filters:type_name -> rill.runtime.v1.MetricsViewFilter
+	130, // 87: rill.runtime.v1.TimeSeriesTimeRange.start:type_name -> google.protobuf.Timestamp
+	130, // 88: rill.runtime.v1.TimeSeriesTimeRange.end:type_name -> google.protobuf.Timestamp
+	0,   // 89: rill.runtime.v1.TimeSeriesTimeRange.interval:type_name -> rill.runtime.v1.TimeGrain
+	107, // 90: rill.runtime.v1.TimeSeriesResponse.results:type_name -> rill.runtime.v1.TimeSeriesValue
+	107, // 91: rill.runtime.v1.TimeSeriesResponse.spark:type_name -> rill.runtime.v1.TimeSeriesValue
+	104, // 92: rill.runtime.v1.TimeSeriesResponse.time_range:type_name -> rill.runtime.v1.TimeSeriesTimeRange
+	105, // 93: rill.runtime.v1.GenerateTimeSeriesResponse.rollup:type_name -> rill.runtime.v1.TimeSeriesResponse
+	128, // 94: rill.runtime.v1.TimeSeriesValue.records:type_name -> rill.runtime.v1.TimeSeriesValue.RecordsEntry
+	112, // 95: rill.runtime.v1.ProfileColumnsResponse.profile_columns:type_name -> rill.runtime.v1.ProfileColumn
+	138, // 96: rill.runtime.v1.GetTableRowsResponse.data:type_name -> google.protobuf.Struct
+	129, // 97: rill.runtime.v1.Connector.properties:type_name -> rill.runtime.v1.Connector.Property
+	115, // 98: rill.runtime.v1.ListConnectorsResponse.connectors:type_name -> rill.runtime.v1.Connector
+	136, // 99: rill.runtime.v1.MetricsViewFilter.Cond.in:type_name -> google.protobuf.Value
+	136, // 100: rill.runtime.v1.TopK.Entry.value:type_name -> google.protobuf.Value
+	6,   // 101: rill.runtime.v1.Connector.Property.type:type_name -> rill.runtime.v1.Connector.Property.Type
+	7,   // 102: rill.runtime.v1.RuntimeService.Ping:input_type -> rill.runtime.v1.PingRequest
+	10,  // 103: rill.runtime.v1.RuntimeService.ListInstances:input_type -> rill.runtime.v1.ListInstancesRequest
+	12,  // 104: rill.runtime.v1.RuntimeService.GetInstance:input_type -> rill.runtime.v1.GetInstanceRequest
+	14,  // 105: rill.runtime.v1.RuntimeService.CreateInstance:input_type -> rill.runtime.v1.CreateInstanceRequest
+	16,  // 106: rill.runtime.v1.RuntimeService.EditInstance:input_type -> rill.runtime.v1.EditInstanceRequest
+	18,  // 107: rill.runtime.v1.RuntimeService.DeleteInstance:input_type -> rill.runtime.v1.DeleteInstanceRequest
+	20,  // 108: rill.runtime.v1.RuntimeService.ListFiles:input_type -> rill.runtime.v1.ListFilesRequest
+	22,  // 109: rill.runtime.v1.RuntimeService.GetFile:input_type -> rill.runtime.v1.GetFileRequest
+	24,  // 110: rill.runtime.v1.RuntimeService.PutFile:input_type -> rill.runtime.v1.PutFileRequest
+	26,  // 111: rill.runtime.v1.RuntimeService.DeleteFile:input_type -> rill.runtime.v1.DeleteFileRequest
+	28,  // 112: rill.runtime.v1.RuntimeService.RenameFile:input_type -> rill.runtime.v1.RenameFileRequest
+	31,  // 113: rill.runtime.v1.RuntimeService.ListFileRevisions:input_type -> rill.runtime.v1.ListFileRevisionsRequest
+	33,  // 114: rill.runtime.v1.RuntimeService.RevertFile:input_type -> rill.runtime.v1.RevertFileRequest
+	36,  // 115: rill.runtime.v1.RuntimeService.ListCatalogEntries:input_type -> rill.runtime.v1.ListCatalogEntriesRequest
+	38,  // 116: rill.runtime.v1.RuntimeService.GetCatalogEntry:input_type -> rill.runtime.v1.GetCatalogEntryRequest
+	41,  // 117: rill.runtime.v1.RuntimeService.ListCatalogEntryVersions:input_type -> rill.runtime.v1.ListCatalogEntryVersionsRequest
+	43,  // 118: rill.runtime.v1.RuntimeService.RestoreCatalogEntryVersion:input_type -> rill.runtime.v1.RestoreCatalogEntryVersionRequest
+	45,  // 119: rill.runtime.v1.RuntimeService.TriggerRefresh:input_type -> rill.runtime.v1.TriggerRefreshRequest
+	47,  // 120: rill.runtime.v1.RuntimeService.TriggerSync:input_type -> rill.runtime.v1.TriggerSyncRequest
+	49,  // 121: rill.runtime.v1.RuntimeService.Reconcile:input_type -> rill.runtime.v1.ReconcileRequest
+	49,  // 122: rill.runtime.v1.RuntimeService.ReconcileStream:input_type -> rill.runtime.v1.ReconcileRequest
+	53,  // 123: rill.runtime.v1.RuntimeService.WatchReconcile:input_type -> rill.runtime.v1.WatchReconcileRequest
+	56,  // 124: rill.runtime.v1.RuntimeService.PutFileAndReconcile:input_type -> rill.runtime.v1.PutFileAndReconcileRequest
+	58,  // 125: rill.runtime.v1.RuntimeService.DeleteFileAndReconcile:input_type -> rill.runtime.v1.DeleteFileAndReconcileRequest
+	60,  // 126: rill.runtime.v1.RuntimeService.RenameFileAndReconcile:input_type -> rill.runtime.v1.RenameFileAndReconcileRequest
+	62,  // 127: rill.runtime.v1.RuntimeService.RefreshAndReconcile:input_type -> rill.runtime.v1.RefreshAndReconcileRequest
+	64,  // 128: rill.runtime.v1.RuntimeService.Query:input_type -> rill.runtime.v1.QueryRequest
+	66,  // 129: rill.runtime.v1.RuntimeService.QueryArrow:input_type -> rill.runtime.v1.QueryArrowRequest
+	68,  // 130: rill.runtime.v1.RuntimeService.MetricsViewToplist:input_type -> rill.runtime.v1.MetricsViewToplistRequest
+	70,  // 131: rill.runtime.v1.RuntimeService.MetricsViewTimeSeries:input_type -> rill.runtime.v1.MetricsViewTimeSeriesRequest
+	72,  // 132: rill.runtime.v1.RuntimeService.MetricsViewTotals:input_type -> rill.runtime.v1.MetricsViewTotalsRequest
+	77,  // 133: rill.runtime.v1.RuntimeService.EstimateRollupInterval:input_type -> rill.runtime.v1.EstimateRollupIntervalRequest
+	90,  // 134: rill.runtime.v1.RuntimeService.GetTopK:input_type -> rill.runtime.v1.GetTopKRequest
+	92,  // 135: rill.runtime.v1.RuntimeService.GetNullCount:input_type -> rill.runtime.v1.GetNullCountRequest
+	94,  // 136: rill.runtime.v1.RuntimeService.GetDescriptiveStatistics:input_type -> rill.runtime.v1.GetDescriptiveStatisticsRequest
+	96,  // 137: rill.runtime.v1.RuntimeService.EstimateSmallestTimeGrain:input_type -> rill.runtime.v1.EstimateSmallestTimeGrainRequest
+	98,  // 138: rill.runtime.v1.RuntimeService.GetNumericHistogram:input_type -> rill.runtime.v1.GetNumericHistogramRequest
+	100, // 139: rill.runtime.v1.RuntimeService.GetRugHistogram:input_type -> rill.runtime.v1.GetRugHistogramRequest
+	101, // 140: rill.runtime.v1.RuntimeService.GetTimeRangeSummary:input_type -> rill.runtime.v1.GetTimeRangeSummaryRequest
+	102, // 141: rill.runtime.v1.RuntimeService.GetCardinalityOfColumn:input_type -> rill.runtime.v1.GetCardinalityOfColumnRequest
+	103, // 142: rill.runtime.v1.RuntimeService.GenerateTimeSeries:input_type -> rill.runtime.v1.GenerateTimeSeriesRequest
+	108, // 143: rill.runtime.v1.RuntimeService.GetTableCardinality:input_type -> rill.runtime.v1.GetTableCardinalityRequest
+	110, // 144: rill.runtime.v1.RuntimeService.ProfileColumns:input_type -> rill.runtime.v1.ProfileColumnsRequest
+	113, // 145: rill.runtime.v1.RuntimeService.GetTableRows:input_type -> rill.runtime.v1.GetTableRowsRequest
+	116, // 146: rill.runtime.v1.RuntimeService.ListConnectors:input_type -> rill.runtime.v1.ListConnectorsRequest
+	8,   // 147: rill.runtime.v1.RuntimeService.Ping:output_type -> rill.runtime.v1.PingResponse
+	11,  // 148: rill.runtime.v1.RuntimeService.ListInstances:output_type -> rill.runtime.v1.ListInstancesResponse
+	13,  // 149: rill.runtime.v1.RuntimeService.GetInstance:output_type -> rill.runtime.v1.GetInstanceResponse
+	15,  // 150: rill.runtime.v1.RuntimeService.CreateInstance:output_type -> rill.runtime.v1.CreateInstanceResponse
+	17,  // 151: rill.runtime.v1.RuntimeService.EditInstance:output_type -> rill.runtime.v1.EditInstanceResponse
+	19,  // 152: rill.runtime.v1.RuntimeService.DeleteInstance:output_type -> rill.runtime.v1.DeleteInstanceResponse
+	21,  // 153: rill.runtime.v1.RuntimeService.ListFiles:output_type -> rill.runtime.v1.ListFilesResponse
+	23,  // 154: rill.runtime.v1.RuntimeService.GetFile:output_type -> rill.runtime.v1.GetFileResponse
+	25,  // 155: rill.runtime.v1.RuntimeService.PutFile:output_type -> rill.runtime.v1.PutFileResponse
+	27,  // 156: rill.runtime.v1.RuntimeService.DeleteFile:output_type -> rill.runtime.v1.DeleteFileResponse
+	29,  // 157: rill.runtime.v1.RuntimeService.RenameFile:output_type -> rill.runtime.v1.RenameFileResponse
+	32,  // 158: rill.runtime.v1.RuntimeService.ListFileRevisions:output_type -> rill.runtime.v1.ListFileRevisionsResponse
+	34,  // 159: rill.runtime.v1.RuntimeService.RevertFile:output_type -> rill.runtime.v1.RevertFileResponse
+	37,  // 160: rill.runtime.v1.RuntimeService.ListCatalogEntries:output_type -> rill.runtime.v1.ListCatalogEntriesResponse
+	39,  // 161: rill.runtime.v1.RuntimeService.GetCatalogEntry:output_type -> rill.runtime.v1.GetCatalogEntryResponse
+	42,  // 162: rill.runtime.v1.RuntimeService.ListCatalogEntryVersions:output_type -> rill.runtime.v1.ListCatalogEntryVersionsResponse
+	44,  // 163: rill.runtime.v1.RuntimeService.RestoreCatalogEntryVersion:output_type -> rill.runtime.v1.RestoreCatalogEntryVersionResponse
+	46,  // 164: rill.runtime.v1.RuntimeService.TriggerRefresh:output_type -> rill.runtime.v1.TriggerRefreshResponse
+	48,  // 165: rill.runtime.v1.RuntimeService.TriggerSync:output_type -> rill.runtime.v1.TriggerSyncResponse
+	50,  // 166: rill.runtime.v1.RuntimeService.Reconcile:output_type -> rill.runtime.v1.ReconcileResponse
+	51,  // 167: rill.runtime.v1.RuntimeService.ReconcileStream:output_type -> rill.runtime.v1.ReconcileStreamResponse
+	54,  // 168: rill.runtime.v1.RuntimeService.WatchReconcile:output_type -> rill.runtime.v1.WatchReconcileResponse
+	57,  // 169: rill.runtime.v1.RuntimeService.PutFileAndReconcile:output_type -> rill.runtime.v1.PutFileAndReconcileResponse
+	59,  // 170: rill.runtime.v1.RuntimeService.DeleteFileAndReconcile:output_type -> rill.runtime.v1.DeleteFileAndReconcileResponse
+	61,  // 171: rill.runtime.v1.RuntimeService.RenameFileAndReconcile:output_type -> rill.runtime.v1.RenameFileAndReconcileResponse
+	63,  // 172: rill.runtime.v1.RuntimeService.RefreshAndReconcile:output_type -> rill.runtime.v1.RefreshAndReconcileResponse
+	65,  // 173: rill.runtime.v1.RuntimeService.Query:output_type -> rill.runtime.v1.QueryResponse
+	67,  // 174: rill.runtime.v1.RuntimeService.QueryArrow:output_type -> rill.runtime.v1.QueryArrowResponse
+	69,  // 175: rill.runtime.v1.RuntimeService.MetricsViewToplist:output_type -> rill.runtime.v1.MetricsViewToplistResponse
+	71,  // 176: rill.runtime.v1.RuntimeService.MetricsViewTimeSeries:output_type -> rill.runtime.v1.MetricsViewTimeSeriesResponse
+	73,  // 177: rill.runtime.v1.RuntimeService.MetricsViewTotals:output_type -> rill.runtime.v1.MetricsViewTotalsResponse
+	78,  // 178: rill.runtime.v1.RuntimeService.EstimateRollupInterval:output_type -> rill.runtime.v1.EstimateRollupIntervalResponse
+	82,  // 179: rill.runtime.v1.RuntimeService.GetTopK:output_type -> rill.runtime.v1.GetTopKResponse
+	93,  // 180: rill.runtime.v1.RuntimeService.GetNullCount:output_type -> rill.runtime.v1.GetNullCountResponse
+	86,  // 181: rill.runtime.v1.RuntimeService.GetDescriptiveStatistics:output_type -> rill.runtime.v1.GetDescriptiveStatisticsResponse
+	97,  // 182: rill.runtime.v1.RuntimeService.EstimateSmallestTimeGrain:output_type -> rill.runtime.v1.EstimateSmallestTimeGrainResponse
+	85,  // 183: rill.runtime.v1.RuntimeService.GetNumericHistogram:output_type -> rill.runtime.v1.GetNumericHistogramResponse
+	84,  // 184: rill.runtime.v1.RuntimeService.GetRugHistogram:output_type -> rill.runtime.v1.GetRugHistogramResponse
+	87,  // 185: rill.runtime.v1.RuntimeService.GetTimeRangeSummary:output_type -> rill.runtime.v1.GetTimeRangeSummaryResponse
+	81,  // 186: rill.runtime.v1.RuntimeService.GetCardinalityOfColumn:output_type -> rill.runtime.v1.GetCardinalityOfColumnResponse
+	106, // 187: rill.runtime.v1.RuntimeService.GenerateTimeSeries:output_type -> rill.runtime.v1.GenerateTimeSeriesResponse
+	109, // 188: rill.runtime.v1.RuntimeService.GetTableCardinality:output_type -> rill.runtime.v1.GetTableCardinalityResponse
+	111, // 189: rill.runtime.v1.RuntimeService.ProfileColumns:output_type -> rill.runtime.v1.ProfileColumnsResponse
+	114, // 190: rill.runtime.v1.RuntimeService.GetTableRows:output_type -> rill.runtime.v1.GetTableRowsResponse
+	117, // 191: rill.runtime.v1.RuntimeService.ListConnectors:output_type -> rill.runtime.v1.ListConnectorsResponse
+	147, // [147:192] is the sub-list for method output_type
+	102, // [102:147] is the sub-list for method input_type
+	102, // [102:102] is the sub-list for extension type_name
+	102, // [102:102] is the sub-list for extension extendee
+	0,   // [0:102] is the sub-list for field type_name
 }
 
 func init() { file_rill_runtime_v1_api_proto_init() }
@@ -8525,8 +10488,200 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PingResponse); i {
+		file_rill_runtime_v1_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rill_runtime_v1_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Instance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rill_runtime_v1_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListInstancesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rill_runtime_v1_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListInstancesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rill_runtime_v1_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInstanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rill_runtime_v1_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInstanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rill_runtime_v1_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateInstanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rill_runtime_v1_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateInstanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rill_runtime_v1_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditInstanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rill_runtime_v1_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditInstanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rill_runtime_v1_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteInstanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rill_runtime_v1_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteInstanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rill_runtime_v1_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFilesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rill_runtime_v1_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFilesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rill_runtime_v1_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rill_runtime_v1_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rill_runtime_v1_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8537,8 +10692,8 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Instance); i {
+		file_rill_runtime_v1_api_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutFileResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8549,8 +10704,8 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListInstancesRequest); i {
+		file_rill_runtime_v1_api_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8561,8 +10716,8 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListInstancesResponse); i {
+		file_rill_runtime_v1_api_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteFileResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8573,8 +10728,8 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetInstanceRequest); i {
+		file_rill_runtime_v1_api_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8585,8 +10740,8 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetInstanceResponse); i {
+		file_rill_runtime_v1_api_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameFileResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8597,8 +10752,8 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateInstanceRequest); i {
+		file_rill_runtime_v1_api_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileRevision); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8609,8 +10764,8 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateInstanceResponse); i {
+		file_rill_runtime_v1_api_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFileRevisionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8621,8 +10776,8 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteInstanceRequest); i {
+		file_rill_runtime_v1_api_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFileRevisionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8633,8 +10788,8 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteInstanceResponse); i {
+		file_rill_runtime_v1_api_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevertFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8645,8 +10800,8 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListFilesRequest); i {
+		file_rill_runtime_v1_api_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevertFileResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8657,8 +10812,8 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListFilesResponse); i {
+		file_rill_runtime_v1_api_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CatalogEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8669,8 +10824,8 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFileRequest); i {
+		file_rill_runtime_v1_api_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCatalogEntriesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8681,8 +10836,8 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFileResponse); i {
+		file_rill_runtime_v1_api_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCatalogEntriesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8693,8 +10848,8 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PutFileRequest); i {
+		file_rill_runtime_v1_api_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCatalogEntryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8705,8 +10860,8 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PutFileResponse); i {
+		file_rill_runtime_v1_api_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCatalogEntryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8717,8 +10872,8 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteFileRequest); i {
+		file_rill_runtime_v1_api_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CatalogEntryVersion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8729,8 +10884,8 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteFileResponse); i {
+		file_rill_runtime_v1_api_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCatalogEntryVersionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8741,8 +10896,8 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RenameFileRequest); i {
+		file_rill_runtime_v1_api_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCatalogEntryVersionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8753,8 +10908,8 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RenameFileResponse); i {
+		file_rill_runtime_v1_api_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreCatalogEntryVersionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8765,8 +10920,8 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CatalogEntry); i {
+		file_rill_runtime_v1_api_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreCatalogEntryVersionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8777,8 +10932,8 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListCatalogEntriesRequest); i {
+		file_rill_runtime_v1_api_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TriggerRefreshRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8789,8 +10944,8 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListCatalogEntriesResponse); i {
+		file_rill_runtime_v1_api_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TriggerRefreshResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8801,8 +10956,8 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCatalogEntryRequest); i {
+		file_rill_runtime_v1_api_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TriggerSyncRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8813,8 +10968,8 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCatalogEntryResponse); i {
+		file_rill_runtime_v1_api_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TriggerSyncResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8825,8 +10980,8 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TriggerRefreshRequest); i {
+		file_rill_runtime_v1_api_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReconcileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8837,8 +10992,8 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TriggerRefreshResponse); i {
+		file_rill_runtime_v1_api_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReconcileResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8849,8 +11004,8 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TriggerSyncRequest); i {
+		file_rill_runtime_v1_api_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReconcileStreamResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8861,8 +11016,8 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TriggerSyncResponse); i {
+		file_rill_runtime_v1_api_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReconcileEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8873,8 +11028,8 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReconcileRequest); i {
+		file_rill_runtime_v1_api_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchReconcileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8885,8 +11040,8 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReconcileResponse); i {
+		file_rill_runtime_v1_api_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchReconcileResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8897,7 +11052,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReconcileError); i {
 			case 0:
 				return &v.state
@@ -8909,7 +11064,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PutFileAndReconcileRequest); i {
 			case 0:
 				return &v.state
@@ -8921,7 +11076,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PutFileAndReconcileResponse); i {
 			case 0:
 				return &v.state
@@ -8933,7 +11088,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteFileAndReconcileRequest); i {
 			case 0:
 				return &v.state
@@ -8945,7 +11100,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteFileAndReconcileResponse); i {
 			case 0:
 				return &v.state
@@ -8957,7 +11112,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RenameFileAndReconcileRequest); i {
 			case 0:
 				return &v.state
@@ -8969,7 +11124,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RenameFileAndReconcileResponse); i {
 			case 0:
 				return &v.state
@@ -8981,7 +11136,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RefreshAndReconcileRequest); i {
 			case 0:
 				return &v.state
@@ -8993,7 +11148,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RefreshAndReconcileResponse); i {
 			case 0:
 				return &v.state
@@ -9005,7 +11160,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryRequest); i {
 			case 0:
 				return &v.state
@@ -9017,7 +11172,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryResponse); i {
 			case 0:
 				return &v.state
@@ -9029,7 +11184,31 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryArrowRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rill_runtime_v1_api_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryArrowResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rill_runtime_v1_api_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MetricsViewToplistRequest); i {
 			case 0:
 				return &v.state
@@ -9041,7 +11220,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MetricsViewToplistResponse); i {
 			case 0:
 				return &v.state
@@ -9053,7 +11232,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MetricsViewTimeSeriesRequest); i {
 			case 0:
 				return &v.state
@@ -9065,7 +11244,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MetricsViewTimeSeriesResponse); i {
 			case 0:
 				return &v.state
@@ -9077,7 +11256,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MetricsViewTotalsRequest); i {
 			case 0:
 				return &v.state
@@ -9089,7 +11268,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MetricsViewTotalsResponse); i {
 			case 0:
 				return &v.state
@@ -9101,7 +11280,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MetricsViewSort); i {
 			case 0:
 				return &v.state
@@ -9113,7 +11292,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MetricsViewFilter); i {
 			case 0:
 				return &v.state
@@ -9125,7 +11304,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MetricsViewColumn); i {
 			case 0:
 				return &v.state
@@ -9137,7 +11316,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EstimateRollupIntervalRequest); i {
 			case 0:
 				return &v.state
@@ -9149,7 +11328,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EstimateRollupIntervalResponse); i {
 			case 0:
 				return &v.state
@@ -9161,7 +11340,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProfileColumnSummary); i {
 			case 0:
 				return &v.state
@@ -9173,7 +11352,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CategoricalSummary); i {
 			case 0:
 				return &v.state
@@ -9185,7 +11364,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetCardinalityOfColumnResponse); i {
 			case 0:
 				return &v.state
@@ -9197,7 +11376,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetTopKResponse); i {
 			case 0:
 				return &v.state
@@ -9209,7 +11388,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NumericSummary); i {
 			case 0:
 				return &v.state
@@ -9221,7 +11400,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetRugHistogramResponse); i {
 			case 0:
 				return &v.state
@@ -9233,7 +11412,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetNumericHistogramResponse); i {
 			case 0:
 				return &v.state
@@ -9245,7 +11424,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetDescriptiveStatisticsResponse); i {
 			case 0:
 				return &v.state
@@ -9257,7 +11436,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetTimeRangeSummaryResponse); i {
 			case 0:
 				return &v.state
@@ -9269,7 +11448,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TimeRangeSummary); i {
 			case 0:
 				return &v.state
@@ -9281,7 +11460,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NumericOutliers); i {
 			case 0:
 				return &v.state
@@ -9293,7 +11472,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetTopKRequest); i {
 			case 0:
 				return &v.state
@@ -9305,7 +11484,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TopK); i {
 			case 0:
 				return &v.state
@@ -9317,7 +11496,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetNullCountRequest); i {
 			case 0:
 				return &v.state
@@ -9329,7 +11508,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetNullCountResponse); i {
 			case 0:
 				return &v.state
@@ -9341,7 +11520,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetDescriptiveStatisticsRequest); i {
 			case 0:
 				return &v.state
@@ -9353,7 +11532,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NumericStatistics); i {
 			case 0:
 				return &v.state
@@ -9365,7 +11544,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EstimateSmallestTimeGrainRequest); i {
 			case 0:
 				return &v.state
@@ -9377,7 +11556,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EstimateSmallestTimeGrainResponse); i {
 			case 0:
 				return &v.state
@@ -9389,7 +11568,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetNumericHistogramRequest); i {
 			case 0:
 				return &v.state
@@ -9401,7 +11580,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NumericHistogramBins); i {
 			case 0:
 				return &v.state
@@ -9413,7 +11592,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetRugHistogramRequest); i {
 			case 0:
 				return &v.state
@@ -9425,7 +11604,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetTimeRangeSummaryRequest); i {
 			case 0:
 				return &v.state
@@ -9437,7 +11616,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetCardinalityOfColumnRequest); i {
 			case 0:
 				return &v.state
@@ -9449,7 +11628,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenerateTimeSeriesRequest); i {
 			case 0:
 				return &v.state
@@ -9461,7 +11640,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TimeSeriesTimeRange); i {
 			case 0:
 				return &v.state
@@ -9473,7 +11652,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TimeSeriesResponse); i {
 			case 0:
 				return &v.state
@@ -9485,7 +11664,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenerateTimeSeriesResponse); i {
 			case 0:
 				return &v.state
@@ -9497,7 +11676,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TimeSeriesValue); i {
 			case 0:
 				return &v.state
@@ -9509,7 +11688,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetTableCardinalityRequest); i {
 			case 0:
 				return &v.state
@@ -9521,7 +11700,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetTableCardinalityResponse); i {
 			case 0:
 				return &v.state
@@ -9533,7 +11712,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProfileColumnsRequest); i {
 			case 0:
 				return &v.state
@@ -9545,7 +11724,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProfileColumnsResponse); i {
 			case 0:
 				return &v.state
@@ -9557,7 +11736,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProfileColumn); i {
 			case 0:
 				return &v.state
@@ -9569,7 +11748,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetTableRowsRequest); i {
 			case 0:
 				return &v.state
@@ -9581,7 +11760,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetTableRowsResponse); i {
 			case 0:
 				return &v.state
@@ -9593,7 +11772,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Connector); i {
 			case 0:
 				return &v.state
@@ -9605,7 +11784,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListConnectorsRequest); i {
 			case 0:
 				return &v.state
@@ -9617,7 +11796,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListConnectorsResponse); i {
 			case 0:
 				return &v.state
@@ -9629,7 +11808,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReconcileError_CharLocation); i {
 			case 0:
 				return &v.state
@@ -9641,7 +11820,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MetricsViewFilter_Cond); i {
 			case 0:
 				return &v.state
@@ -9653,7 +11832,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TimeRangeSummary_Interval); i {
 			case 0:
 				return &v.state
@@ -9665,7 +11844,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NumericOutliers_Outlier); i {
 			case 0:
 				return &v.state
@@ -9677,7 +11856,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TopK_Entry); i {
 			case 0:
 				return &v.state
@@ -9689,7 +11868,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NumericHistogramBins_Bin); i {
 			case 0:
 				return &v.state
@@ -9701,7 +11880,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenerateTimeSeriesRequest_BasicMeasure); i {
 			case 0:
 				return &v.state
@@ -9713,7 +11892,7 @@ func file_rill_runtime_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_rill_runtime_v1_api_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+		file_rill_runtime_v1_api_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Connector_Property); i {
 			case 0:
 				return &v.state
@@ -9726,34 +11905,39 @@ func file_rill_runtime_v1_api_proto_init() {
 			}
 		}
 	}
-	file_rill_runtime_v1_api_proto_msgTypes[21].OneofWrappers = []interface{}{
+	file_rill_runtime_v1_api_proto_msgTypes[9].OneofWrappers = []interface{}{}
+	file_rill_runtime_v1_api_proto_msgTypes[28].OneofWrappers = []interface{}{
 		(*CatalogEntry_Table)(nil),
 		(*CatalogEntry_Source)(nil),
 		(*CatalogEntry_Model)(nil),
 		(*CatalogEntry_MetricsView)(nil),
 	}
-	file_rill_runtime_v1_api_proto_msgTypes[54].OneofWrappers = []interface{}{
+	file_rill_runtime_v1_api_proto_msgTypes[44].OneofWrappers = []interface{}{
+		(*ReconcileStreamResponse_Event)(nil),
+		(*ReconcileStreamResponse_Result)(nil),
+	}
+	file_rill_runtime_v1_api_proto_msgTypes[72].OneofWrappers = []interface{}{
 		(*ProfileColumnSummary_CategoricalSummary)(nil),
 		(*ProfileColumnSummary_NumericSummary)(nil),
 		(*ProfileColumnSummary_TimeRangeSummary)(nil),
 	}
-	file_rill_runtime_v1_api_proto_msgTypes[55].OneofWrappers = []interface{}{
+	file_rill_runtime_v1_api_proto_msgTypes[73].OneofWrappers = []interface{}{
 		(*CategoricalSummary_TopK)(nil),
 		(*CategoricalSummary_Cardinality)(nil),
 	}
-	file_rill_runtime_v1_api_proto_msgTypes[58].OneofWrappers = []interface{}{
+	file_rill_runtime_v1_api_proto_msgTypes[76].OneofWrappers = []interface{}{
 		(*NumericSummary_NumericHistogramBins)(nil),
 		(*NumericSummary_NumericStatistics)(nil),
 		(*NumericSummary_NumericOutliers)(nil),
 	}
-	file_rill_runtime_v1_api_proto_msgTypes[82].OneofWrappers = []interface{}{}
+	file_rill_runtime_v1_api_proto_msgTypes[100].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_rill_runtime_v1_api_proto_rawDesc,
-			NumEnums:      5,
-			NumMessages:   102,
+			NumEnums:      7,
+			NumMessages:   123,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
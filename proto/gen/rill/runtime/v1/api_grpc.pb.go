@@ -30,12 +30,16 @@ type RuntimeServiceClient interface {
 	GetInstance(ctx context.Context, in *GetInstanceRequest, opts ...grpc.CallOption) (*GetInstanceResponse, error)
 	// CreateInstance creates a new instance
 	CreateInstance(ctx context.Context, in *CreateInstanceRequest, opts ...grpc.CallOption) (*CreateInstanceResponse, error)
+	// EditInstance updates an instance's drivers and settings. Only the fields that are set are changed.
+	// If embed_catalog is toggled, the instance's catalog is moved to the new catalog store.
+	EditInstance(ctx context.Context, in *EditInstanceRequest, opts ...grpc.CallOption) (*EditInstanceResponse, error)
 	// DeleteInstance deletes an instance
 	DeleteInstance(ctx context.Context, in *DeleteInstanceRequest, opts ...grpc.CallOption) (*DeleteInstanceResponse, error)
 	// ListFiles lists all the files matching a glob in a repo.
-	// The files are sorted by their full path.
+	// The files are sorted by their full path. Files matched by the repo's ignore rules (.rillignore) are skipped.
 	ListFiles(ctx context.Context, in *ListFilesRequest, opts ...grpc.CallOption) (*ListFilesResponse, error)
 	// GetFile returns the contents of a specific file in a repo.
+	// If a revision is passed, it returns the contents as of that revision.
 	GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (*GetFileResponse, error)
 	// PutFile creates or updates a file in a repo
 	PutFile(ctx context.Context, in *PutFileRequest, opts ...grpc.CallOption) (*PutFileResponse, error)
@@ -43,10 +47,23 @@ type RuntimeServiceClient interface {
 	DeleteFile(ctx context.Context, in *DeleteFileRequest, opts ...grpc.CallOption) (*DeleteFileResponse, error)
 	// RenameFile renames a file in a repo
 	RenameFile(ctx context.Context, in *RenameFileRequest, opts ...grpc.CallOption) (*RenameFileResponse, error)
+	// ListFileRevisions lists the revisions of a file in a repo, ordered from newest to oldest.
+	// It's only supported for repo drivers that track history (currently only git).
+	ListFileRevisions(ctx context.Context, in *ListFileRevisionsRequest, opts ...grpc.CallOption) (*ListFileRevisionsResponse, error)
+	// RevertFile restores a file to its contents as of a revision (see ListFileRevisions).
+	// The revert is recorded as a new revision. If the file didn't exist as of the revision, it's deleted.
+	RevertFile(ctx context.Context, in *RevertFileRequest, opts ...grpc.CallOption) (*RevertFileResponse, error)
 	// ListCatalogEntries lists all the entries registered in an instance's catalog (like tables, sources or metrics views)
 	ListCatalogEntries(ctx context.Context, in *ListCatalogEntriesRequest, opts ...grpc.CallOption) (*ListCatalogEntriesResponse, error)
 	// GetCatalogEntry returns information about a specific entry in the catalog
 	GetCatalogEntry(ctx context.Context, in *GetCatalogEntryRequest, opts ...grpc.CallOption) (*GetCatalogEntryResponse, error)
+	// ListCatalogEntryVersions lists the versions recorded for a catalog entry, ordered from newest to oldest.
+	// A version is recorded every time the entry is created, updated, renamed or deleted.
+	ListCatalogEntryVersions(ctx context.Context, in *ListCatalogEntryVersionsRequest, opts ...grpc.CallOption) (*ListCatalogEntryVersionsResponse, error)
+	// RestoreCatalogEntryVersion writes the artifact of a version of a catalog entry back to the repo and reconciles it.
+	// If the artifact's contents changed since the version was recorded, it's written back as it was at the time
+	// (including its variable references).
+	RestoreCatalogEntryVersion(ctx context.Context, in *RestoreCatalogEntryVersionRequest, opts ...grpc.CallOption) (*RestoreCatalogEntryVersionResponse, error)
 	// TriggerRefresh triggers a refresh of a refreshable catalog object.
 	// It currently only supports sources (which will be re-ingested), but will also support materialized models in the future.
 	// It does not respond until the refresh has completed (will move to async jobs when the task scheduler is in place).
@@ -59,6 +76,12 @@ type RuntimeServiceClient interface {
 	// the desired state expressed in the artifacts. Any existing objects not described in the submitted
 	// artifacts will be deleted.
 	Reconcile(ctx context.Context, in *ReconcileRequest, opts ...grpc.CallOption) (*ReconcileResponse, error)
+	// ReconcileStream is like Reconcile, but streams progress events for every migration item as it's reconciled.
+	// The last message contains the result of the reconcile.
+	ReconcileStream(ctx context.Context, in *ReconcileRequest, opts ...grpc.CallOption) (RuntimeService_ReconcileStreamClient, error)
+	// WatchReconcile streams the result of every reconcile that runs for an instance, including
+	// reconciles triggered by changes to files in the instance's repo.
+	WatchReconcile(ctx context.Context, in *WatchReconcileRequest, opts ...grpc.CallOption) (RuntimeService_WatchReconcileClient, error)
 	// PutFileAndReconcile combines PutFile and Reconcile in a single endpoint to reduce latency.
 	// It is equivalent to calling the two RPCs sequentially.
 	PutFileAndReconcile(ctx context.Context, in *PutFileAndReconcileRequest, opts ...grpc.CallOption) (*PutFileAndReconcileResponse, error)
@@ -69,6 +92,9 @@ type RuntimeServiceClient interface {
 	RefreshAndReconcile(ctx context.Context, in *RefreshAndReconcileRequest, opts ...grpc.CallOption) (*RefreshAndReconcileResponse, error)
 	// Query runs a SQL query against the instance's OLAP datastore.
 	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error)
+	// QueryArrow runs a SQL query against the instance's OLAP datastore and streams the result in Arrow IPC format.
+	// Concatenating the arrow_ipc of every response gives an Arrow IPC stream (a schema followed by record batches).
+	QueryArrow(ctx context.Context, in *QueryArrowRequest, opts ...grpc.CallOption) (RuntimeService_QueryArrowClient, error)
 	// MetricsViewToplist returns the top dimension values of a metrics view sorted by one or more measures.
 	// It's a convenience API for querying a metrics view.
 	MetricsViewToplist(ctx context.Context, in *MetricsViewToplistRequest, opts ...grpc.CallOption) (*MetricsViewToplistResponse, error)
@@ -154,6 +180,15 @@ func (c *runtimeServiceClient) CreateInstance(ctx context.Context, in *CreateIns
 	return out, nil
 }
 
+func (c *runtimeServiceClient) EditInstance(ctx context.Context, in *EditInstanceRequest, opts ...grpc.CallOption) (*EditInstanceResponse, error) {
+	out := new(EditInstanceResponse)
+	err := c.cc.Invoke(ctx, "/rill.runtime.v1.RuntimeService/EditInstance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runtimeServiceClient) DeleteInstance(ctx context.Context, in *DeleteInstanceRequest, opts ...grpc.CallOption) (*DeleteInstanceResponse, error) {
 	out := new(DeleteInstanceResponse)
 	err := c.cc.Invoke(ctx, "/rill.runtime.v1.RuntimeService/DeleteInstance", in, out, opts...)
@@ -208,6 +243,24 @@ func (c *runtimeServiceClient) RenameFile(ctx context.Context, in *RenameFileReq
 	return out, nil
 }
 
+func (c *runtimeServiceClient) ListFileRevisions(ctx context.Context, in *ListFileRevisionsRequest, opts ...grpc.CallOption) (*ListFileRevisionsResponse, error) {
+	out := new(ListFileRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/rill.runtime.v1.RuntimeService/ListFileRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) RevertFile(ctx context.Context, in *RevertFileRequest, opts ...grpc.CallOption) (*RevertFileResponse, error) {
+	out := new(RevertFileResponse)
+	err := c.cc.Invoke(ctx, "/rill.runtime.v1.RuntimeService/RevertFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runtimeServiceClient) ListCatalogEntries(ctx context.Context, in *ListCatalogEntriesRequest, opts ...grpc.CallOption) (*ListCatalogEntriesResponse, error) {
 	out := new(ListCatalogEntriesResponse)
 	err := c.cc.Invoke(ctx, "/rill.runtime.v1.RuntimeService/ListCatalogEntries", in, out, opts...)
@@ -226,6 +279,24 @@ func (c *runtimeServiceClient) GetCatalogEntry(ctx context.Context, in *GetCatal
 	return out, nil
 }
 
+func (c *runtimeServiceClient) ListCatalogEntryVersions(ctx context.Context, in *ListCatalogEntryVersionsRequest, opts ...grpc.CallOption) (*ListCatalogEntryVersionsResponse, error) {
+	out := new(ListCatalogEntryVersionsResponse)
+	err := c.cc.Invoke(ctx, "/rill.runtime.v1.RuntimeService/ListCatalogEntryVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) RestoreCatalogEntryVersion(ctx context.Context, in *RestoreCatalogEntryVersionRequest, opts ...grpc.CallOption) (*RestoreCatalogEntryVersionResponse, error) {
+	out := new(RestoreCatalogEntryVersionResponse)
+	err := c.cc.Invoke(ctx, "/rill.runtime.v1.RuntimeService/RestoreCatalogEntryVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runtimeServiceClient) TriggerRefresh(ctx context.Context, in *TriggerRefreshRequest, opts ...grpc.CallOption) (*TriggerRefreshResponse, error) {
 	out := new(TriggerRefreshResponse)
 	err := c.cc.Invoke(ctx, "/rill.runtime.v1.RuntimeService/TriggerRefresh", in, out, opts...)
@@ -253,6 +324,70 @@ func (c *runtimeServiceClient) Reconcile(ctx context.Context, in *ReconcileReque
 	return out, nil
 }
 
+func (c *runtimeServiceClient) ReconcileStream(ctx context.Context, in *ReconcileRequest, opts ...grpc.CallOption) (RuntimeService_ReconcileStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RuntimeService_ServiceDesc.Streams[0], "/rill.runtime.v1.RuntimeService/ReconcileStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &runtimeServiceReconcileStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RuntimeService_ReconcileStreamClient interface {
+	Recv() (*ReconcileStreamResponse, error)
+	grpc.ClientStream
+}
+
+type runtimeServiceReconcileStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *runtimeServiceReconcileStreamClient) Recv() (*ReconcileStreamResponse, error) {
+	m := new(ReconcileStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *runtimeServiceClient) WatchReconcile(ctx context.Context, in *WatchReconcileRequest, opts ...grpc.CallOption) (RuntimeService_WatchReconcileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RuntimeService_ServiceDesc.Streams[1], "/rill.runtime.v1.RuntimeService/WatchReconcile", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &runtimeServiceWatchReconcileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RuntimeService_WatchReconcileClient interface {
+	Recv() (*WatchReconcileResponse, error)
+	grpc.ClientStream
+}
+
+type runtimeServiceWatchReconcileClient struct {
+	grpc.ClientStream
+}
+
+func (x *runtimeServiceWatchReconcileClient) Recv() (*WatchReconcileResponse, error) {
+	m := new(WatchReconcileResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *runtimeServiceClient) PutFileAndReconcile(ctx context.Context, in *PutFileAndReconcileRequest, opts ...grpc.CallOption) (*PutFileAndReconcileResponse, error) {
 	out := new(PutFileAndReconcileResponse)
 	err := c.cc.Invoke(ctx, "/rill.runtime.v1.RuntimeService/PutFileAndReconcile", in, out, opts...)
@@ -298,6 +433,38 @@ func (c *runtimeServiceClient) Query(ctx context.Context, in *QueryRequest, opts
 	return out, nil
 }
 
+func (c *runtimeServiceClient) QueryArrow(ctx context.Context, in *QueryArrowRequest, opts ...grpc.CallOption) (RuntimeService_QueryArrowClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RuntimeService_ServiceDesc.Streams[2], "/rill.runtime.v1.RuntimeService/QueryArrow", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &runtimeServiceQueryArrowClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RuntimeService_QueryArrowClient interface {
+	Recv() (*QueryArrowResponse, error)
+	grpc.ClientStream
+}
+
+type runtimeServiceQueryArrowClient struct {
+	grpc.ClientStream
+}
+
+func (x *runtimeServiceQueryArrowClient) Recv() (*QueryArrowResponse, error) {
+	m := new(QueryArrowResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *runtimeServiceClient) MetricsViewToplist(ctx context.Context, in *MetricsViewToplistRequest, opts ...grpc.CallOption) (*MetricsViewToplistResponse, error) {
 	out := new(MetricsViewToplistResponse)
 	err := c.cc.Invoke(ctx, "/rill.runtime.v1.RuntimeService/MetricsViewToplist", in, out, opts...)
@@ -463,12 +630,16 @@ type RuntimeServiceServer interface {
 	GetInstance(context.Context, *GetInstanceRequest) (*GetInstanceResponse, error)
 	// CreateInstance creates a new instance
 	CreateInstance(context.Context, *CreateInstanceRequest) (*CreateInstanceResponse, error)
+	// EditInstance updates an instance's drivers and settings. Only the fields that are set are changed.
+	// If embed_catalog is toggled, the instance's catalog is moved to the new catalog store.
+	EditInstance(context.Context, *EditInstanceRequest) (*EditInstanceResponse, error)
 	// DeleteInstance deletes an instance
 	DeleteInstance(context.Context, *DeleteInstanceRequest) (*DeleteInstanceResponse, error)
 	// ListFiles lists all the files matching a glob in a repo.
-	// The files are sorted by their full path.
+	// The files are sorted by their full path. Files matched by the repo's ignore rules (.rillignore) are skipped.
 	ListFiles(context.Context, *ListFilesRequest) (*ListFilesResponse, error)
 	// GetFile returns the contents of a specific file in a repo.
+	// If a revision is passed, it returns the contents as of that revision.
 	GetFile(context.Context, *GetFileRequest) (*GetFileResponse, error)
 	// PutFile creates or updates a file in a repo
 	PutFile(context.Context, *PutFileRequest) (*PutFileResponse, error)
@@ -476,10 +647,23 @@ type RuntimeServiceServer interface {
 	DeleteFile(context.Context, *DeleteFileRequest) (*DeleteFileResponse, error)
 	// RenameFile renames a file in a repo
 	RenameFile(context.Context, *RenameFileRequest) (*RenameFileResponse, error)
+	// ListFileRevisions lists the revisions of a file in a repo, ordered from newest to oldest.
+	// It's only supported for repo drivers that track history (currently only git).
+	ListFileRevisions(context.Context, *ListFileRevisionsRequest) (*ListFileRevisionsResponse, error)
+	// RevertFile restores a file to its contents as of a revision (see ListFileRevisions).
+	// The revert is recorded as a new revision. If the file didn't exist as of the revision, it's deleted.
+	RevertFile(context.Context, *RevertFileRequest) (*RevertFileResponse, error)
 	// ListCatalogEntries lists all the entries registered in an instance's catalog (like tables, sources or metrics views)
 	ListCatalogEntries(context.Context, *ListCatalogEntriesRequest) (*ListCatalogEntriesResponse, error)
 	// GetCatalogEntry returns information about a specific entry in the catalog
 	GetCatalogEntry(context.Context, *GetCatalogEntryRequest) (*GetCatalogEntryResponse, error)
+	// ListCatalogEntryVersions lists the versions recorded for a catalog entry, ordered from newest to oldest.
+	// A version is recorded every time the entry is created, updated, renamed or deleted.
+	ListCatalogEntryVersions(context.Context, *ListCatalogEntryVersionsRequest) (*ListCatalogEntryVersionsResponse, error)
+	// RestoreCatalogEntryVersion writes the artifact of a version of a catalog entry back to the repo and reconciles it.
+	// If the artifact's contents changed since the version was recorded, it's written back as it was at the time
+	// (including its variable references).
+	RestoreCatalogEntryVersion(context.Context, *RestoreCatalogEntryVersionRequest) (*RestoreCatalogEntryVersionResponse, error)
 	// TriggerRefresh triggers a refresh of a refreshable catalog object.
 	// It currently only supports sources (which will be re-ingested), but will also support materialized models in the future.
 	// It does not respond until the refresh has completed (will move to async jobs when the task scheduler is in place).
@@ -492,6 +676,12 @@ type RuntimeServiceServer interface {
 	// the desired state expressed in the artifacts. Any existing objects not described in the submitted
 	// artifacts will be deleted.
 	Reconcile(context.Context, *ReconcileRequest) (*ReconcileResponse, error)
+	// ReconcileStream is like Reconcile, but streams progress events for every migration item as it's reconciled.
+	// The last message contains the result of the reconcile.
+	ReconcileStream(*ReconcileRequest, RuntimeService_ReconcileStreamServer) error
+	// WatchReconcile streams the result of every reconcile that runs for an instance, including
+	// reconciles triggered by changes to files in the instance's repo.
+	WatchReconcile(*WatchReconcileRequest, RuntimeService_WatchReconcileServer) error
 	// PutFileAndReconcile combines PutFile and Reconcile in a single endpoint to reduce latency.
 	// It is equivalent to calling the two RPCs sequentially.
 	PutFileAndReconcile(context.Context, *PutFileAndReconcileRequest) (*PutFileAndReconcileResponse, error)
@@ -502,6 +692,9 @@ type RuntimeServiceServer interface {
 	RefreshAndReconcile(context.Context, *RefreshAndReconcileRequest) (*RefreshAndReconcileResponse, error)
 	// Query runs a SQL query against the instance's OLAP datastore.
 	Query(context.Context, *QueryRequest) (*QueryResponse, error)
+	// QueryArrow runs a SQL query against the instance's OLAP datastore and streams the result in Arrow IPC format.
+	// Concatenating the arrow_ipc of every response gives an Arrow IPC stream (a schema followed by record batches).
+	QueryArrow(*QueryArrowRequest, RuntimeService_QueryArrowServer) error
 	// MetricsViewToplist returns the top dimension values of a metrics view sorted by one or more measures.
 	// It's a convenience API for querying a metrics view.
 	MetricsViewToplist(context.Context, *MetricsViewToplistRequest) (*MetricsViewToplistResponse, error)
@@ -560,6 +753,9 @@ func (UnimplementedRuntimeServiceServer) GetInstance(context.Context, *GetInstan
 func (UnimplementedRuntimeServiceServer) CreateInstance(context.Context, *CreateInstanceRequest) (*CreateInstanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateInstance not implemented")
 }
+func (UnimplementedRuntimeServiceServer) EditInstance(context.Context, *EditInstanceRequest) (*EditInstanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditInstance not implemented")
+}
 func (UnimplementedRuntimeServiceServer) DeleteInstance(context.Context, *DeleteInstanceRequest) (*DeleteInstanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteInstance not implemented")
 }
@@ -578,12 +774,24 @@ func (UnimplementedRuntimeServiceServer) DeleteFile(context.Context, *DeleteFile
 func (UnimplementedRuntimeServiceServer) RenameFile(context.Context, *RenameFileRequest) (*RenameFileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RenameFile not implemented")
 }
+func (UnimplementedRuntimeServiceServer) ListFileRevisions(context.Context, *ListFileRevisionsRequest) (*ListFileRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFileRevisions not implemented")
+}
+func (UnimplementedRuntimeServiceServer) RevertFile(context.Context, *RevertFileRequest) (*RevertFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertFile not implemented")
+}
 func (UnimplementedRuntimeServiceServer) ListCatalogEntries(context.Context, *ListCatalogEntriesRequest) (*ListCatalogEntriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListCatalogEntries not implemented")
 }
 func (UnimplementedRuntimeServiceServer) GetCatalogEntry(context.Context, *GetCatalogEntryRequest) (*GetCatalogEntryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCatalogEntry not implemented")
 }
+func (UnimplementedRuntimeServiceServer) ListCatalogEntryVersions(context.Context, *ListCatalogEntryVersionsRequest) (*ListCatalogEntryVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCatalogEntryVersions not implemented")
+}
+func (UnimplementedRuntimeServiceServer) RestoreCatalogEntryVersion(context.Context, *RestoreCatalogEntryVersionRequest) (*RestoreCatalogEntryVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreCatalogEntryVersion not implemented")
+}
 func (UnimplementedRuntimeServiceServer) TriggerRefresh(context.Context, *TriggerRefreshRequest) (*TriggerRefreshResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TriggerRefresh not implemented")
 }
@@ -593,6 +801,12 @@ func (UnimplementedRuntimeServiceServer) TriggerSync(context.Context, *TriggerSy
 func (UnimplementedRuntimeServiceServer) Reconcile(context.Context, *ReconcileRequest) (*ReconcileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Reconcile not implemented")
 }
+func (UnimplementedRuntimeServiceServer) ReconcileStream(*ReconcileRequest, RuntimeService_ReconcileStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReconcileStream not implemented")
+}
+func (UnimplementedRuntimeServiceServer) WatchReconcile(*WatchReconcileRequest, RuntimeService_WatchReconcileServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchReconcile not implemented")
+}
 func (UnimplementedRuntimeServiceServer) PutFileAndReconcile(context.Context, *PutFileAndReconcileRequest) (*PutFileAndReconcileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PutFileAndReconcile not implemented")
 }
@@ -608,6 +822,9 @@ func (UnimplementedRuntimeServiceServer) RefreshAndReconcile(context.Context, *R
 func (UnimplementedRuntimeServiceServer) Query(context.Context, *QueryRequest) (*QueryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
 }
+func (UnimplementedRuntimeServiceServer) QueryArrow(*QueryArrowRequest, RuntimeService_QueryArrowServer) error {
+	return status.Errorf(codes.Unimplemented, "method QueryArrow not implemented")
+}
 func (UnimplementedRuntimeServiceServer) MetricsViewToplist(context.Context, *MetricsViewToplistRequest) (*MetricsViewToplistResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MetricsViewToplist not implemented")
 }
@@ -744,6 +961,24 @@ func _RuntimeService_CreateInstance_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuntimeService_EditInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditInstanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).EditInstance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rill.runtime.v1.RuntimeService/EditInstance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).EditInstance(ctx, req.(*EditInstanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RuntimeService_DeleteInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteInstanceRequest)
 	if err := dec(in); err != nil {
@@ -852,6 +1087,42 @@ func _RuntimeService_RenameFile_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuntimeService_ListFileRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFileRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).ListFileRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rill.runtime.v1.RuntimeService/ListFileRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).ListFileRevisions(ctx, req.(*ListFileRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_RevertFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).RevertFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rill.runtime.v1.RuntimeService/RevertFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).RevertFile(ctx, req.(*RevertFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RuntimeService_ListCatalogEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListCatalogEntriesRequest)
 	if err := dec(in); err != nil {
@@ -888,6 +1159,42 @@ func _RuntimeService_GetCatalogEntry_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuntimeService_ListCatalogEntryVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCatalogEntryVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).ListCatalogEntryVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rill.runtime.v1.RuntimeService/ListCatalogEntryVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).ListCatalogEntryVersions(ctx, req.(*ListCatalogEntryVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_RestoreCatalogEntryVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCatalogEntryVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).RestoreCatalogEntryVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rill.runtime.v1.RuntimeService/RestoreCatalogEntryVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).RestoreCatalogEntryVersion(ctx, req.(*RestoreCatalogEntryVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RuntimeService_TriggerRefresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TriggerRefreshRequest)
 	if err := dec(in); err != nil {
@@ -942,6 +1249,48 @@ func _RuntimeService_Reconcile_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuntimeService_ReconcileStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReconcileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RuntimeServiceServer).ReconcileStream(m, &runtimeServiceReconcileStreamServer{stream})
+}
+
+type RuntimeService_ReconcileStreamServer interface {
+	Send(*ReconcileStreamResponse) error
+	grpc.ServerStream
+}
+
+type runtimeServiceReconcileStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *runtimeServiceReconcileStreamServer) Send(m *ReconcileStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RuntimeService_WatchReconcile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchReconcileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RuntimeServiceServer).WatchReconcile(m, &runtimeServiceWatchReconcileServer{stream})
+}
+
+type RuntimeService_WatchReconcileServer interface {
+	Send(*WatchReconcileResponse) error
+	grpc.ServerStream
+}
+
+type runtimeServiceWatchReconcileServer struct {
+	grpc.ServerStream
+}
+
+func (x *runtimeServiceWatchReconcileServer) Send(m *WatchReconcileResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _RuntimeService_PutFileAndReconcile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PutFileAndReconcileRequest)
 	if err := dec(in); err != nil {
@@ -1032,6 +1381,27 @@ func _RuntimeService_Query_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuntimeService_QueryArrow_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueryArrowRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RuntimeServiceServer).QueryArrow(m, &runtimeServiceQueryArrowServer{stream})
+}
+
+type RuntimeService_QueryArrowServer interface {
+	Send(*QueryArrowResponse) error
+	grpc.ServerStream
+}
+
+type runtimeServiceQueryArrowServer struct {
+	grpc.ServerStream
+}
+
+func (x *runtimeServiceQueryArrowServer) Send(m *QueryArrowResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _RuntimeService_MetricsViewToplist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MetricsViewToplistRequest)
 	if err := dec(in); err != nil {
@@ -1361,6 +1731,10 @@ var RuntimeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateInstance",
 			Handler:    _RuntimeService_CreateInstance_Handler,
 		},
+		{
+			MethodName: "EditInstance",
+			Handler:    _RuntimeService_EditInstance_Handler,
+		},
 		{
 			MethodName: "DeleteInstance",
 			Handler:    _RuntimeService_DeleteInstance_Handler,
@@ -1385,6 +1759,14 @@ var RuntimeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RenameFile",
 			Handler:    _RuntimeService_RenameFile_Handler,
 		},
+		{
+			MethodName: "ListFileRevisions",
+			Handler:    _RuntimeService_ListFileRevisions_Handler,
+		},
+		{
+			MethodName: "RevertFile",
+			Handler:    _RuntimeService_RevertFile_Handler,
+		},
 		{
 			MethodName: "ListCatalogEntries",
 			Handler:    _RuntimeService_ListCatalogEntries_Handler,
@@ -1393,6 +1775,14 @@ var RuntimeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCatalogEntry",
 			Handler:    _RuntimeService_GetCatalogEntry_Handler,
 		},
+		{
+			MethodName: "ListCatalogEntryVersions",
+			Handler:    _RuntimeService_ListCatalogEntryVersions_Handler,
+		},
+		{
+			MethodName: "RestoreCatalogEntryVersion",
+			Handler:    _RuntimeService_RestoreCatalogEntryVersion_Handler,
+		},
 		{
 			MethodName: "TriggerRefresh",
 			Handler:    _RuntimeService_TriggerRefresh_Handler,
@@ -1494,6 +1884,22 @@ var RuntimeService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _RuntimeService_ListConnectors_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ReconcileStream",
+			Handler:       _RuntimeService_ReconcileStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchReconcile",
+			Handler:       _RuntimeService_WatchReconcile_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "QueryArrow",
+			Handler:       _RuntimeService_QueryArrow_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "rill/runtime/v1/api.proto",
 }
@@ -5,9 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/hashicorp/golang-lru/simplelru"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/services/catalog"
 	"go.uber.org/zap"
@@ -15,19 +18,82 @@ import (
 
 var errConnectionCacheClosed = errors.New("connectionCache: closed")
 
+var (
+	connectionsOpen = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "rill",
+		Subsystem: "runtime",
+		Name:      "connections_open",
+		Help:      "Number of open driver connections in the connection cache.",
+	}, []string{"driver"})
+	connectionsInUse = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "rill",
+		Subsystem: "runtime",
+		Name:      "connections_in_use",
+		Help:      "Number of driver connections in the connection cache that are currently referenced.",
+	}, []string{"driver"})
+	connectionsClosed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "rill",
+		Subsystem: "runtime",
+		Name:      "connections_closed_total",
+		Help:      "Number of driver connections closed by the connection cache, by reason.",
+	}, []string{"driver", "reason"})
+)
+
+// connectionCache is a cache of open driver connections, keyed by instance, driver and DSN.
+//
+// Connections are reference counted. get returns a release func, which the caller must call when it's done using the connection.
+// A connection that is evicted (by the LRU policy, by the idle TTL, or explicitly) is only closed when its last reference is released,
+// so it's never closed under a running query.
+//
+// Connections are opened at most once per key at a time; concurrent callers for the same key wait for the first one to open it,
+// while calls for other keys proceed in parallel. A connection that the LRU policy evicts while it's in use keeps being
+// returned for its key until it's released, so a key never has more than one open connection.
 type connectionCache struct {
-	cache  *simplelru.LRU
-	lock   sync.Mutex
-	closed bool
-	logger *zap.Logger
+	lru     *simplelru.LRU
+	idleTTL time.Duration
+	lock    sync.Mutex
+	closed  bool
+	stop    chan struct{}
+	logger  *zap.Logger
+	// inUse contains the entries evicted by the LRU policy that are still in use
+	inUse map[string]*connectionCacheEntry
 }
 
-func newConnectionCache(size int, logger *zap.Logger) *connectionCache {
-	cache, err := simplelru.NewLRU(size, nil)
+type connectionCacheEntry struct {
+	key        string
+	instanceID string
+	driver     string
+	// ready is closed when the connection has been opened (or failed to open)
+	ready    chan struct{}
+	conn     drivers.Connection
+	err      error
+	refs     int
+	lastUsed time.Time
+	evicted  bool
+	// closed is closed when the connection has been closed
+	closed chan struct{}
+	// evictReason is the reason reported in metrics when the connection is closed after eviction
+	evictReason string
+}
+
+func newConnectionCache(size int, idleTTL time.Duration, logger *zap.Logger) *connectionCache {
+	c := &connectionCache{
+		idleTTL: idleTTL,
+		stop:    make(chan struct{}),
+		logger:  logger,
+		inUse:   make(map[string]*connectionCacheEntry),
+	}
+	cache, err := simplelru.NewLRU(size, c.onEvict)
 	if err != nil {
 		panic(err)
 	}
-	return &connectionCache{cache: cache, logger: logger}
+	c.lru = cache
+
+	if idleTTL > 0 {
+		go c.evictIdle()
+	}
+
+	return c
 }
 
 func (c *connectionCache) Close() error {
@@ -37,122 +103,377 @@ func (c *connectionCache) Close() error {
 		return errConnectionCacheClosed
 	}
 	c.closed = true
+	close(c.stop)
+
+	// Close all connections, including those in use
+	var entries []*connectionCacheEntry
+	for _, key := range c.lru.Keys() {
+		val, _ := c.lru.Peek(key)
+		entry := val.(*connectionCacheEntry)
+		entry.evicted = true
+		entries = append(entries, entry)
+	}
+	c.lru.Purge()
+	for key, entry := range c.inUse {
+		entries = append(entries, entry)
+		delete(c.inUse, key)
+	}
 	c.lock.Unlock()
 
 	var firstErr error
-	for _, key := range c.cache.Keys() {
-		val, _ := c.cache.Get(key)
-		err := val.(drivers.Connection).Close()
-		if err != nil {
-			c.logger.Error("failed closing cached connection", zap.Error(err))
-			if firstErr == nil {
-				firstErr = err
-			}
+	for _, entry := range entries {
+		<-entry.ready
+		if entry.conn == nil {
+			continue
+		}
+		err := c.closeEntry(entry, "shutdown")
+		if err != nil && firstErr == nil {
+			firstErr = err
 		}
 	}
 
 	return firstErr
 }
 
-func (c *connectionCache) get(ctx context.Context, instanceID, driver, dsn string) (drivers.Connection, error) {
-	// TODO: This locks for all instances for the duration of Open and Migrate.
-	// Adapt to lock only on the lookup, and then on the individual instance's Open and Migrate.
-
+// get returns a connection for the given instance, driver and DSN, opening and migrating it if it isn't already cached.
+// The returned release func must be called when the caller is done with the connection.
+func (c *connectionCache) get(ctx context.Context, instanceID, driver, dsn string) (drivers.Connection, func(), error) {
 	c.lock.Lock()
-	defer c.lock.Unlock()
-
 	if c.closed {
-		return nil, errConnectionCacheClosed
+		c.lock.Unlock()
+		return nil, nil, errConnectionCacheClosed
 	}
 
 	key := instanceID + driver + dsn
-	val, ok := c.cache.Get(key)
-	if !ok {
-		conn, err := drivers.Open(driver, dsn, c.logger)
-		if err != nil {
-			return nil, err
+	var entry *connectionCacheEntry
+	val, ok := c.lru.Get(key)
+	if ok {
+		entry = val.(*connectionCacheEntry)
+	} else {
+		entry = c.inUse[key]
+	}
+	if entry != nil {
+		c.acquire(entry)
+		c.lock.Unlock()
+	} else {
+		entry = &connectionCacheEntry{
+			key:        key,
+			instanceID: instanceID,
+			driver:     driver,
+			ready:      make(chan struct{}),
+			closed:     make(chan struct{}),
 		}
+		c.acquire(entry)
+		c.lru.Add(key, entry)
+		c.lock.Unlock()
 
-		err = conn.Migrate(ctx)
+		// Open outside the lock, so other keys are not blocked
+		conn, err := c.open(ctx, driver, dsn)
+		c.lock.Lock()
+		entry.conn, entry.err = conn, err
 		if err != nil {
-			return nil, err
+			c.removeEntry(entry)
+		} else {
+			connectionsOpen.WithLabelValues(driver).Inc()
+		}
+		c.lock.Unlock()
+		close(entry.ready)
+	}
+
+	// Wait for the connection to be opened (possibly by another caller)
+	select {
+	case <-entry.ready:
+	case <-ctx.Done():
+		c.release(entry)
+		return nil, nil, ctx.Err()
+	}
+	if entry.err != nil {
+		c.release(entry)
+		return nil, nil, entry.err
+	}
+
+	var once sync.Once
+	release := func() {
+		once.Do(func() { c.release(entry) })
+	}
+	return entry.conn, release, nil
+}
+
+// evict removes all connections for an instance from the cache.
+// Connections that are in use are closed when they're released.
+// It returns the evicted entries, which can be passed to waitClosed.
+func (c *connectionCache) evict(instanceID string) []*connectionCacheEntry {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	var entries []*connectionCacheEntry
+	for _, key := range c.lru.Keys() {
+		val, ok := c.lru.Peek(key)
+		if ok && val.(*connectionCacheEntry).instanceID == instanceID {
+			entry := val.(*connectionCacheEntry)
+			entry.evictReason = "evicted"
+			c.lru.Remove(key)
+			entries = append(entries, entry)
+		}
+	}
+	for key, entry := range c.inUse {
+		if entry.instanceID == instanceID {
+			entry.evictReason = "evicted"
+			delete(c.inUse, key)
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// evictConnection removes a single connection for an instance from the cache.
+// If it's in use, it's closed when it's released.
+func (c *connectionCache) evictConnection(instanceID, driver, dsn string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	key := instanceID + driver + dsn
+	val, ok := c.lru.Peek(key)
+	if ok {
+		val.(*connectionCacheEntry).evictReason = "evicted"
+		c.lru.Remove(key)
+	}
+	if entry, ok := c.inUse[key]; ok {
+		entry.evictReason = "evicted"
+		delete(c.inUse, key)
+	}
+}
+
+// waitClosed blocks until the connections of the given (evicted) entries have been closed or ctx is cancelled.
+func (c *connectionCache) waitClosed(ctx context.Context, entries []*connectionCacheEntry) error {
+	for _, entry := range entries {
+		select {
+		case <-entry.ready:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if entry.conn == nil {
+			// Failed to open, so there's nothing to close
+			continue
+		}
+		select {
+		case <-entry.closed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+func (c *connectionCache) open(ctx context.Context, driver, dsn string) (drivers.Connection, error) {
+	conn, err := drivers.Open(driver, dsn, c.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	err = conn.Migrate(ctx)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	return conn, nil
+}
+
+// acquire must be called while holding the lock.
+func (c *connectionCache) acquire(entry *connectionCacheEntry) {
+	entry.refs++
+	if entry.refs == 1 {
+		connectionsInUse.WithLabelValues(entry.driver).Inc()
+	}
+}
+
+func (c *connectionCache) release(entry *connectionCacheEntry) {
+	c.lock.Lock()
+	entry.refs--
+	entry.lastUsed = time.Now()
+	closeNow := false
+	if entry.refs == 0 {
+		connectionsInUse.WithLabelValues(entry.driver).Dec()
+		closeNow = entry.evicted && entry.conn != nil && !c.closed
+		if c.inUse[entry.key] == entry {
+			delete(c.inUse, entry.key)
 		}
+	}
+	c.lock.Unlock()
+
+	if closeNow {
+		_ = c.closeEntry(entry, entry.evictReason)
+	}
+}
+
+// onEvict is called by the LRU while holding the lock when an entry is removed.
+func (c *connectionCache) onEvict(key, val any) {
+	entry := val.(*connectionCacheEntry)
+	entry.evicted = true
+	if entry.evictReason == "" {
+		entry.evictReason = "lru"
+	}
+
+	if c.closed {
+		return
+	}
+
+	// Entries that are opening or in use are closed when they're released.
+	// If the LRU policy evicted them, they're still returned for their key until then.
+	if entry.refs > 0 {
+		if entry.evictReason == "lru" {
+			c.inUse[entry.key] = entry
+		}
+		return
+	}
+	if entry.conn == nil {
+		return
+	}
+	go func() { _ = c.closeEntry(entry, entry.evictReason) }()
+}
 
-		c.cache.Add(key, conn)
-		return conn, nil
+// removeEntry removes an entry that failed to open. It must be called while holding the lock.
+func (c *connectionCache) removeEntry(entry *connectionCacheEntry) {
+	val, ok := c.lru.Peek(entry.key)
+	if ok && val == entry {
+		c.lru.Remove(entry.key)
+	}
+	if c.inUse[entry.key] == entry {
+		delete(c.inUse, entry.key)
 	}
+}
 
-	return val.(drivers.Connection), nil
+func (c *connectionCache) closeEntry(entry *connectionCacheEntry, reason string) error {
+	err := entry.conn.Close()
+	close(entry.closed)
+	connectionsOpen.WithLabelValues(entry.driver).Dec()
+	connectionsClosed.WithLabelValues(entry.driver, reason).Inc()
+	if err != nil {
+		c.logger.Error("failed closing cached connection", zap.String("instance_id", entry.instanceID), zap.String("driver", entry.driver), zap.Error(err))
+	}
+	return err
 }
 
+// evictIdle periodically evicts connections that haven't been used for longer than the idle TTL.
+func (c *connectionCache) evictIdle() {
+	ticker := time.NewTicker(c.idleTTL / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.stop:
+			return
+		case <-ticker.C:
+		}
+
+		c.lock.Lock()
+		for _, key := range c.lru.Keys() {
+			val, ok := c.lru.Peek(key)
+			if !ok {
+				continue
+			}
+			entry := val.(*connectionCacheEntry)
+			if entry.refs == 0 && entry.conn != nil && time.Since(entry.lastUsed) > c.idleTTL {
+				entry.evictReason = "idle"
+				c.lru.Remove(key)
+			}
+		}
+		c.lock.Unlock()
+	}
+}
+
+// catalogCache caches a catalog service per instance.
+// The service doesn't hold references to the instance's connections between calls. Every call to get acquires them
+// (so they're subject to the connection cache's idle TTL and eviction while unused), and the service is re-created
+// if the connection cache has opened new connections since it was cached.
 type catalogCache struct {
-	cache map[string]*catalog.Service
+	cache map[string]*catalogCacheEntry
 	lock  sync.Mutex
 }
 
+type catalogCacheEntry struct {
+	service  *catalog.Service
+	olapConn drivers.Connection
+	repoConn drivers.Connection
+}
+
 func newCatalogCache() *catalogCache {
 	return &catalogCache{
-		cache: make(map[string]*catalog.Service),
+		cache: make(map[string]*catalogCacheEntry),
 	}
 }
 
-func (c *catalogCache) get(ctx context.Context, rt *Runtime, instID string) (*catalog.Service, error) {
+// get returns the instance's catalog service.
+// The returned release func must be called when the caller is done with the service.
+func (c *catalogCache) get(ctx context.Context, rt *Runtime, instID string) (*catalog.Service, func(), error) {
 	// TODO 1: opening a driver shouldn't take too long but we should still have an instance specific lock
-	// TODO 2: This is a cache on a cache, which may lead to undefined behavior
-	// TODO 3: Use LRU and not a map
+	// TODO 2: Use LRU and not a map
 
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
-	key := instID
-
-	service, ok := c.cache[key]
-	if ok {
-		return service, nil
-	}
-
 	registry, _ := rt.metastore.RegistryStore()
 	inst, err := registry.FindInstance(ctx, instID)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	olapConn, err := rt.connCache.get(ctx, instID, inst.OLAPDriver, inst.OLAPDSN)
+	olapConn, releaseOLAP, err := rt.connCache.get(ctx, instID, inst.OLAPDriver, inst.OLAPDSN)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
+
+	repoConn, releaseRepo, err := rt.connCache.get(ctx, instID, inst.RepoDriver, inst.RepoDSN)
+	if err != nil {
+		releaseOLAP()
+		return nil, nil, err
+	}
+
+	release := func() {
+		releaseOLAP()
+		releaseRepo()
+	}
+
+	key := instID
+
+	entry, ok := c.cache[key]
+	if ok && entry.olapConn == olapConn && entry.repoConn == repoConn {
+		return entry.service, release, nil
+	}
+
 	olap, _ := olapConn.OLAPStore()
 
 	var catalogStore drivers.CatalogStore
 	if inst.EmbedCatalog {
-		conn, err := rt.connCache.get(ctx, inst.ID, inst.OLAPDriver, inst.OLAPDSN)
-		if err != nil {
-			return nil, err
-		}
-
-		store, ok := conn.CatalogStore()
+		store, ok := olapConn.CatalogStore()
 		if !ok {
-			return nil, fmt.Errorf("instance cannot embed catalog")
+			release()
+			return nil, nil, fmt.Errorf("instance cannot embed catalog")
 		}
 
 		catalogStore = store
 	} else {
 		store, ok := rt.metastore.CatalogStore()
 		if !ok {
-			return nil, fmt.Errorf("metastore cannot serve as catalog")
+			release()
+			return nil, nil, fmt.Errorf("metastore cannot serve as catalog")
 		}
 		catalogStore = store
 	}
 
-	repoConn, err := rt.connCache.get(ctx, instID, inst.RepoDriver, inst.RepoDSN)
-	if err != nil {
-		return nil, err
-	}
 	repoStore, _ := repoConn.RepoStore()
 
-	service = catalog.NewService(catalogStore, repoStore, olap, instID, rt.logger)
-	c.cache[key] = service
-	return service, nil
+	service := catalog.NewService(catalogStore, repoStore, olap, instID, inst.Variables, rt.logger)
+	if rt.opts.ReconcileConcurrency > 0 {
+		service.Concurrency = rt.opts.ReconcileConcurrency
+	}
+	c.cache[key] = &catalogCacheEntry{service: service, olapConn: olapConn, repoConn: repoConn}
+	return service, release, nil
+}
+
+// evict removes the instance's catalog service from the cache.
+func (c *catalogCache) evict(instID string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.cache, instID)
 }
 
 type queryCache struct {
@@ -174,3 +495,15 @@ func (c *queryCache) get(key queryCacheKey) (any, bool) {
 func (c *queryCache) add(key queryCacheKey, value any) bool {
 	return c.cache.Add(key, value)
 }
+
+// evict removes all cached query results for an instance and returns the number of results removed.
+func (c *queryCache) evict(instanceID string) int {
+	n := 0
+	for _, key := range c.cache.Keys() {
+		if key.(queryCacheKey).instanceID == instanceID {
+			c.cache.Remove(key)
+			n++
+		}
+	}
+	return n
+}
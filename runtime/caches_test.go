@@ -2,35 +2,152 @@ package runtime
 
 import (
 	"context"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/rilldata/rill/runtime/drivers"
 	_ "github.com/rilldata/rill/runtime/drivers/sqlite"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 )
 
 func TestConnectionCache(t *testing.T) {
 	ctx := context.Background()
 	id := uuid.NewString()
 
-	c := newConnectionCache(10, zap.NewNop())
-	conn1, err := c.get(ctx, id, "sqlite", ":memory:")
+	c := newConnectionCache(10, 0, zap.NewNop())
+	conn1, release1, err := c.get(ctx, id, "sqlite", ":memory:")
 	require.NoError(t, err)
 	require.NotNil(t, conn1)
+	defer release1()
 
-	conn2, err := c.get(ctx, id, "sqlite", ":memory:")
+	conn2, release2, err := c.get(ctx, id, "sqlite", ":memory:")
 	require.NoError(t, err)
 	require.NotNil(t, conn2)
+	defer release2()
 
-	conn3, err := c.get(ctx, uuid.NewString(), "sqlite", ":memory:")
+	conn3, release3, err := c.get(ctx, uuid.NewString(), "sqlite", ":memory:")
 	require.NoError(t, err)
 	require.NotNil(t, conn3)
+	defer release3()
 
 	require.True(t, conn1 == conn2)
 	require.False(t, conn2 == conn3)
 }
 
+func TestConnectionCacheEviction(t *testing.T) {
+	ctx := context.Background()
+	c := newConnectionCache(1, 0, zap.NewNop())
+
+	conn1, release1, err := c.get(ctx, "a", "mock", "")
+	require.NoError(t, err)
+
+	// Evicted by the LRU, but still in use
+	_, release2, err := c.get(ctx, "b", "mock", "")
+	require.NoError(t, err)
+	require.False(t, conn1.(*mockConn).isClosed())
+
+	// Still returned until it's released, so the key doesn't get a second connection
+	conn1b, release1b, err := c.get(ctx, "a", "mock", "")
+	require.NoError(t, err)
+	require.True(t, conn1 == conn1b)
+
+	// Closed when released
+	release1()
+	require.False(t, conn1.(*mockConn).isClosed())
+	release1b()
+	require.True(t, conn1.(*mockConn).isClosed())
+	conn1c, release1c, err := c.get(ctx, "a", "mock", "")
+	require.NoError(t, err)
+	require.False(t, conn1 == conn1c)
+	release1c()
+
+	// Explicit eviction of an idle connection closes it
+	release2()
+	conn2, release2, err := c.get(ctx, "b", "mock", "")
+	require.NoError(t, err)
+	release2()
+	c.evict("b")
+	require.Eventually(t, conn2.(*mockConn).isClosed, time.Second, 10*time.Millisecond)
+
+	// Close closes remaining connections
+	conn3, release3, err := c.get(ctx, "c", "mock", "")
+	require.NoError(t, err)
+	defer release3()
+	require.NoError(t, c.Close())
+	require.True(t, conn3.(*mockConn).isClosed())
+	_, _, err = c.get(ctx, "c", "mock", "")
+	require.ErrorIs(t, err, errConnectionCacheClosed)
+}
+
+func TestConnectionCacheSingleflight(t *testing.T) {
+	ctx := context.Background()
+	c := newConnectionCache(10, 0, zap.NewNop())
+	defer c.Close()
+
+	dsn := uuid.NewString()
+	var g errgroup.Group
+	conns := make([]drivers.Connection, 10)
+	for i := range conns {
+		i := i
+		g.Go(func() error {
+			conn, release, err := c.get(ctx, "a", "mock", dsn)
+			if err != nil {
+				return err
+			}
+			defer release()
+			conns[i] = conn
+			return nil
+		})
+	}
+	require.NoError(t, g.Wait())
+	for _, conn := range conns {
+		require.True(t, conn == conns[0])
+	}
+	require.Equal(t, int64(1), mockOpens(dsn))
+
+	// A slow open for one instance doesn't block another instance
+	slowDone := make(chan struct{})
+	go func() {
+		_, release, err := c.get(ctx, "slow", "mock", "slow")
+		if err == nil {
+			release()
+		}
+		close(slowDone)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	_, release, err := c.get(ctx, "b", "mock", uuid.NewString())
+	require.NoError(t, err)
+	release()
+	select {
+	case <-slowDone:
+		t.Fatal("expected slow open to still be in progress")
+	default:
+	}
+	<-slowDone
+}
+
+func TestConnectionCacheIdleTTL(t *testing.T) {
+	ctx := context.Background()
+	c := newConnectionCache(10, 50*time.Millisecond, zap.NewNop())
+	defer c.Close()
+
+	conn1, release1, err := c.get(ctx, "a", "mock", "")
+	require.NoError(t, err)
+	conn2, release2, err := c.get(ctx, "b", "mock", "")
+	require.NoError(t, err)
+	defer release2()
+
+	// Only the released connection is closed after the TTL
+	release1()
+	require.Eventually(t, conn1.(*mockConn).isClosed, time.Second, 10*time.Millisecond)
+	require.False(t, conn2.(*mockConn).isClosed())
+}
+
 func TestNilValues(t *testing.T) {
 	qc := newQueryCache(10)
 
@@ -48,3 +165,101 @@ func TestNilValues(t *testing.T) {
 	require.Nil(t, v)
 	require.False(t, ok)
 }
+
+func init() {
+	drivers.Register("mock", mockDriver{})
+}
+
+var (
+	mockOpenCounts   = make(map[string]int64)
+	mockOpenCountsMu sync.Mutex
+)
+
+func mockOpens(dsn string) int64 {
+	mockOpenCountsMu.Lock()
+	defer mockOpenCountsMu.Unlock()
+	return mockOpenCounts[dsn]
+}
+
+// mockDriver opens connections that track whether they've been closed.
+// Opening the DSN "slow" takes 200ms.
+type mockDriver struct{}
+
+func (d mockDriver) Open(dsn string, logger *zap.Logger) (drivers.Connection, error) {
+	mockOpenCountsMu.Lock()
+	mockOpenCounts[dsn]++
+	mockOpenCountsMu.Unlock()
+	if dsn == "slow" {
+		time.Sleep(200 * time.Millisecond)
+	} else {
+		time.Sleep(10 * time.Millisecond)
+	}
+	return &mockConn{}, nil
+}
+
+type mockConn struct {
+	closed atomic.Bool
+}
+
+func (c *mockConn) isClosed() bool {
+	return c.closed.Load()
+}
+
+func (c *mockConn) Migrate(ctx context.Context) error { return nil }
+
+func (c *mockConn) MigrationStatus(ctx context.Context) (int, int, error) { return 0, 0, nil }
+
+func (c *mockConn) Close() error {
+	c.closed.Store(true)
+	return nil
+}
+
+func (c *mockConn) RegistryStore() (drivers.RegistryStore, bool) { return nil, false }
+
+func (c *mockConn) CatalogStore() (drivers.CatalogStore, bool) { return nil, false }
+
+func (c *mockConn) RepoStore() (drivers.RepoStore, bool) { return nil, false }
+
+func (c *mockConn) OLAPStore() (drivers.OLAPStore, bool) { return nil, false }
+
+func TestCatalogCacheReleasesConnections(t *testing.T) {
+	ctx := context.Background()
+	rt, err := New(&Options{
+		ConnectionCacheSize:    10,
+		ConnectionCacheIdleTTL: 50 * time.Millisecond,
+		MetastoreDriver:        "sqlite",
+		MetastoreDSN:           "file:TestCatalogCacheReleasesConnections?mode=memory&cache=shared",
+		QueryCacheSize:         10,
+	}, zap.NewNop())
+	require.NoError(t, err)
+	defer rt.Close()
+
+	inst := &drivers.Instance{
+		OLAPDriver:   "duckdb",
+		RepoDriver:   "file",
+		RepoDSN:      t.TempDir(),
+		EmbedCatalog: true,
+	}
+	require.NoError(t, rt.CreateInstance(ctx, inst))
+
+	cat1, release, err := rt.Catalog(ctx, inst.ID)
+	require.NoError(t, err)
+	release()
+	_, err = rt.Reconcile(ctx, inst.ID, nil, nil, false, false)
+	require.NoError(t, err)
+
+	// The cached catalog service doesn't keep the connections open after reconciling
+	require.Eventually(t, func() bool {
+		rt.connCache.lock.Lock()
+		defer rt.connCache.lock.Unlock()
+		return rt.connCache.lru.Len() == 0
+	}, time.Second, 10*time.Millisecond)
+
+	// The service is re-created for the new connections
+	cat2, release, err := rt.Catalog(ctx, inst.ID)
+	require.NoError(t, err)
+	defer release()
+	require.False(t, cat1 == cat2)
+	_, err = rt.Reconcile(ctx, inst.ID, nil, nil, false, false)
+	require.NoError(t, err)
+}
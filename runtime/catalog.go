@@ -4,27 +4,32 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
+	"strings"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/services/catalog"
+	"github.com/rilldata/rill/runtime/services/catalog/artifacts"
 	"google.golang.org/protobuf/proto"
 )
 
 func (r *Runtime) ListCatalogEntries(ctx context.Context, instanceID string, t drivers.ObjectType) ([]*drivers.CatalogEntry, error) {
-	cat, err := r.Catalog(ctx, instanceID)
+	cat, release, err := r.Catalog(ctx, instanceID)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
 
 	return cat.FindEntries(ctx, t), nil
 }
 
 func (r *Runtime) GetCatalogEntry(ctx context.Context, instanceID, name string) (*drivers.CatalogEntry, error) {
-	cat, err := r.Catalog(ctx, instanceID)
+	cat, release, err := r.Catalog(ctx, instanceID)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
 
 	e, ok := cat.FindEntry(ctx, name)
 	if !ok {
@@ -34,32 +39,124 @@ func (r *Runtime) GetCatalogEntry(ctx context.Context, instanceID, name string)
 	return e, nil
 }
 
+func (r *Runtime) ListCatalogEntryVersions(ctx context.Context, instanceID, name string) ([]*drivers.CatalogEntryVersion, error) {
+	cat, release, err := r.Catalog(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return cat.FindEntryVersions(ctx, name)
+}
+
+// RestoreCatalogEntryVersion writes the artifact of a version of a catalog entry back to the repo and reconciles it.
+// The artifact is only re-written if its contents changed since the version was recorded. It's written as it was at the time
+// (so variable references are kept), or re-created from the version's definition for versions recorded without the artifact's contents.
+func (r *Runtime) RestoreCatalogEntryVersion(ctx context.Context, instanceID, name string, version int64) (*catalog.ReconcileResult, error) {
+	defer r.catalogLocks.lockWrites(instanceID)()
+
+	cat, release, err := r.Catalog(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	v, err := cat.Catalog.FindEntryVersion(ctx, instanceID, version)
+	if err != nil && !errors.Is(err, drivers.ErrNotFound) {
+		return nil, err
+	}
+	if v == nil || (!strings.EqualFold(v.Entry.Name, name) && !strings.EqualFold(v.FromName, name)) {
+		return nil, fmt.Errorf("version %d of %q not found", version, name)
+	}
+	if v.Entry.Path == "" {
+		return nil, fmt.Errorf("entry %q is not backed by an artifact", v.Entry.Name)
+	}
+
+	changedPaths := []string{v.Entry.Path}
+	var forcedPaths []string
+	blob, err := cat.Repo.Get(ctx, instanceID, v.Entry.Path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) && !errors.Is(err, drivers.ErrNotFound) {
+		return nil, err
+	}
+	if err != nil || artifacts.ContentHash(blob) != v.Entry.ContentHash {
+		if v.Entry.Artifact != "" {
+			err = cat.Repo.Put(ctx, instanceID, v.Entry.Path, strings.NewReader(v.Entry.Artifact), drivers.PutOptions{Create: true})
+		} else {
+			err = artifacts.Write(ctx, cat.Repo, instanceID, v.Entry)
+		}
+		if err != nil {
+			return nil, err
+		}
+		// the file's modified time isn't precise enough to tell that it changed right after a reconcile
+		forcedPaths = []string{v.Entry.Path}
+	}
+
+	// the entry might have been moved to another file since
+	if e, ok := cat.FindEntry(ctx, v.Entry.Name); ok && e.Path != v.Entry.Path {
+		err = cat.Repo.Delete(ctx, instanceID, e.Path)
+		if err != nil {
+			return nil, err
+		}
+		changedPaths = append(changedPaths, e.Path)
+	}
+
+	resp, err := cat.Reconcile(ctx, catalog.ReconcileConfig{
+		ChangedPaths: changedPaths,
+		ForcedPaths:  forcedPaths,
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.reconcileSubs.publish(instanceID, resp)
+
+	return resp, nil
+}
+
 func (r *Runtime) Reconcile(ctx context.Context, instanceID string, changedPaths, forcedPaths []string, dry, strict bool) (*catalog.ReconcileResult, error) {
-	cat, err := r.Catalog(ctx, instanceID)
+	return r.ReconcileStream(ctx, instanceID, changedPaths, forcedPaths, dry, strict, nil)
+}
+
+// ReconcileStream is like Reconcile, but calls onEvent with the progress of every migration item while reconciling.
+func (r *Runtime) ReconcileStream(ctx context.Context, instanceID string, changedPaths, forcedPaths []string, dry, strict bool, onEvent func(catalog.ReconcileEvent)) (*catalog.ReconcileResult, error) {
+	defer r.catalogLocks.lockWrites(instanceID)()
+
+	cat, release, err := r.Catalog(ctx, instanceID)
 	if err != nil {
 		return nil, err
 	}
+	defer release()
 
 	resp, err := cat.Reconcile(ctx, catalog.ReconcileConfig{
 		DryRun:       dry,
 		Strict:       strict,
 		ChangedPaths: changedPaths,
 		ForcedPaths:  forcedPaths,
+		OnEvent:      onEvent,
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	if !dry {
+		r.reconcileSubs.publish(instanceID, resp)
+	}
+
 	return resp, nil
 }
 
 func (r *Runtime) RefreshSource(ctx context.Context, instanceID, name string) error {
-	cat, err := r.Catalog(ctx, instanceID)
+	defer r.catalogLocks.lockWrites(instanceID)()
+
+	cat, release, err := r.Catalog(ctx, instanceID)
 	if err != nil {
 		return err
 	}
+	defer release()
 
-	path, ok := cat.NameToPath[name]
+	path, ok, err := cat.FindPath(ctx, name)
+	if err != nil {
+		return err
+	}
 	if !ok {
 		return fmt.Errorf("artifact not found for source")
 	}
@@ -72,6 +169,7 @@ func (r *Runtime) RefreshSource(ctx context.Context, instanceID, name string) er
 	if err != nil {
 		return err
 	}
+	r.reconcileSubs.publish(instanceID, resp)
 	if len(resp.Errors) > 0 {
 		return errors.New(resp.Errors[0].Message)
 	}
@@ -80,19 +178,23 @@ func (r *Runtime) RefreshSource(ctx context.Context, instanceID, name string) er
 }
 
 func (r *Runtime) SyncExistingTables(ctx context.Context, instanceID string) error {
+	defer r.catalogLocks.lockWrites(instanceID)()
+
 	// TODO: move to using reconcile
 
 	// Get OLAP
-	olap, err := r.OLAP(ctx, instanceID)
+	olap, releaseOLAP, err := r.OLAP(ctx, instanceID)
 	if err != nil {
 		return err
 	}
+	defer releaseOLAP()
 
 	// Get catalog
-	cat, err := r.Catalog(ctx, instanceID)
+	cat, releaseCatalog, err := r.Catalog(ctx, instanceID)
 	if err != nil {
 		return err
 	}
+	defer releaseCatalog()
 
 	// Get full catalog
 	objs := cat.FindEntries(ctx, drivers.ObjectTypeUnspecified)
@@ -115,19 +217,24 @@ func (r *Runtime) SyncExistingTables(ctx context.Context, instanceID string) err
 	added := 0
 	updated := 0
 	for _, t := range tables {
-		obj, ok := objMap[t.Name]
+		// Tables outside the default database and schema are tracked by their qualified name
+		name := t.QualifiedName()
+		obj, ok := objMap[name]
 
 		// Track that the object still exists
 		if ok {
-			objSeen[t.Name] = true
+			objSeen[name] = true
 		}
 
 		// Create or update in catalog if relevant
 		if ok && obj.Type == drivers.ObjectTypeTable && !obj.GetTable().Managed {
-			// If the table has already been synced, update the schema if it has changed
+			// If the table has already been synced, update the schema, namespace and stats if they have changed
 			tbl := obj.GetTable()
-			if !proto.Equal(t.Schema, tbl.Schema) {
+			if !proto.Equal(t.Schema, tbl.Schema) || tbl.Database != t.Database || tbl.DatabaseSchema != t.DatabaseSchema || !proto.Equal(t.Stats, tbl.Stats) {
 				tbl.Schema = t.Schema
+				tbl.Database = t.Database
+				tbl.DatabaseSchema = t.DatabaseSchema
+				tbl.Stats = t.Stats
 				err := cat.Catalog.UpdateEntry(ctx, instanceID, obj)
 				if err != nil {
 					return err
@@ -137,12 +244,15 @@ func (r *Runtime) SyncExistingTables(ctx context.Context, instanceID string) err
 		} else if !ok {
 			// If we haven't seen this table before, add it
 			err := cat.Catalog.CreateEntry(ctx, instanceID, &drivers.CatalogEntry{
-				Name: t.Name,
+				Name: name,
 				Type: drivers.ObjectTypeTable,
 				Object: &runtimev1.Table{
-					Name:    t.Name,
-					Schema:  t.Schema,
-					Managed: false,
+					Name:           name,
+					Schema:         t.Schema,
+					Managed:        false,
+					Database:       t.Database,
+					DatabaseSchema: t.DatabaseSchema,
+					Stats:          t.Stats,
 				},
 			})
 			if err != nil {
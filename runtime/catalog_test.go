@@ -19,8 +19,9 @@ func TestCatalog(t *testing.T) {
 	ctx := context.Background()
 	rt, instanceID := testruntime.NewInstanceForProject(t, "ad_bids")
 
-	cat, err := rt.Catalog(ctx, instanceID)
+	cat, release, err := rt.Catalog(ctx, instanceID)
 	require.NoError(t, err)
+	defer release()
 
 	sourcePath := "/sources/ad_bids_source.yaml"
 	modelPath := "/models/ad_bids.sql"
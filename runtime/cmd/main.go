@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/kelseyhightower/envconfig"
@@ -14,6 +15,7 @@ import (
 	_ "github.com/rilldata/rill/runtime/drivers/druid"
 	_ "github.com/rilldata/rill/runtime/drivers/duckdb"
 	_ "github.com/rilldata/rill/runtime/drivers/file"
+	_ "github.com/rilldata/rill/runtime/drivers/git"
 	_ "github.com/rilldata/rill/runtime/drivers/postgres"
 	_ "github.com/rilldata/rill/runtime/drivers/sqlite"
 	"github.com/rilldata/rill/runtime/pkg/graceful"
@@ -24,14 +26,16 @@ import (
 )
 
 type Config struct {
-	Env                 string        `default:"development"`
-	HTTPPort            int           `default:"8080" split_words:"true"`
-	GRPCPort            int           `default:"9090" split_words:"true"`
-	LogLevel            zapcore.Level `default:"info" split_words:"true"`
-	DatabaseDriver      string        `default:"sqlite"`
-	DatabaseURL         string        `default:"file:rill?mode=memory&cache=shared" split_words:"true"`
-	ConnectionCacheSize int           `default:"100" split_words:"true"`
-	QueryCacheSize      int           `default:"10000" split_words:"true"`
+	Env                    string        `default:"development"`
+	HTTPPort               int           `default:"8080" split_words:"true"`
+	GRPCPort               int           `default:"9090" split_words:"true"`
+	LogLevel               zapcore.Level `default:"info" split_words:"true"`
+	DatabaseDriver         string        `default:"sqlite"`
+	DatabaseURL            string        `default:"file:rill?mode=memory&cache=shared" split_words:"true"`
+	ConnectionCacheSize    int           `default:"100" split_words:"true"`
+	ConnectionCacheIdleTTL time.Duration `default:"0" split_words:"true"`
+	QueryCacheSize         int           `default:"10000" split_words:"true"`
+	ReconcileConcurrency   int           `default:"4" split_words:"true"`
 }
 
 func main() {
@@ -60,10 +64,12 @@ func main() {
 
 	// Init runtime
 	opts := &runtime.Options{
-		ConnectionCacheSize: conf.ConnectionCacheSize,
-		MetastoreDriver:     conf.DatabaseDriver,
-		MetastoreDSN:        conf.DatabaseURL,
-		QueryCacheSize:      conf.QueryCacheSize,
+		ConnectionCacheSize:    conf.ConnectionCacheSize,
+		ConnectionCacheIdleTTL: conf.ConnectionCacheIdleTTL,
+		MetastoreDriver:        conf.DatabaseDriver,
+		MetastoreDSN:           conf.DatabaseURL,
+		QueryCacheSize:         conf.QueryCacheSize,
+		ReconcileConcurrency:   conf.ReconcileConcurrency,
 	}
 	rt, err := runtime.New(opts, logger)
 	if err != nil {
@@ -3,6 +3,7 @@ package rillv1beta
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path"
@@ -29,8 +30,24 @@ func (c *Codec) IsInit(ctx context.Context) bool {
 	return err == nil
 }
 
+// ProjectConfig parses the project's rill.yaml. It returns an empty config if the project isn't initialized.
+func (c *Codec) ProjectConfig(ctx context.Context) (*ProjectConfig, error) {
+	blob, err := c.Repo.Get(ctx, c.InstanceID, "rill.yaml")
+	if err != nil {
+		return &ProjectConfig{}, nil
+	}
+
+	conf := &ProjectConfig{}
+	err = yaml.Unmarshal([]byte(blob), conf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse rill.yaml: %w", err)
+	}
+
+	return conf, nil
+}
+
 func (c *Codec) InitEmpty(ctx context.Context, name, rillVersion string) error {
-	err := c.Repo.Put(ctx, c.InstanceID, "rill.yaml", strings.NewReader(fmt.Sprintf("compiler: %s\nrill_version: %s\n\nname: %s\n", Version, rillVersion, name)))
+	err := c.Repo.Put(ctx, c.InstanceID, "rill.yaml", strings.NewReader(fmt.Sprintf("compiler: %s\nrill_version: %s\n\nname: %s\n", Version, rillVersion, name)), drivers.PutOptions{Create: true})
 	if err != nil {
 		return err
 	}
@@ -41,22 +58,28 @@ func (c *Codec) InitEmpty(ctx context.Context, name, rillVersion string) error {
 	}
 	gitignore += "# Rill\n*.db\n*.db.wal\ndata/\n"
 
-	err = c.Repo.Put(ctx, c.InstanceID, ".gitignore", strings.NewReader(gitignore))
+	err = c.Repo.Put(ctx, c.InstanceID, ".gitignore", strings.NewReader(gitignore), drivers.PutOptions{Create: true})
 	if err != nil {
 		return err
 	}
 
-	err = c.Repo.Put(ctx, c.InstanceID, "sources/.gitkeep", strings.NewReader(""))
+	// Skip data files when listing the project's files (keeps an existing ignore file intact)
+	err = c.Repo.Put(ctx, c.InstanceID, ".rillignore", strings.NewReader("# Rill\ndata/\n"), drivers.PutOptions{Create: true, CreateOnly: true})
+	if err != nil && !errors.Is(err, drivers.ErrFileAlreadyExists) {
+		return err
+	}
+
+	err = c.Repo.Put(ctx, c.InstanceID, "sources/.gitkeep", strings.NewReader(""), drivers.PutOptions{Create: true})
 	if err != nil {
 		return err
 	}
 
-	err = c.Repo.Put(ctx, c.InstanceID, "models/.gitkeep", strings.NewReader(""))
+	err = c.Repo.Put(ctx, c.InstanceID, "models/.gitkeep", strings.NewReader(""), drivers.PutOptions{Create: true})
 	if err != nil {
 		return err
 	}
 
-	err = c.Repo.Put(ctx, c.InstanceID, "dashboards/.gitkeep", strings.NewReader(""))
+	err = c.Repo.Put(ctx, c.InstanceID, "dashboards/.gitkeep", strings.NewReader(""), drivers.PutOptions{Create: true})
 	if err != nil {
 		return err
 	}
@@ -94,15 +117,11 @@ func (c *Codec) PutSource(ctx context.Context, repo drivers.RepoStore, instanceI
 
 	p := path.Join("sources", source.Name+".yaml")
 
-	// TODO: Use create and createOnly when they're added to repo.Put
-	if _, err := os.Stat(path.Join(repo.DSN(), p)); err == nil {
-		if !force {
+	err = repo.Put(ctx, c.InstanceID, p, bytes.NewReader(blob), drivers.PutOptions{Create: true, CreateOnly: !force})
+	if err != nil {
+		if errors.Is(err, drivers.ErrFileAlreadyExists) {
 			return "", os.ErrExist
 		}
-	}
-
-	err = repo.Put(ctx, c.InstanceID, p, bytes.NewReader(blob))
-	if err != nil {
 		return "", err
 	}
 
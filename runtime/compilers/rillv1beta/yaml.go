@@ -1,5 +1,14 @@
 package rillv1beta
 
+// ProjectConfig is the project's rill.yaml
+type ProjectConfig struct {
+	Compiler    string `yaml:"compiler"`
+	RillVersion string `yaml:"rill_version"`
+	Name        string `yaml:"name"`
+	// Vars are the default values of the variables used in the project's artifacts
+	Vars map[string]string `yaml:"vars,omitempty"`
+}
+
 type Source struct {
 	Type         string
 	URI          string `yaml:"uri,omitempty"`
@@ -17,51 +17,52 @@ func (r *Runtime) Registry() drivers.RegistryStore {
 	return registry
 }
 
-func (r *Runtime) Repo(ctx context.Context, instanceID string) (drivers.RepoStore, error) {
+// Repo returns the instance's repo. The returned func must be called when the caller is done using the repo.
+func (r *Runtime) Repo(ctx context.Context, instanceID string) (drivers.RepoStore, func(), error) {
 	inst, err := r.FindInstance(ctx, instanceID)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	conn, err := r.connCache.get(ctx, instanceID, inst.RepoDriver, inst.RepoDSN)
+	conn, release, err := r.connCache.get(ctx, instanceID, inst.RepoDriver, inst.RepoDSN)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	repo, ok := conn.RepoStore()
 	if !ok {
 		// Verified as repo when instance is created, so this should never happen
-		return nil, fmt.Errorf("connection for instance '%s' is not a repo", instanceID)
+		release()
+		return nil, nil, fmt.Errorf("connection for instance '%s' is not a repo", instanceID)
 	}
 
-	return repo, nil
+	return repo, release, nil
 }
 
-func (r *Runtime) OLAP(ctx context.Context, instanceID string) (drivers.OLAPStore, error) {
+// OLAP returns the instance's OLAP store. The returned func must be called when the caller is done using the store
+// (including any results obtained from it).
+func (r *Runtime) OLAP(ctx context.Context, instanceID string) (drivers.OLAPStore, func(), error) {
 	inst, err := r.FindInstance(ctx, instanceID)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	conn, err := r.connCache.get(ctx, instanceID, inst.OLAPDriver, inst.OLAPDSN)
+	conn, release, err := r.connCache.get(ctx, instanceID, inst.OLAPDriver, inst.OLAPDSN)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	olap, ok := conn.OLAPStore()
 	if !ok {
 		// Verified as OLAP when instance is created, so this should never happen
-		return nil, fmt.Errorf("connection for instance '%s' is not an olap", instanceID)
+		release()
+		return nil, nil, fmt.Errorf("connection for instance '%s' is not an olap", instanceID)
 	}
 
-	return olap, nil
+	return olap, release, nil
 }
 
-func (r *Runtime) Catalog(ctx context.Context, instanceID string) (*catalog.Service, error) {
-	c, err := r.catalogCache.get(ctx, r, instanceID)
-	if err != nil {
-		return nil, err
-	}
-
-	return c, err
+// Catalog returns the instance's catalog service. The returned func must be called when the caller is done using the service.
+func (r *Runtime) Catalog(ctx context.Context, instanceID string) (*catalog.Service, func(), error) {
+	return r.catalogCache.get(ctx, r, instanceID)
 }
@@ -77,6 +77,8 @@ func (ps PropertySchema) ValidateType(val any) bool {
 type Env struct {
 	RepoDriver string
 	RepoDSN    string
+	// RepoRoot is the local directory of the repo's files. It's empty if the repo isn't stored locally.
+	RepoRoot string
 }
 
 // Source represents a dataset to ingest using a specific connector (like a connector instance).
@@ -0,0 +1,451 @@
+package drivers
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/apache/arrow/go/v9/arrow"
+	"github.com/apache/arrow/go/v9/arrow/array"
+	"github.com/apache/arrow/go/v9/arrow/decimal128"
+	"github.com/apache/arrow/go/v9/arrow/memory"
+	"github.com/google/uuid"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+)
+
+// DefaultArrowBatchSize is the number of rows in each record emitted by a RecordReader created with NewRecordReader.
+const DefaultArrowBatchSize = 10000
+
+// RecordReader reads query results as Arrow records.
+// Err should be checked after Next returns false. Release must be called when done to free the underlying result.
+type RecordReader interface {
+	array.RecordReader
+	Err() error
+	// ResultSchema returns the schema of the result in the runtime's types, which are more specific than the Arrow types (see typeToArrow).
+	// It's nil for statements that don't return rows.
+	ResultSchema() *runtimev1.StructType
+}
+
+// NewRecordReader converts a Result to a RecordReader that emits records of up to batchSize rows.
+// It's a fallback for drivers that don't natively support Arrow. Releasing the reader closes the Result.
+func NewRecordReader(res *Result, batchSize int) (RecordReader, error) {
+	schema, err := SchemaToArrow(res.Schema)
+	if err != nil {
+		_ = res.Close()
+		return nil, err
+	}
+
+	if batchSize <= 0 {
+		batchSize = DefaultArrowBatchSize
+	}
+
+	return &rowsRecordReader{
+		refCount:  1,
+		res:       res,
+		schema:    schema,
+		batchSize: batchSize,
+		mem:       memory.NewGoAllocator(),
+	}, nil
+}
+
+type rowsRecordReader struct {
+	refCount  int64
+	res       *Result
+	schema    *arrow.Schema
+	batchSize int
+	mem       memory.Allocator
+	rec       arrow.Record
+	err       error
+}
+
+var _ RecordReader = (*rowsRecordReader)(nil)
+
+// Retain implements array.RecordReader.
+func (r *rowsRecordReader) Retain() {
+	atomic.AddInt64(&r.refCount, 1)
+}
+
+// Release implements array.RecordReader.
+func (r *rowsRecordReader) Release() {
+	if atomic.AddInt64(&r.refCount, -1) == 0 {
+		if r.rec != nil {
+			r.rec.Release()
+			r.rec = nil
+		}
+		err := r.res.Close()
+		if r.err == nil {
+			r.err = err
+		}
+	}
+}
+
+// Schema implements array.RecordReader.
+func (r *rowsRecordReader) Schema() *arrow.Schema {
+	return r.schema
+}
+
+// Record implements array.RecordReader. The record is only valid until the next call to Next.
+func (r *rowsRecordReader) Record() arrow.Record {
+	return r.rec
+}
+
+// Err implements RecordReader.
+func (r *rowsRecordReader) Err() error {
+	return r.err
+}
+
+// ResultSchema implements RecordReader.
+func (r *rowsRecordReader) ResultSchema() *runtimev1.StructType {
+	return r.res.Schema
+}
+
+// Next implements array.RecordReader.
+func (r *rowsRecordReader) Next() bool {
+	if r.rec != nil {
+		r.rec.Release()
+		r.rec = nil
+	}
+	if r.err != nil {
+		return false
+	}
+
+	b := array.NewRecordBuilder(r.mem, r.schema)
+	defer b.Release()
+
+	n := 0
+	for n < r.batchSize && r.res.Next() {
+		vals, err := r.res.SliceScan()
+		if err != nil {
+			r.err = err
+			return false
+		}
+
+		for i, v := range vals {
+			err := appendArrowValue(b.Field(i), r.schema.Field(i).Type, v)
+			if err != nil {
+				r.err = fmt.Errorf("column %q: %w", r.schema.Field(i).Name, err)
+				return false
+			}
+		}
+		n++
+	}
+
+	if err := r.res.Err(); err != nil {
+		r.err = err
+		return false
+	}
+	if n == 0 {
+		return false
+	}
+
+	r.rec = b.NewRecord()
+	return true
+}
+
+// SchemaToArrow converts a result schema to an Arrow schema.
+// The schema is nil for statements that don't return rows (like DDL), which is converted to an empty schema.
+func SchemaToArrow(schema *runtimev1.StructType) (*arrow.Schema, error) {
+	fields, err := structFieldsToArrow(schema)
+	if err != nil {
+		return nil, err
+	}
+	return arrow.NewSchema(fields, nil), nil
+}
+
+func structFieldsToArrow(st *runtimev1.StructType) ([]arrow.Field, error) {
+	if st == nil {
+		return nil, nil
+	}
+	fields := make([]arrow.Field, len(st.Fields))
+	for i, f := range st.Fields {
+		t, err := typeToArrow(f.Type)
+		if err != nil {
+			return nil, err
+		}
+		fields[i] = arrow.Field{Name: f.Name, Type: t, Nullable: f.Type.Nullable}
+	}
+	return fields, nil
+}
+
+// typeToArrow converts a type to an Arrow data type.
+// Types without an exact Arrow counterpart (like maps, intervals and enums) are represented as JSON strings.
+// Decimals are represented as strings, since the type doesn't carry their precision and scale.
+func typeToArrow(t *runtimev1.Type) (arrow.DataType, error) {
+	switch t.Code {
+	case runtimev1.Type_CODE_BOOL:
+		return arrow.FixedWidthTypes.Boolean, nil
+	case runtimev1.Type_CODE_INT8:
+		return arrow.PrimitiveTypes.Int8, nil
+	case runtimev1.Type_CODE_INT16:
+		return arrow.PrimitiveTypes.Int16, nil
+	case runtimev1.Type_CODE_INT32:
+		return arrow.PrimitiveTypes.Int32, nil
+	case runtimev1.Type_CODE_INT64:
+		return arrow.PrimitiveTypes.Int64, nil
+	case runtimev1.Type_CODE_INT128, runtimev1.Type_CODE_UINT128:
+		return &arrow.Decimal128Type{Precision: 38, Scale: 0}, nil
+	case runtimev1.Type_CODE_UINT8:
+		return arrow.PrimitiveTypes.Uint8, nil
+	case runtimev1.Type_CODE_UINT16:
+		return arrow.PrimitiveTypes.Uint16, nil
+	case runtimev1.Type_CODE_UINT32:
+		return arrow.PrimitiveTypes.Uint32, nil
+	case runtimev1.Type_CODE_UINT64:
+		return arrow.PrimitiveTypes.Uint64, nil
+	case runtimev1.Type_CODE_FLOAT32:
+		return arrow.PrimitiveTypes.Float32, nil
+	case runtimev1.Type_CODE_FLOAT64:
+		return arrow.PrimitiveTypes.Float64, nil
+	case runtimev1.Type_CODE_DECIMAL:
+		// Drivers should scan decimals as strings to keep them exact. Decimals scanned as floats are formatted as strings.
+		return arrow.BinaryTypes.String, nil
+	case runtimev1.Type_CODE_TIMESTAMP:
+		return &arrow.TimestampType{Unit: arrow.Microsecond, TimeZone: "UTC"}, nil
+	case runtimev1.Type_CODE_DATE:
+		return arrow.FixedWidthTypes.Date32, nil
+	case runtimev1.Type_CODE_TIME:
+		return arrow.FixedWidthTypes.Time64us, nil
+	case runtimev1.Type_CODE_STRING, runtimev1.Type_CODE_JSON, runtimev1.Type_CODE_UUID:
+		return arrow.BinaryTypes.String, nil
+	case runtimev1.Type_CODE_BYTES:
+		return arrow.BinaryTypes.Binary, nil
+	case runtimev1.Type_CODE_ARRAY:
+		elem, err := typeToArrow(t.ArrayElementType)
+		if err != nil {
+			return nil, err
+		}
+		return arrow.ListOf(elem), nil
+	case runtimev1.Type_CODE_STRUCT:
+		fields, err := structFieldsToArrow(t.StructType)
+		if err != nil {
+			return nil, err
+		}
+		return arrow.StructOf(fields...), nil
+	case runtimev1.Type_CODE_MAP, runtimev1.Type_CODE_UNSPECIFIED:
+		return arrow.BinaryTypes.String, nil
+	default:
+		return nil, fmt.Errorf("unsupported type %q", t.Code.String())
+	}
+}
+
+// appendArrowValue appends a value scanned from a SQL driver to a builder for dt (created with typeToArrow).
+func appendArrowValue(b array.Builder, dt arrow.DataType, v any) error {
+	if v == nil {
+		b.AppendNull()
+		return nil
+	}
+
+	switch b := b.(type) {
+	case *array.BooleanBuilder:
+		x, ok := v.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected value of type %T", v)
+		}
+		b.Append(x)
+	case *array.Int8Builder:
+		x, err := toInt64(v)
+		if err != nil {
+			return err
+		}
+		b.Append(int8(x))
+	case *array.Int16Builder:
+		x, err := toInt64(v)
+		if err != nil {
+			return err
+		}
+		b.Append(int16(x))
+	case *array.Int32Builder:
+		x, err := toInt64(v)
+		if err != nil {
+			return err
+		}
+		b.Append(int32(x))
+	case *array.Int64Builder:
+		x, err := toInt64(v)
+		if err != nil {
+			return err
+		}
+		b.Append(x)
+	case *array.Uint8Builder:
+		x, err := toUint64(v)
+		if err != nil {
+			return err
+		}
+		b.Append(uint8(x))
+	case *array.Uint16Builder:
+		x, err := toUint64(v)
+		if err != nil {
+			return err
+		}
+		b.Append(uint16(x))
+	case *array.Uint32Builder:
+		x, err := toUint64(v)
+		if err != nil {
+			return err
+		}
+		b.Append(uint32(x))
+	case *array.Uint64Builder:
+		x, err := toUint64(v)
+		if err != nil {
+			return err
+		}
+		b.Append(x)
+	case *array.Float32Builder:
+		x, err := toFloat64(v)
+		if err != nil {
+			return err
+		}
+		b.Append(float32(x))
+	case *array.Float64Builder:
+		x, err := toFloat64(v)
+		if err != nil {
+			return err
+		}
+		b.Append(x)
+	case *array.Decimal128Builder:
+		switch x := v.(type) {
+		case *big.Int:
+			b.Append(decimal128.FromBigInt(x))
+		default:
+			i, err := toInt64(v)
+			if err != nil {
+				return err
+			}
+			b.Append(decimal128.FromI64(i))
+		}
+	case *array.TimestampBuilder:
+		x, ok := v.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected value of type %T", v)
+		}
+		b.Append(arrow.Timestamp(x.UnixMicro()))
+	case *array.Date32Builder:
+		x, ok := v.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected value of type %T", v)
+		}
+		b.Append(arrow.Date32FromTime(x))
+	case *array.Time64Builder:
+		x, ok := v.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected value of type %T", v)
+		}
+		midnight := time.Date(x.Year(), x.Month(), x.Day(), 0, 0, 0, 0, x.Location())
+		b.Append(arrow.Time64(x.Sub(midnight).Microseconds()))
+	case *array.StringBuilder:
+		s, err := toString(v)
+		if err != nil {
+			return err
+		}
+		b.Append(s)
+	case *array.BinaryBuilder:
+		switch x := v.(type) {
+		case []byte:
+			b.Append(x)
+		case string:
+			b.AppendString(x)
+		default:
+			return fmt.Errorf("unexpected value of type %T", v)
+		}
+	case *array.ListBuilder:
+		xs, ok := v.([]any)
+		if !ok {
+			return fmt.Errorf("unexpected value of type %T", v)
+		}
+		b.Append(true)
+		for _, x := range xs {
+			err := appendArrowValue(b.ValueBuilder(), dt.(*arrow.ListType).Elem(), x)
+			if err != nil {
+				return err
+			}
+		}
+	case *array.StructBuilder:
+		m, ok := v.(map[string]any)
+		if !ok {
+			return fmt.Errorf("unexpected value of type %T", v)
+		}
+		b.Append(true)
+		for i, f := range dt.(*arrow.StructType).Fields() {
+			err := appendArrowValue(b.FieldBuilder(i), f.Type, m[f.Name])
+			if err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported arrow builder %T", b)
+	}
+
+	return nil
+}
+
+func toInt64(v any) (int64, error) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(rv.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return int64(rv.Float()), nil
+	default:
+		return 0, fmt.Errorf("unexpected value of type %T", v)
+	}
+}
+
+func toUint64(v any) (uint64, error) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return rv.Uint(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return uint64(rv.Int()), nil
+	default:
+		return 0, fmt.Errorf("unexpected value of type %T", v)
+	}
+}
+
+func toFloat64(v any) (float64, error) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint()), nil
+	default:
+		return 0, fmt.Errorf("unexpected value of type %T", v)
+	}
+}
+
+// toString converts strings, UUIDs and values without an Arrow counterpart to a string.
+func toString(v any) (string, error) {
+	switch x := v.(type) {
+	case string:
+		return x, nil
+	case []byte:
+		if len(x) == 16 {
+			if id, err := uuid.FromBytes(x); err == nil {
+				return id.String(), nil
+			}
+		}
+		return string(x), nil
+	case float32:
+		return strconv.FormatFloat(float64(x), 'f', -1, 32), nil
+	case float64:
+		return strconv.FormatFloat(x, 'f', -1, 64), nil
+	case fmt.Stringer:
+		return x.String(), nil
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		// Maps with non-string keys can't be marshalled to JSON
+		return fmt.Sprint(v), nil //nolint:nilerr // falling back to a Go representation
+	}
+	return string(b), nil
+}
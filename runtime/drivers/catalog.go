@@ -27,6 +27,18 @@ type CatalogStore interface {
 	CreateEntry(ctx context.Context, instanceID string, entry *CatalogEntry) error
 	UpdateEntry(ctx context.Context, instanceID string, entry *CatalogEntry) error
 	DeleteEntry(ctx context.Context, instanceID string, name string) error
+	// RenameEntry replaces the entry named fromName with entry
+	RenameEntry(ctx context.Context, instanceID string, fromName string, entry *CatalogEntry) error
+	// FindEntryVersions returns the versions recorded for the entry with the given name (including renames from and to it), newest first.
+	// A version is recorded for every create, update, rename and delete. Only the latest MaxCatalogEntryVersions versions of a name are kept.
+	FindEntryVersions(ctx context.Context, instanceID string, name string) ([]*CatalogEntryVersion, error)
+	// FindEntryVersion returns a version recorded for the instance, or ErrNotFound if there's none.
+	FindEntryVersion(ctx context.Context, instanceID string, version int64) (*CatalogEntryVersion, error)
+	DeleteEntryVersions(ctx context.Context, instanceID string) error
+	// FindReconcileState returns the state saved by the last reconcile of the instance, or ErrNotFound if there's none.
+	FindReconcileState(ctx context.Context, instanceID string) (*ReconcileState, error)
+	SaveReconcileState(ctx context.Context, instanceID string, state *ReconcileState) error
+	DeleteReconcileState(ctx context.Context, instanceID string) error
 }
 
 // CatalogEntry represents one object in the catalog, such as a source.
@@ -38,6 +50,11 @@ type CatalogEntry struct {
 	CreatedOn   time.Time
 	UpdatedOn   time.Time
 	RefreshedOn time.Time
+	// ContentHash is a hash of the contents of the artifact file the entry was created from
+	ContentHash string
+	// Artifact is the contents of the artifact file the entry was created from (before variables were resolved).
+	// It's recorded with the entry's versions, so that they can be restored as they were written.
+	Artifact string
 }
 
 func (e *CatalogEntry) GetTable() *runtimev1.Table {
@@ -71,3 +88,44 @@ func (e *CatalogEntry) GetMetricsView() *runtimev1.MetricsView {
 	}
 	return obj
 }
+
+// CatalogOperation represents the kinds of changes to catalog entries.
+type CatalogOperation int
+
+const (
+	CatalogOperationUnspecified CatalogOperation = 0
+	CatalogOperationCreate      CatalogOperation = 1
+	CatalogOperationUpdate      CatalogOperation = 2
+	CatalogOperationRename      CatalogOperation = 3
+	CatalogOperationDelete      CatalogOperation = 4
+)
+
+// MaxCatalogEntryVersions is the number of versions a CatalogStore keeps for each entry name.
+// Older versions are deleted when new ones are recorded.
+const MaxCatalogEntryVersions = 100
+
+// CatalogEntryVersion is a snapshot of a catalog entry, recorded when the entry changed.
+type CatalogEntryVersion struct {
+	Version   int64
+	Operation CatalogOperation
+	// Entry is the entry after the change. For deletes, it's the entry that was deleted.
+	Entry *CatalogEntry
+	// FromName is the entry's previous name (only set for renames)
+	FromName  string
+	CreatedOn time.Time
+}
+
+// ReconcileState is the state that the catalog service keeps between reconciles of an instance.
+// It's persisted so that reconciles stay incremental across restarts.
+type ReconcileState struct {
+	// LastMigration is the time of the last reconcile. Artifacts that didn't change since are not re-evaluated.
+	LastMigration time.Time `json:"last_migration"`
+	// Variables are the resolved variables used by the last reconcile
+	Variables map[string]string `json:"variables"`
+	// NameToPath maps the normalized names of the tracked artifacts to their paths
+	NameToPath map[string]string `json:"name_to_path"`
+	// PathToName maps the paths of the tracked artifacts to their normalized names
+	PathToName map[string]string `json:"path_to_name"`
+	// Dependencies maps the normalized names in the DAG to the names they depend on
+	Dependencies map[string][]string `json:"dependencies"`
+}
@@ -40,11 +40,34 @@ func Open(driver, dsn string, logger *zap.Logger) (Connection, error) {
 	return conn, nil
 }
 
+// Drop deletes the data stored for the DSN if the driver supports it (see Dropper).
+// It returns false if the driver doesn't manage any data for the DSN.
+func Drop(driver, dsn string, logger *zap.Logger) (bool, error) {
+	d, ok := Drivers[driver]
+	if !ok {
+		return false, fmt.Errorf("unknown database driver: %s", driver)
+	}
+
+	dropper, ok := d.(Dropper)
+	if !ok {
+		return false, nil
+	}
+
+	return dropper.Drop(dsn, logger)
+}
+
 // Driver represents an underlying DB.
 type Driver interface {
 	Open(dsn string, logger *zap.Logger) (Connection, error)
 }
 
+// Dropper is implemented by drivers that manage the storage of their data (such as an embedded database file).
+type Dropper interface {
+	// Drop deletes all data stored for the DSN and reports whether anything was deleted.
+	// It must only be called when no connections to the DSN are open.
+	Drop(dsn string, logger *zap.Logger) (bool, error)
+}
+
 // Connection represents a connection to an underlying DB.
 // It should implement one or more of RegistryStore, CatalogStore, RepoStore, and OLAPStore.
 type Connection interface {
@@ -5,25 +5,26 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/drivers/driverstest"
 	"github.com/stretchr/testify/require"
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/wait"
-	"go.uber.org/zap"
 
 	_ "github.com/rilldata/rill/runtime/drivers/duckdb"
 	_ "github.com/rilldata/rill/runtime/drivers/file"
+	_ "github.com/rilldata/rill/runtime/drivers/git"
 	_ "github.com/rilldata/rill/runtime/drivers/postgres"
 	_ "github.com/rilldata/rill/runtime/drivers/sqlite"
 )
 
-// TestAll runs sub-tests against all drivers.
+// TestAll runs the driverstest conformance suites against all drivers.
 // This should be the only "real" test in the package. Other tests should be added
-// as subtests of TestAll.
+// to the suites in package driverstest.
 func TestAll(t *testing.T) {
 	var matrix = []func(t *testing.T, fn func(driver string, dsn string)) error{
 		withDuckDB,
 		withFile,
+		withGit,
 		withPostgres,
 		withSQLite,
 		// Druid only tested in driver due to complicated ingestion setup
@@ -31,34 +32,7 @@ func TestAll(t *testing.T) {
 
 	for _, withDriver := range matrix {
 		err := withDriver(t, func(driver string, dsn string) {
-			// Open
-			conn, err := drivers.Open(driver, dsn, zap.NewNop())
-			require.NoError(t, err)
-			require.NotNil(t, conn)
-
-			// Migrate
-			ctx := context.Background()
-			require.NoError(t, conn.Migrate(ctx))
-			current, desired, err := conn.MigrationStatus(ctx)
-			require.NoError(t, err)
-			require.Equal(t, desired, current)
-
-			// Run applicable sub-tests
-			if registry, ok := conn.RegistryStore(); ok {
-				t.Run("registry_"+driver, func(t *testing.T) { testRegistry(t, registry) })
-			}
-			if catalog, ok := conn.CatalogStore(); ok {
-				t.Run("catalog_"+driver, func(t *testing.T) { testCatalog(t, catalog) })
-			}
-			if repo, ok := conn.RepoStore(); ok {
-				t.Run("repo_"+driver, func(t *testing.T) { testRepo(t, repo) })
-			}
-			if olap, ok := conn.OLAPStore(); ok {
-				t.Run("olap_"+driver, func(t *testing.T) { testOLAP(t, olap) })
-			}
-
-			// Close
-			require.NoError(t, conn.Close())
+			t.Run(driver, func(t *testing.T) { driverstest.TestDriver(t, driver, dsn) })
 		})
 		require.NoError(t, err)
 	}
@@ -75,6 +49,12 @@ func withFile(t *testing.T, fn func(driver string, dsn string)) error {
 	return nil
 }
 
+func withGit(t *testing.T, fn func(driver string, dsn string)) error {
+	dsn := t.TempDir()
+	fn("git", dsn)
+	return nil
+}
+
 func withPostgres(t *testing.T, fn func(driver string, dsn string)) error {
 	// Start a Postgres test container
 	ctx := context.Background()
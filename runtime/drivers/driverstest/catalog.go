@@ -0,0 +1,203 @@
+package driverstest
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// TestCatalog tests a CatalogStore. It uses a random instance ID, so it can run against a store that holds other data.
+func TestCatalog(t *testing.T, catalog drivers.CatalogStore) {
+	ctx := context.Background()
+	instanceID := uuid.NewString()
+
+	objs := catalog.FindEntries(ctx, instanceID, drivers.ObjectTypeSource)
+	require.Len(t, objs, 0)
+
+	obj1 := &drivers.CatalogEntry{
+		Name: "bar",
+		Type: drivers.ObjectTypeSource,
+		Path: "sources/bar.yaml",
+		Object: &runtimev1.Source{
+			Name:       "bar",
+			Connector:  "local_file",
+			Properties: &structpb.Struct{Fields: map[string]*structpb.Value{"path": structpb.NewStringValue("/path/to/file.csv")}},
+			Schema:     &runtimev1.StructType{Fields: []*runtimev1.StructType_Field{{Name: "a", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_INT64}}}},
+		},
+	}
+
+	obj2 := &drivers.CatalogEntry{
+		Name: "foo",
+		Type: drivers.ObjectTypeTable,
+		Object: &runtimev1.Table{
+			Name:    "foo",
+			Managed: false,
+			Schema:  &runtimev1.StructType{Fields: []*runtimev1.StructType_Field{{Name: "a", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_INT64}}}},
+		},
+	}
+
+	err := catalog.CreateEntry(ctx, instanceID, obj1)
+	require.NoError(t, err)
+	require.Greater(t, time.Minute, time.Since(obj1.CreatedOn))
+	require.Greater(t, time.Minute, time.Since(obj1.UpdatedOn))
+
+	err = catalog.CreateEntry(ctx, instanceID, obj1)
+	require.Error(t, err)
+	require.True(t, strings.Contains(err.Error(), "duplicate key") || strings.Contains(err.Error(), "UNIQUE constraint failed"))
+
+	// names are unique regardless of case
+	err = catalog.CreateEntry(ctx, instanceID, &drivers.CatalogEntry{Name: "BAR", Type: drivers.ObjectTypeSource, Object: obj1.Object})
+	require.Error(t, err)
+
+	err = catalog.CreateEntry(ctx, instanceID, obj2)
+	require.NoError(t, err)
+
+	objs = catalog.FindEntries(ctx, instanceID, drivers.ObjectTypeSource)
+	require.Len(t, objs, 1)
+	require.Equal(t, objs[0].Name, obj1.Name)
+	require.Equal(t, objs[0].Type, obj1.Type)
+	require.Equal(t, objs[0].Path, obj1.Path)
+	require.True(t, proto.Equal(objs[0].GetSource(), obj1.GetSource()))
+
+	objs = catalog.FindEntries(ctx, instanceID, drivers.ObjectTypeUnspecified)
+	require.Len(t, objs, 2)
+	require.Equal(t, objs[0].Name, obj1.Name)
+	require.Equal(t, objs[0].Type, obj1.Type)
+	require.Equal(t, objs[1].Name, obj2.Name)
+	require.Equal(t, objs[1].Type, obj2.Type)
+
+	obj1.Type = drivers.ObjectTypeMetricsView
+	err = catalog.UpdateEntry(ctx, instanceID, obj1)
+	require.NoError(t, err)
+
+	obj, found := catalog.FindEntry(ctx, instanceID, "bar")
+	require.True(t, found)
+	require.Equal(t, obj.Name, "bar")
+	require.Equal(t, obj.Type, drivers.ObjectTypeMetricsView)
+
+	err = catalog.DeleteEntry(ctx, instanceID, "bar")
+	require.NoError(t, err)
+
+	obj, found = catalog.FindEntry(ctx, instanceID, "bar")
+	require.False(t, found)
+	require.Nil(t, obj)
+
+	// every change is recorded in the entry's history, newest first
+	created, found := catalog.FindEntry(ctx, instanceID, "foo")
+	require.True(t, found)
+	time.Sleep(time.Millisecond)
+	err = catalog.RenameEntry(ctx, instanceID, "foo", &drivers.CatalogEntry{
+		Name:        "foo_renamed",
+		Type:        drivers.ObjectTypeTable,
+		Object:      obj2.Object,
+		ContentHash: "abc",
+		Artifact:    "select {{ .vars.n }}",
+	})
+	require.NoError(t, err)
+	_, found = catalog.FindEntry(ctx, instanceID, "foo")
+	require.False(t, found)
+	obj, found = catalog.FindEntry(ctx, instanceID, "foo_renamed")
+	require.True(t, found)
+	require.Equal(t, "abc", obj.ContentHash)
+	require.Equal(t, "select {{ .vars.n }}", obj.Artifact)
+	require.True(t, created.CreatedOn.Equal(obj.CreatedOn))
+
+	versions, err := catalog.FindEntryVersions(ctx, instanceID, "BAR")
+	require.NoError(t, err)
+	require.Len(t, versions, 3)
+	require.Equal(t, drivers.CatalogOperationDelete, versions[0].Operation)
+	require.Equal(t, drivers.ObjectTypeMetricsView, versions[0].Entry.Type)
+	require.Equal(t, drivers.CatalogOperationUpdate, versions[1].Operation)
+	require.Equal(t, drivers.CatalogOperationCreate, versions[2].Operation)
+	require.Equal(t, obj1.Path, versions[2].Entry.Path)
+	require.True(t, proto.Equal(obj1.GetSource(), versions[2].Entry.GetSource()))
+	require.Greater(t, versions[0].Version, versions[1].Version)
+
+	versions, err = catalog.FindEntryVersions(ctx, instanceID, "foo_renamed")
+	require.NoError(t, err)
+	require.Len(t, versions, 1)
+	require.Equal(t, drivers.CatalogOperationRename, versions[0].Operation)
+	require.Equal(t, "foo", versions[0].FromName)
+	require.Equal(t, "abc", versions[0].Entry.ContentHash)
+	require.Equal(t, "select {{ .vars.n }}", versions[0].Entry.Artifact)
+
+	version, err := catalog.FindEntryVersion(ctx, instanceID, versions[0].Version)
+	require.NoError(t, err)
+	require.Equal(t, "foo_renamed", version.Entry.Name)
+	_, err = catalog.FindEntryVersion(ctx, instanceID, versions[0].Version+1000)
+	require.ErrorIs(t, err, drivers.ErrNotFound)
+
+	// renames can change only the case of a name, or keep the name
+	obj.Name = "Foo_Renamed"
+	err = catalog.RenameEntry(ctx, instanceID, "foo_renamed", obj)
+	require.NoError(t, err)
+	obj, found = catalog.FindEntry(ctx, instanceID, "foo_renamed")
+	require.True(t, found)
+	require.Equal(t, "Foo_Renamed", obj.Name)
+	require.True(t, created.CreatedOn.Equal(obj.CreatedOn))
+	obj.Path = "models/Foo_Renamed.sql"
+	err = catalog.RenameEntry(ctx, instanceID, "Foo_Renamed", obj)
+	require.NoError(t, err)
+	obj, found = catalog.FindEntry(ctx, instanceID, "foo_renamed")
+	require.True(t, found)
+	require.Equal(t, "models/Foo_Renamed.sql", obj.Path)
+	require.True(t, created.CreatedOn.Equal(obj.CreatedOn))
+	require.Len(t, catalog.FindEntries(ctx, instanceID, drivers.ObjectTypeTable), 1)
+
+	// only the latest versions of a name are kept
+	for i := 0; i < drivers.MaxCatalogEntryVersions+5; i++ {
+		err = catalog.UpdateEntry(ctx, instanceID, obj)
+		require.NoError(t, err)
+	}
+	versions, err = catalog.FindEntryVersions(ctx, instanceID, "foo_renamed")
+	require.NoError(t, err)
+	require.Len(t, versions, drivers.MaxCatalogEntryVersions)
+	require.Equal(t, drivers.CatalogOperationUpdate, versions[len(versions)-1].Operation)
+
+	err = catalog.DeleteEntryVersions(ctx, instanceID)
+	require.NoError(t, err)
+	versions, err = catalog.FindEntryVersions(ctx, instanceID, "foo")
+	require.NoError(t, err)
+	require.Len(t, versions, 0)
+
+	_, err = catalog.FindReconcileState(ctx, instanceID)
+	require.ErrorIs(t, err, drivers.ErrNotFound)
+
+	state := &drivers.ReconcileState{
+		LastMigration: time.Now().Round(0),
+		Variables:     map[string]string{"env": "dev"},
+		NameToPath:    map[string]string{"bar": "sources/bar.yaml"},
+		PathToName:    map[string]string{"sources/bar.yaml": "bar"},
+		Dependencies:  map[string][]string{"bar": nil, "baz": {"bar"}},
+	}
+	err = catalog.SaveReconcileState(ctx, instanceID, state)
+	require.NoError(t, err)
+
+	saved, err := catalog.FindReconcileState(ctx, instanceID)
+	require.NoError(t, err)
+	require.True(t, state.LastMigration.Equal(saved.LastMigration))
+	require.Equal(t, state.Variables, saved.Variables)
+	require.Equal(t, state.NameToPath, saved.NameToPath)
+	require.Equal(t, state.PathToName, saved.PathToName)
+	require.Equal(t, state.Dependencies, saved.Dependencies)
+
+	state.NameToPath = map[string]string{}
+	err = catalog.SaveReconcileState(ctx, instanceID, state)
+	require.NoError(t, err)
+	saved, err = catalog.FindReconcileState(ctx, instanceID)
+	require.NoError(t, err)
+	require.Empty(t, saved.NameToPath)
+
+	err = catalog.DeleteReconcileState(ctx, instanceID)
+	require.NoError(t, err)
+	_, err = catalog.FindReconcileState(ctx, instanceID)
+	require.ErrorIs(t, err, drivers.ErrNotFound)
+}
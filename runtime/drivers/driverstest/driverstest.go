@@ -0,0 +1,61 @@
+// Package driverstest contains conformance tests for implementations of the interfaces in package drivers.
+// Drivers (including third-party drivers) can prove compliance by calling TestDriver from a regular Go test:
+//
+//	func TestConformance(t *testing.T) {
+//		driverstest.TestDriver(t, "mydriver", "mydsn")
+//	}
+//
+// The suites for individual stores (such as TestOLAP) can also be called directly.
+package driverstest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// TestDriver opens and migrates a connection to the registered driver, runs TestConnection against it and closes it.
+func TestDriver(t *testing.T, driver, dsn string) {
+	// Open
+	conn, err := drivers.Open(driver, dsn, zap.NewNop())
+	require.NoError(t, err)
+	require.NotNil(t, conn)
+
+	// Migrate
+	ctx := context.Background()
+	require.NoError(t, conn.Migrate(ctx))
+	current, desired, err := conn.MigrationStatus(ctx)
+	require.NoError(t, err)
+	require.Equal(t, desired, current)
+
+	TestConnection(t, conn)
+
+	// Close
+	require.NoError(t, conn.Close())
+}
+
+// TestConnection runs the suites for every store the connection implements as subtests.
+// The connection must be migrated.
+func TestConnection(t *testing.T, conn drivers.Connection) {
+	if registry, ok := conn.RegistryStore(); ok {
+		t.Run("registry", func(t *testing.T) { TestRegistry(t, registry) })
+	}
+	if catalog, ok := conn.CatalogStore(); ok {
+		t.Run("catalog", func(t *testing.T) { TestCatalog(t, catalog) })
+	}
+	if repo, ok := conn.RepoStore(); ok {
+		t.Run("repo", func(t *testing.T) { TestRepo(t, repo) })
+		if history, ok := repo.(drivers.RepoHistoryStore); ok {
+			t.Run("repo_history", func(t *testing.T) { TestRepoHistory(t, repo, history) })
+		}
+		if watcher, ok := repo.(drivers.RepoWatcher); ok {
+			t.Run("repo_watch", func(t *testing.T) { TestRepoWatch(t, repo, watcher) })
+		}
+	}
+	if olap, ok := conn.OLAPStore(); ok {
+		t.Run("olap", func(t *testing.T) { TestOLAP(t, olap) })
+	}
+}
@@ -0,0 +1,348 @@
+package driverstest
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow/go/v9/arrow"
+	"github.com/apache/arrow/go/v9/arrow/array"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+)
+
+// TestOLAP tests an OLAPStore. It creates and drops tables and views prefixed with "driverstest_",
+// so the store must support CREATE TABLE, CREATE TEMPORARY TABLE, CREATE VIEW and DROP statements through Exec.
+func TestOLAP(t *testing.T, olap drivers.OLAPStore) {
+	t.Run("types", func(t *testing.T) { testOLAPTypes(t, olap) })
+	t.Run("arrow", func(t *testing.T) { testOLAPArrow(t, olap) })
+	t.Run("dry_run", func(t *testing.T) { testOLAPDryRun(t, olap) })
+	t.Run("with_connection", func(t *testing.T) { testOLAPWithConnection(t, olap) })
+	t.Run("cancel", func(t *testing.T) { testOLAPCancel(t, olap) })
+	t.Run("information_schema", func(t *testing.T) { testOLAPInformationSchema(t, olap) })
+}
+
+// TestOLAPScheduling tests that an OLAPStore runs queued statements in priority order and drops cancelled statements from the queue.
+// It must be called with a store that runs only one OLAP statement at a time.
+// The queued func must return the number of statements that are waiting for a connection.
+func TestOLAPScheduling(t *testing.T, olap drivers.OLAPStore, queued func() int) {
+	ctx := context.Background()
+	n := 10
+
+	var mu sync.Mutex
+	var order []int
+	var wg sync.WaitGroup
+	var cancelErr error
+
+	// Queue statements while the only connection is held
+	err := olap.WithConnection(ctx, 0, func(wrappedCtx, ensuredCtx context.Context) error {
+		for i := 1; i <= n; i++ {
+			priority := i
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				res, err := olap.Execute(ctx, &drivers.Statement{Query: "SELECT 1", Priority: priority})
+				if err != nil {
+					t.Errorf("execute with priority %d: %v", priority, err)
+					return
+				}
+				mu.Lock()
+				order = append(order, priority)
+				mu.Unlock()
+				_ = res.Close()
+			}()
+			waitQueued(t, queued, i)
+		}
+
+		// The highest priority statement is cancelled before the connection is released
+		cctx, cancel := context.WithCancel(ctx)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := olap.Execute(cctx, &drivers.Statement{Query: "SELECT 1", Priority: n + 1})
+			if err == nil {
+				_ = res.Close()
+			}
+			cancelErr = err
+		}()
+		waitQueued(t, queued, n+1)
+		cancel()
+		waitQueued(t, queued, n)
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	wg.Wait()
+	require.Error(t, cancelErr)
+	require.Len(t, order, n)
+	for i, priority := range order {
+		require.Equal(t, n-i, priority)
+	}
+}
+
+// waitQueued waits until n statements are waiting for a connection.
+func waitQueued(t *testing.T, queued func() int, n int) {
+	require.Eventually(t, func() bool { return queued() == n }, 5*time.Second, time.Millisecond)
+}
+
+func testOLAPTypes(t *testing.T, olap drivers.OLAPStore) {
+	ctx := context.Background()
+
+	res, err := olap.Execute(ctx, &drivers.Statement{
+		Query: `SELECT
+			CAST(TRUE AS BOOLEAN) AS b,
+			CAST(1 AS INTEGER) AS i,
+			CAST(2 AS BIGINT) AS l,
+			CAST(1.5 AS DOUBLE) AS d,
+			CAST('hello' AS VARCHAR) AS s,
+			CAST('2023-01-02' AS DATE) AS dt,
+			CAST('2023-01-02 03:04:05' AS TIMESTAMP) AS ts`,
+	})
+	require.NoError(t, err)
+	defer res.Close()
+
+	expected := []struct {
+		name string
+		code runtimev1.Type_Code
+	}{
+		{"b", runtimev1.Type_CODE_BOOL},
+		{"i", runtimev1.Type_CODE_INT32},
+		{"l", runtimev1.Type_CODE_INT64},
+		{"d", runtimev1.Type_CODE_FLOAT64},
+		{"s", runtimev1.Type_CODE_STRING},
+		{"dt", runtimev1.Type_CODE_DATE},
+		{"ts", runtimev1.Type_CODE_TIMESTAMP},
+	}
+	require.Len(t, res.Schema.Fields, len(expected))
+	for i, e := range expected {
+		require.Equal(t, e.name, res.Schema.Fields[i].Name)
+		require.Equal(t, e.code, res.Schema.Fields[i].Type.Code, "type of %q", e.name)
+	}
+
+	var b bool
+	var i int32
+	var l int64
+	var d float64
+	var s string
+	var dt, ts time.Time
+	require.True(t, res.Next())
+	require.NoError(t, res.Scan(&b, &i, &l, &d, &s, &dt, &ts))
+	require.Equal(t, true, b)
+	require.Equal(t, int32(1), i)
+	require.Equal(t, int64(2), l)
+	require.Equal(t, 1.5, d)
+	require.Equal(t, "hello", s)
+	require.Equal(t, time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC), dt.UTC())
+	require.Equal(t, time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC), ts.UTC())
+	require.False(t, res.Next())
+	require.NoError(t, res.Err())
+
+	// NULLs scan into pointers
+	res2, err := olap.Execute(ctx, &drivers.Statement{Query: "SELECT CAST(NULL AS INTEGER) AS n"})
+	require.NoError(t, err)
+	defer res2.Close()
+	require.True(t, res2.Schema.Fields[0].Type.Nullable)
+	var n *int32
+	require.True(t, res2.Next())
+	require.NoError(t, res2.Scan(&n))
+	require.Nil(t, n)
+}
+
+func testOLAPArrow(t *testing.T, olap drivers.OLAPStore) {
+	ctx := context.Background()
+
+	rdr, err := olap.ExecuteArrow(ctx, &drivers.Statement{
+		Query: "SELECT 1::INTEGER AS a, 'hello' AS b, NULL::DOUBLE AS c",
+	})
+	require.NoError(t, err)
+	defer rdr.Release()
+
+	schema := rdr.Schema()
+	require.Equal(t, 3, len(schema.Fields()))
+	require.Equal(t, "a", schema.Field(0).Name)
+	require.Equal(t, arrow.INT32, schema.Field(0).Type.ID())
+	require.Equal(t, arrow.STRING, schema.Field(1).Type.ID())
+	require.Equal(t, arrow.FLOAT64, schema.Field(2).Type.ID())
+
+	require.True(t, rdr.Next())
+	rec := rdr.Record()
+	require.Equal(t, int64(1), rec.NumRows())
+	require.Equal(t, int32(1), rec.Column(0).(*array.Int32).Value(0))
+	require.Equal(t, "hello", rec.Column(1).(*array.String).Value(0))
+	require.True(t, rec.Column(2).IsNull(0))
+
+	require.False(t, rdr.Next())
+	require.NoError(t, rdr.Err())
+
+	// dry runs only return the schema
+	dry, err := olap.ExecuteArrow(ctx, &drivers.Statement{
+		Query:  "SELECT 1::INTEGER AS a",
+		DryRun: true,
+	})
+	require.NoError(t, err)
+	defer dry.Release()
+	require.Equal(t, 1, len(dry.Schema().Fields()))
+	require.False(t, dry.Next())
+	require.NoError(t, dry.Err())
+}
+
+func testOLAPDryRun(t *testing.T, olap drivers.OLAPStore) {
+	ctx := context.Background()
+
+	res, err := olap.Execute(ctx, &drivers.Statement{
+		Query:  "SELECT CAST(1 AS INTEGER) AS a, CAST('hello' AS VARCHAR) AS b",
+		DryRun: true,
+	})
+	require.NoError(t, err)
+	require.Nil(t, res.Rows)
+	require.Len(t, res.Schema.Fields, 2)
+	require.Equal(t, "a", res.Schema.Fields[0].Name)
+	require.Equal(t, runtimev1.Type_CODE_INT32, res.Schema.Fields[0].Type.Code)
+	require.Equal(t, "b", res.Schema.Fields[1].Name)
+	require.Equal(t, runtimev1.Type_CODE_STRING, res.Schema.Fields[1].Type.Code)
+	require.False(t, res.Next())
+	require.NoError(t, res.Err())
+	require.NoError(t, res.Close())
+
+	// dry runs don't have side effects
+	err = olap.Exec(ctx, &drivers.Statement{Query: "CREATE TABLE driverstest_dry AS SELECT 1 AS a", DryRun: true})
+	require.NoError(t, err)
+	_, err = olap.InformationSchema().Lookup(ctx, "driverstest_dry")
+	require.ErrorIs(t, err, drivers.ErrNotFound)
+
+	// invalid queries fail
+	_, err = olap.Execute(ctx, &drivers.Statement{Query: "SELECT driverstest_missing FROM driverstest_missing", DryRun: true})
+	require.Error(t, err)
+}
+
+func testOLAPWithConnection(t *testing.T, olap drivers.OLAPStore) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	err := olap.WithConnection(ctx, 1, func(wrappedCtx, ensuredCtx context.Context) error {
+		// Temporary tables are only visible to the connection that created them
+		err := olap.Exec(wrappedCtx, &drivers.Statement{Query: "CREATE TEMPORARY TABLE driverstest_temp AS SELECT 1 AS a"})
+		require.NoError(t, err)
+
+		res, err := olap.Execute(ensuredCtx, &drivers.Statement{Query: "SELECT a FROM driverstest_temp"})
+		require.NoError(t, err)
+		var a int
+		require.True(t, res.Next())
+		require.NoError(t, res.Scan(&a))
+		require.Equal(t, 1, a)
+		require.NoError(t, res.Close())
+
+		// Cancelling the input context cancels wrappedCtx, but not ensuredCtx
+		cancel()
+		_, err = olap.Execute(wrappedCtx, &drivers.Statement{Query: "SELECT a FROM driverstest_temp"})
+		require.Error(t, err)
+
+		return olap.Exec(ensuredCtx, &drivers.Statement{Query: "DROP TABLE driverstest_temp"})
+	})
+	require.NoError(t, err)
+}
+
+func testOLAPCancel(t *testing.T, olap drivers.OLAPStore) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := olap.Execute(ctx, &drivers.Statement{Query: "SELECT 1"})
+	require.ErrorIs(t, err, context.Canceled)
+
+	err = olap.WithConnection(ctx, 1, func(wrappedCtx, ensuredCtx context.Context) error {
+		return nil
+	})
+	require.ErrorIs(t, err, context.Canceled)
+
+	// timeouts are enforced for queued and running statements
+	_, err = olap.Execute(context.Background(), &drivers.Statement{Query: "SELECT 1", Timeout: time.Nanosecond})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// the store is still usable
+	res, err := olap.Execute(context.Background(), &drivers.Statement{Query: "SELECT 1"})
+	require.NoError(t, err)
+	require.NoError(t, res.Close())
+}
+
+func testOLAPInformationSchema(t *testing.T, olap drivers.OLAPStore) {
+	ctx := context.Background()
+	mixedCase := `driverstest Mixed.Case`
+
+	stmts := []string{
+		"CREATE TABLE driverstest_table (a INTEGER NOT NULL, b VARCHAR)",
+		"CREATE VIEW driverstest_view AS SELECT b FROM driverstest_table",
+		`CREATE TABLE "driverstest Mixed.Case" (c BOOLEAN)`,
+	}
+	for _, stmt := range stmts {
+		require.NoError(t, olap.Exec(ctx, &drivers.Statement{Query: stmt}))
+	}
+	t.Cleanup(func() {
+		_ = olap.Exec(ctx, &drivers.Statement{Query: "DROP VIEW driverstest_view"})
+		_ = olap.Exec(ctx, &drivers.Statement{Query: "DROP TABLE driverstest_table"})
+		_ = olap.Exec(ctx, &drivers.Statement{Query: `DROP TABLE "driverstest Mixed.Case"`})
+	})
+
+	is := olap.InformationSchema()
+
+	// All includes tables and views
+	tables, err := is.All(ctx)
+	require.NoError(t, err)
+	found := make(map[string]*drivers.Table)
+	for _, tbl := range tables {
+		found[tbl.Name] = tbl
+	}
+	require.Contains(t, found, "driverstest_table")
+	require.Contains(t, found, "driverstest_view")
+	require.Contains(t, found, mixedCase)
+
+	tbl := found["driverstest_table"]
+	require.False(t, tbl.View)
+	require.True(t, tbl.IsDefaultDatabase)
+	require.True(t, tbl.IsDefaultDatabaseSchema)
+	require.Equal(t, "driverstest_table", tbl.QualifiedName())
+	require.Len(t, tbl.Schema.Fields, 2)
+	require.Equal(t, "a", tbl.Schema.Fields[0].Name)
+	require.Equal(t, runtimev1.Type_CODE_INT32, tbl.Schema.Fields[0].Type.Code)
+	require.Equal(t, "b", tbl.Schema.Fields[1].Name)
+	require.Equal(t, runtimev1.Type_CODE_STRING, tbl.Schema.Fields[1].Type.Code)
+	require.True(t, tbl.Schema.Fields[1].Type.Nullable)
+
+	view := found["driverstest_view"]
+	require.True(t, view.View)
+	require.Len(t, view.Schema.Fields, 1)
+	require.Equal(t, "b", view.Schema.Fields[0].Name)
+
+	// Lookup accepts plain, schema-qualified and quoted names
+	lookup := func(name string) *drivers.Table {
+		tbl, err := is.Lookup(ctx, name)
+		require.NoError(t, err, "lookup %q", name)
+		return tbl
+	}
+	require.Equal(t, "driverstest_table", lookup("driverstest_table").Name)
+	require.Equal(t, "driverstest_table", lookup(`"driverstest_table"`).Name)
+	require.Equal(t, "driverstest_table", lookup(drivers.FormatTableName("", tbl.DatabaseSchema, "driverstest_table")).Name)
+	require.Equal(t, "driverstest_view", lookup("driverstest_view").Name)
+
+	mixed := lookup(drivers.FormatTableName("", "", mixedCase))
+	require.Equal(t, mixedCase, mixed.Name)
+	// Tables in the default database and schema are addressed by their plain name, even if it needs quoting in SQL
+	require.Equal(t, mixedCase, mixed.QualifiedName())
+	require.Equal(t, mixedCase, lookup(drivers.FormatTableName("", mixed.DatabaseSchema, mixedCase)).Name)
+
+	// Missing tables and invalid names are not found
+	for _, name := range []string{
+		"driverstest_missing",
+		"driverstest_missing.driverstest_table",
+		"DRIVERSTEST Mixed.Case",
+		"driverstest Mixed.Case",
+		"a.b.c.driverstest_table",
+		`"driverstest_table`,
+		"",
+	} {
+		_, err := is.Lookup(ctx, name)
+		require.ErrorIs(t, err, drivers.ErrNotFound, "lookup %q", name)
+	}
+}
@@ -1,4 +1,4 @@
-package drivers_test
+package driverstest
 
 import (
 	"context"
@@ -10,14 +10,19 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func testRegistry(t *testing.T, reg drivers.RegistryStore) {
+// TestRegistry tests a RegistryStore. The store must not contain any instances.
+func TestRegistry(t *testing.T, reg drivers.RegistryStore) {
 	ctx := context.Background()
 	inst := &drivers.Instance{
-		OLAPDriver:   "duckdb",
-		OLAPDSN:      ":memory:",
-		RepoDriver:   "file",
-		RepoDSN:      ".",
-		EmbedCatalog: true,
+		OLAPDriver:     "duckdb",
+		OLAPDSN:        ":memory:",
+		RepoDriver:     "file",
+		RepoDSN:        ".",
+		EmbedCatalog:   true,
+		QueryTimeout:   30 * time.Second,
+		QueryMaxRows:   1000,
+		QueryMaxMemory: 1 << 30,
+		Variables:      map[string]string{"bucket": "dev"},
 	}
 
 	err := reg.CreateInstance(ctx, inst)
@@ -39,6 +44,30 @@ func testRegistry(t *testing.T, reg drivers.RegistryStore) {
 	require.Equal(t, inst.RepoDriver, res.RepoDriver)
 	require.Equal(t, inst.RepoDSN, res.RepoDSN)
 	require.Equal(t, inst.EmbedCatalog, res.EmbedCatalog)
+	require.Equal(t, inst.QueryTimeout, res.QueryTimeout)
+	require.Equal(t, inst.QueryMaxRows, res.QueryMaxRows)
+	require.Equal(t, inst.QueryMaxMemory, res.QueryMaxMemory)
+	require.Equal(t, inst.Variables, res.Variables)
+
+	updatedOn := res.UpdatedOn
+	res.OLAPDSN = "stage.db"
+	res.EmbedCatalog = false
+	res.QueryMaxRows = 10
+	res.Variables = map[string]string{"bucket": "prod", "year": "2022"}
+	err = reg.EditInstance(ctx, res)
+	require.NoError(t, err)
+	require.True(t, res.UpdatedOn.After(updatedOn))
+
+	res, err = reg.FindInstance(ctx, inst.ID)
+	require.NoError(t, err)
+	require.Equal(t, "stage.db", res.OLAPDSN)
+	require.Equal(t, false, res.EmbedCatalog)
+	require.Equal(t, int64(10), res.QueryMaxRows)
+	require.Equal(t, map[string]string{"bucket": "prod", "year": "2022"}, res.Variables)
+	require.Equal(t, inst.RepoDSN, res.RepoDSN)
+
+	err = reg.EditInstance(ctx, &drivers.Instance{ID: uuid.NewString()})
+	require.ErrorIs(t, err, drivers.ErrNotFound)
 
 	err = reg.CreateInstance(ctx, &drivers.Instance{OLAPDriver: "druid"})
 	require.NoError(t, err)
@@ -0,0 +1,264 @@
+package driverstest
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRepo tests a RepoStore. It uses a random instance ID, so it can run against a store that holds other data.
+func TestRepo(t *testing.T, repo drivers.RepoStore) {
+	ctx := context.Background()
+	instID := uuid.NewString()
+
+	paths, _, err := repo.ListRecursive(ctx, instID, "**", 0, "")
+	require.NoError(t, err)
+	require.Len(t, paths, 0)
+
+	err = repo.Put(ctx, instID, "foo.sql", strings.NewReader("hello world"), drivers.PutOptions{Create: true})
+	require.NoError(t, err)
+	err = repo.Put(ctx, instID, "/nested/bar.sql", strings.NewReader("hello world"), drivers.PutOptions{Create: true})
+	require.NoError(t, err)
+
+	paths, _, err = repo.ListRecursive(ctx, instID, "/**", 0, "")
+	require.NoError(t, err)
+	require.Equal(t, []string{"/foo.sql", "/nested/bar.sql"}, paths)
+
+	paths, _, err = repo.ListRecursive(ctx, instID, "./**", 0, "")
+	require.NoError(t, err)
+	require.Equal(t, []string{"/foo.sql", "/nested/bar.sql"}, paths)
+
+	paths, _, err = repo.ListRecursive(ctx, instID, "/nested/**", 0, "")
+	require.NoError(t, err)
+	require.Equal(t, []string{"/nested/bar.sql"}, paths)
+
+	err = repo.Delete(ctx, instID, "nested/bar.sql")
+	require.NoError(t, err)
+
+	paths, _, err = repo.ListRecursive(ctx, instID, "**", 0, "")
+	require.NoError(t, err)
+	require.Equal(t, []string{"/foo.sql"}, paths)
+
+	_, err = repo.Get(ctx, instID, "nested/bar.sql")
+	require.Error(t, err)
+
+	blob, err := repo.Get(ctx, instID, "foo.sql")
+	require.NoError(t, err)
+	require.Equal(t, "hello world", blob)
+
+	err = repo.Put(ctx, instID, "foo.sql", strings.NewReader("bar bar bar"), drivers.PutOptions{Create: true})
+	require.NoError(t, err)
+
+	blob, err = repo.Get(ctx, instID, "foo.sql")
+	require.NoError(t, err)
+	require.Equal(t, "bar bar bar", blob)
+
+	paths, _, err = repo.ListRecursive(ctx, instID, "**", 0, "")
+	require.NoError(t, err)
+	require.Equal(t, []string{"/foo.sql"}, paths)
+
+	err = repo.Put(ctx, instID, "foo.yml", strings.NewReader("foo foo"), drivers.PutOptions{Create: true})
+	require.NoError(t, err)
+	err = repo.Put(ctx, instID, "foo.csv", strings.NewReader("foo foo"), drivers.PutOptions{Create: true})
+	require.NoError(t, err)
+
+	paths, _, err = repo.ListRecursive(ctx, instID, "**/*.{sql,yaml,yml}", 0, "")
+	require.NoError(t, err)
+	require.Equal(t, []string{"/foo.sql", "/foo.yml"}, paths)
+
+	// renaming to existing throws error
+	err = repo.Rename(ctx, instID, "foo.yml", "foo.sql")
+	require.ErrorIs(t, err, drivers.ErrFileAlreadyExists)
+	paths, _, err = repo.ListRecursive(ctx, instID, "**/*.{sql,yaml,yml}", 0, "")
+	require.NoError(t, err)
+	require.Equal(t, []string{"/foo.sql", "/foo.yml"}, paths)
+
+	// rename to existing with different case
+	err = repo.Rename(ctx, instID, "foo.sql", "FOO.sql")
+	require.NoError(t, err)
+	paths, _, err = repo.ListRecursive(ctx, instID, "**/*.{sql,yaml,yml}", 0, "")
+	require.NoError(t, err)
+	require.Equal(t, []string{"/FOO.sql", "/foo.yml"}, paths)
+
+	// valid rename
+	err = repo.Rename(ctx, instID, "foo.yml", "foo_new.yml")
+	require.NoError(t, err)
+	paths, _, err = repo.ListRecursive(ctx, instID, "**/*.{sql,yaml,yml}", 0, "")
+	require.NoError(t, err)
+	require.Equal(t, []string{"/FOO.sql", "/foo_new.yml"}, paths)
+
+	// create preconditions
+	err = repo.Put(ctx, instID, "not_found.sql", strings.NewReader("foo"), drivers.PutOptions{})
+	require.ErrorIs(t, err, drivers.ErrNotFound)
+	err = repo.Put(ctx, instID, "FOO.sql", strings.NewReader("foo"), drivers.PutOptions{Create: true, CreateOnly: true})
+	require.ErrorIs(t, err, drivers.ErrFileAlreadyExists)
+	err = repo.Put(ctx, instID, "create_only.sql", strings.NewReader("foo"), drivers.PutOptions{Create: true, CreateOnly: true})
+	require.NoError(t, err)
+
+	// version preconditions
+	stat, err := repo.Stat(ctx, instID, "FOO.sql")
+	require.NoError(t, err)
+	require.NotEmpty(t, stat.Version)
+	err = repo.Put(ctx, instID, "FOO.sql", strings.NewReader("edit 1"), drivers.PutOptions{ExpectedVersion: stat.Version})
+	require.NoError(t, err)
+	err = repo.Put(ctx, instID, "FOO.sql", strings.NewReader("edit 2"), drivers.PutOptions{ExpectedVersion: stat.Version})
+	require.ErrorIs(t, err, drivers.ErrVersionConflict)
+	blob, err = repo.Get(ctx, instID, "FOO.sql")
+	require.NoError(t, err)
+	require.Equal(t, "edit 1", blob)
+
+	newStat, err := repo.Stat(ctx, instID, "FOO.sql")
+	require.NoError(t, err)
+	require.NotEqual(t, stat.Version, newStat.Version)
+	err = repo.Put(ctx, instID, "FOO.sql", strings.NewReader("edit 2"), drivers.PutOptions{ExpectedVersion: newStat.Version})
+	require.NoError(t, err)
+
+	// pagination
+	paths, next, err := repo.ListRecursive(ctx, instID, "**/*.{sql,yaml,yml}", 2, "")
+	require.NoError(t, err)
+	require.Equal(t, []string{"/FOO.sql", "/create_only.sql"}, paths)
+	require.NotEmpty(t, next)
+	paths, next, err = repo.ListRecursive(ctx, instID, "**/*.{sql,yaml,yml}", 2, next)
+	require.NoError(t, err)
+	require.Equal(t, []string{"/foo_new.yml"}, paths)
+	require.Empty(t, next)
+
+	// pages follow the path order when a directory's name is a prefix of a file's name
+	err = repo.Put(ctx, instID, "create_only/nested.sql", strings.NewReader("foo"), drivers.PutOptions{Create: true})
+	require.NoError(t, err)
+	paths, next, err = repo.ListRecursive(ctx, instID, "**/*.{sql,yaml,yml}", 2, "")
+	require.NoError(t, err)
+	require.Equal(t, []string{"/FOO.sql", "/create_only.sql"}, paths)
+	paths, next, err = repo.ListRecursive(ctx, instID, "**/*.{sql,yaml,yml}", 2, next)
+	require.NoError(t, err)
+	require.Equal(t, []string{"/create_only/nested.sql", "/foo_new.yml"}, paths)
+	require.Empty(t, next)
+	err = repo.Delete(ctx, instID, "create_only/nested.sql")
+	require.NoError(t, err)
+
+	// ignore rules
+	err = repo.Put(ctx, instID, "data/big.csv", strings.NewReader("a,b"), drivers.PutOptions{Create: true})
+	require.NoError(t, err)
+	err = repo.Put(ctx, instID, "data/nested/big.sql", strings.NewReader("foo"), drivers.PutOptions{Create: true})
+	require.NoError(t, err)
+	err = repo.Put(ctx, instID, ".rillignore", strings.NewReader("# large files\ndata/\n*.csv\n"), drivers.PutOptions{Create: true})
+	require.NoError(t, err)
+	paths, _, err = repo.ListRecursive(ctx, instID, "**", 0, "")
+	require.NoError(t, err)
+	require.Equal(t, []string{"/.rillignore", "/FOO.sql", "/create_only.sql", "/foo_new.yml"}, paths)
+}
+
+// TestRepoHistory tests a RepoStore that also implements RepoHistoryStore.
+func TestRepoHistory(t *testing.T, repo drivers.RepoStore, history drivers.RepoHistoryStore) {
+	ctx := context.Background()
+	instID := uuid.NewString()
+
+	_, err := history.ListRevisions(ctx, instID, "history.sql")
+	require.ErrorIs(t, err, drivers.ErrNotFound)
+
+	err = repo.Put(ctx, instID, "history.sql", strings.NewReader("v1"), drivers.PutOptions{Create: true})
+	require.NoError(t, err)
+	err = repo.Put(ctx, instID, "history.sql", strings.NewReader("v2"), drivers.PutOptions{Create: true})
+	require.NoError(t, err)
+
+	// writing unchanged contents doesn't create a revision
+	err = repo.Put(ctx, instID, "history.sql", strings.NewReader("v2"), drivers.PutOptions{Create: true})
+	require.NoError(t, err)
+
+	revs, err := history.ListRevisions(ctx, instID, "history.sql")
+	require.NoError(t, err)
+	require.Len(t, revs, 2)
+	require.NotEmpty(t, revs[0].AuthorName)
+	require.False(t, revs[0].CreatedOn.IsZero())
+
+	// revisions are ordered from newest to oldest
+	blob, rev, err := history.GetRevision(ctx, instID, "history.sql", revs[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, "v2", blob)
+	require.Equal(t, revs[0].ID, rev.ID)
+	blob, _, err = history.GetRevision(ctx, instID, "history.sql", revs[1].ID)
+	require.NoError(t, err)
+	require.Equal(t, "v1", blob)
+
+	_, _, err = history.GetRevision(ctx, instID, "not_found.sql", revs[0].ID)
+	require.ErrorIs(t, err, drivers.ErrNotFound)
+
+	err = history.Revert(ctx, instID, "history.sql", revs[1].ID)
+	require.NoError(t, err)
+	blob, err = repo.Get(ctx, instID, "history.sql")
+	require.NoError(t, err)
+	require.Equal(t, "v1", blob)
+
+	revs, err = history.ListRevisions(ctx, instID, "history.sql")
+	require.NoError(t, err)
+	require.Len(t, revs, 3)
+
+	// deletes and renames are tracked
+	err = repo.Rename(ctx, instID, "history.sql", "history_new.sql")
+	require.NoError(t, err)
+	revs, err = history.ListRevisions(ctx, instID, "history_new.sql")
+	require.NoError(t, err)
+	require.Len(t, revs, 1)
+
+	err = repo.Delete(ctx, instID, "history_new.sql")
+	require.NoError(t, err)
+	revs, err = history.ListRevisions(ctx, instID, "history_new.sql")
+	require.NoError(t, err)
+	require.Len(t, revs, 2)
+
+	_, _, err = history.GetRevision(ctx, instID, "history_new.sql", revs[0].ID)
+	require.ErrorIs(t, err, drivers.ErrNotFound)
+	blob, _, err = history.GetRevision(ctx, instID, "history_new.sql", revs[1].ID)
+	require.NoError(t, err)
+	require.Equal(t, "v1", blob)
+}
+
+// TestRepoWatch tests a RepoStore that also implements RepoWatcher.
+func TestRepoWatch(t *testing.T, repo drivers.RepoStore, watcher drivers.RepoWatcher) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	instID := uuid.NewString()
+
+	err := repo.Put(ctx, instID, "/watch/existing.sql", strings.NewReader("hello world"), drivers.PutOptions{Create: true})
+	require.NoError(t, err)
+
+	changes, err := watcher.Watch(ctx, instID)
+	require.NoError(t, err)
+
+	// changes in quick succession are emitted together
+	err = repo.Put(ctx, instID, "/watch/existing.sql", strings.NewReader("bar bar bar"), drivers.PutOptions{Create: true})
+	require.NoError(t, err)
+	err = repo.Put(ctx, instID, "/watch/nested/new.sql", strings.NewReader("foo foo"), drivers.PutOptions{Create: true})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"/watch/existing.sql", "/watch/nested", "/watch/nested/new.sql"}, nextChanges(t, changes))
+
+	err = repo.Rename(ctx, instID, "/watch/nested/new.sql", "/watch/nested/renamed.sql")
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"/watch/nested/new.sql", "/watch/nested/renamed.sql"}, nextChanges(t, changes))
+
+	err = repo.Delete(ctx, instID, "/watch/existing.sql")
+	require.NoError(t, err)
+	require.Equal(t, []string{"/watch/existing.sql"}, nextChanges(t, changes))
+
+	// the channel is closed when the context is cancelled
+	cancel()
+	require.Eventually(t, func() bool {
+		_, ok := <-changes
+		return !ok
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func nextChanges(t *testing.T, changes <-chan []string) []string {
+	select {
+	case paths := <-changes:
+		return paths
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "timed out waiting for changes")
+		return nil
+	}
+}
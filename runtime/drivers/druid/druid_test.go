@@ -163,6 +163,11 @@ func testSchemaAll(t *testing.T, olap drivers.OLAPStore) {
 
 	require.Equal(t, 1, len(tables))
 	require.Equal(t, testTable, tables[0].Name)
+	require.Equal(t, testTable, tables[0].QualifiedName())
+	require.NotNil(t, tables[0].Stats)
+	require.Greater(t, tables[0].Stats.GetRowCount(), int64(0))
+	require.Greater(t, tables[0].Stats.GetSizeBytes(), int64(0))
+	require.NotNil(t, tables[0].Stats.LastModified)
 
 	require.Equal(t, "__time", tables[0].Schema.Fields[0].Name)
 	require.Equal(t, runtimev1.Type_CODE_TIMESTAMP, tables[0].Schema.Fields[0].Type.Code)
@@ -187,6 +192,10 @@ func testSchemaLookup(t *testing.T, olap drivers.OLAPStore) {
 	require.NoError(t, err)
 	require.Equal(t, testTable, table.Name)
 
+	table, err = olap.InformationSchema().Lookup(ctx, "druid."+testTable)
+	require.NoError(t, err)
+	require.Equal(t, testTable, table.Name)
+
 	_, err = olap.InformationSchema().Lookup(ctx, "foo")
 	require.Equal(t, drivers.ErrNotFound, err)
 }
@@ -2,11 +2,15 @@ package druid
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/connectors"
 	"github.com/rilldata/rill/runtime/drivers"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (c *connection) Dialect() drivers.Dialect {
@@ -36,20 +40,63 @@ func (c *connection) Execute(ctx context.Context, stmt *drivers.Statement) (*dri
 		if err != nil {
 			return nil, err
 		}
-		return nil, prepared.Close()
+		err = prepared.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		// Druid doesn't support DESCRIBE for queries, so we get the schema from an empty result
+		rows, err := c.db.QueryxContext(ctx, fmt.Sprintf("SELECT * FROM (%s) LIMIT 0", stmt.Query), stmt.Args...)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+
+		schema, err := rowsToSchema(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		return &drivers.Result{Schema: schema}, nil
+	}
+
+	// NOTE: stmt.MaxMemory is not supported. Druid limits memory with its own server-side configuration.
+	cancel := func() {}
+	if stmt.Timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, stmt.Timeout)
 	}
 
 	rows, err := c.db.QueryxContext(ctx, stmt.Query, stmt.Args...)
 	if err != nil {
+		cancel()
 		return nil, err
 	}
 
 	schema, err := rowsToSchema(rows)
 	if err != nil {
+		_ = rows.Close()
+		cancel()
 		return nil, err
 	}
 
-	return &drivers.Result{Rows: rows, Schema: schema}, nil
+	res := &drivers.Result{Rows: rows, Schema: schema}
+	res.SetMaxRows(stmt.MaxRows)
+	res.SetCleanupFunc(func() error {
+		cancel()
+		return nil
+	})
+
+	return res, nil
+}
+
+// ExecuteArrow implements drivers.OLAPStore.
+// Druid's SQL API doesn't return Arrow, so we convert from the rows returned by Execute.
+func (c *connection) ExecuteArrow(ctx context.Context, stmt *drivers.Statement) (drivers.RecordReader, error) {
+	res, err := c.Execute(ctx, stmt)
+	if err != nil {
+		return nil, err
+	}
+	return drivers.NewRecordReader(res, drivers.DefaultArrowBatchSize)
 }
 
 func rowsToSchema(r *sqlx.Rows) (*runtimev1.StructType, error) {
@@ -83,6 +130,13 @@ func rowsToSchema(r *sqlx.Rows) (*runtimev1.StructType, error) {
 	return &runtimev1.StructType{Fields: fields}, nil
 }
 
+// Druid has a single catalog, and datasources live in the "druid" schema.
+// Other schemas (like "lookup" and "view") are addressed by qualified names.
+const (
+	defaultDatabase = "druid"
+	defaultSchema   = "druid"
+)
+
 type informationSchema struct {
 	c *connection
 }
@@ -103,7 +157,7 @@ func (i informationSchema) All(ctx context.Context) ([]*drivers.Table, error) {
 			C.IS_NULLABLE = 'YES' AS IS_NULLABLE
 		FROM INFORMATION_SCHEMA.TABLES T 
 		JOIN INFORMATION_SCHEMA.COLUMNS C ON T.TABLE_SCHEMA = C.TABLE_SCHEMA AND T.TABLE_NAME = C.TABLE_NAME
-		WHERE T.TABLE_SCHEMA = 'druid'
+		WHERE T.TABLE_SCHEMA NOT IN ('INFORMATION_SCHEMA', 'sys')
 		ORDER BY DATABASE, SCHEMA, NAME, TABLE_TYPE, C.ORDINAL_POSITION
 	`
 
@@ -118,10 +172,26 @@ func (i informationSchema) All(ctx context.Context) ([]*drivers.Table, error) {
 		return nil, err
 	}
 
+	err = i.addStats(ctx, tables)
+	if err != nil {
+		return nil, err
+	}
+
 	return tables, nil
 }
 
 func (i informationSchema) Lookup(ctx context.Context, name string) (*drivers.Table, error) {
+	database, schema, table, err := drivers.ParseTableName(name)
+	if err != nil {
+		return nil, drivers.ErrNotFound
+	}
+	if database == "" {
+		database = defaultDatabase
+	}
+	if schema == "" {
+		schema = defaultSchema
+	}
+
 	q := `
 		SELECT
 			T.TABLE_CATALOG AS DATABASE,
@@ -133,11 +203,11 @@ func (i informationSchema) Lookup(ctx context.Context, name string) (*drivers.Ta
 			C.IS_NULLABLE = 'YES' AS IS_NULLABLE
 		FROM INFORMATION_SCHEMA.TABLES T 
 		JOIN INFORMATION_SCHEMA.COLUMNS C ON T.TABLE_SCHEMA = C.TABLE_SCHEMA AND T.TABLE_NAME = C.TABLE_NAME
-		WHERE T.TABLE_SCHEMA = 'druid' AND T.TABLE_NAME = ?
+		WHERE T.TABLE_CATALOG = ? AND T.TABLE_SCHEMA = ? AND T.TABLE_NAME = ?
 		ORDER BY DATABASE, SCHEMA, NAME, TABLE_TYPE, C.ORDINAL_POSITION
 	`
 
-	rows, err := i.c.db.QueryxContext(ctx, q, name)
+	rows, err := i.c.db.QueryxContext(ctx, q, database, schema, table)
 	if err != nil {
 		return nil, err
 	}
@@ -152,9 +222,74 @@ func (i informationSchema) Lookup(ctx context.Context, name string) (*drivers.Ta
 		return nil, drivers.ErrNotFound
 	}
 
+	err = i.addStats(ctx, tables[:1])
+	if err != nil {
+		return nil, err
+	}
+
 	return tables[0], nil
 }
 
+// addStats adds row counts, sizes and last modified times to datasources based on the metadata of their available segments.
+// Druid doesn't maintain column statistics that can be retrieved cheaply.
+func (i informationSchema) addStats(ctx context.Context, tables []*drivers.Table) error {
+	var datasources []any
+	for _, t := range tables {
+		if t.DatabaseSchema == defaultSchema {
+			datasources = append(datasources, t.Name)
+		}
+	}
+	if len(datasources) == 0 {
+		return nil
+	}
+
+	q := fmt.Sprintf(`
+		SELECT "datasource", SUM("num_rows"), SUM("size"), MAX("version")
+		FROM sys.segments
+		WHERE is_published = 1 AND is_overshadowed = 0 AND "datasource" IN (?%s)
+		GROUP BY 1
+	`, strings.Repeat(", ?", len(datasources)-1))
+
+	rows, err := i.c.db.QueryxContext(ctx, q, datasources...)
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		// Stats are best effort (sys tables may not be accessible)
+		return nil
+	}
+	defer rows.Close()
+
+	stats := make(map[string]*runtimev1.TableStats)
+	for rows.Next() {
+		var datasource string
+		var rowCount, size int64
+		var version string
+		err := rows.Scan(&datasource, &rowCount, &size, &version)
+		if err != nil {
+			return err
+		}
+
+		ts := &runtimev1.TableStats{RowCount: &rowCount, SizeBytes: &size}
+		// Segment versions are the ISO 8601 time the segment was created
+		if t, err := time.Parse(time.RFC3339Nano, version); err == nil {
+			ts.LastModified = timestamppb.New(t)
+		}
+		stats[datasource] = ts
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, t := range tables {
+		if t.DatabaseSchema == defaultSchema {
+			t.Stats = stats[t.Name]
+		}
+	}
+
+	return nil
+}
+
 func (i informationSchema) scanTables(rows *sqlx.Rows) ([]*drivers.Table, error) {
 	var res []*drivers.Table
 
@@ -182,10 +317,12 @@ func (i informationSchema) scanTables(rows *sqlx.Rows) ([]*drivers.Table, error)
 		}
 		if t == nil {
 			t = &drivers.Table{
-				Database:       database,
-				DatabaseSchema: schema,
-				Name:           name,
-				Schema:         &runtimev1.StructType{},
+				Database:                database,
+				DatabaseSchema:          schema,
+				IsDefaultDatabase:       database == defaultDatabase,
+				IsDefaultDatabaseSchema: schema == defaultSchema,
+				Name:                    name,
+				Schema:                  &runtimev1.StructType{},
 			}
 			res = append(res, t)
 		}
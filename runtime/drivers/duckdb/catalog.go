@@ -2,9 +2,14 @@ package duckdb
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/jmoiron/sqlx"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
 	"google.golang.org/protobuf/proto"
@@ -34,7 +39,7 @@ func (c *connection) findEntries(ctx context.Context, whereClause string, args .
 	}
 	defer func() { _ = release() }()
 
-	sql := fmt.Sprintf("SELECT name, type, object, path, created_on, updated_on, refreshed_on FROM rill.catalog %s ORDER BY lower(name)", whereClause)
+	sql := fmt.Sprintf("SELECT name, type, object, path, content_hash, artifact, created_on, updated_on, refreshed_on FROM rill.catalog %s ORDER BY lower(name)", whereClause)
 	rows, err := conn.QueryxContext(ctx, sql, args...)
 	if err != nil {
 		panic(err)
@@ -46,27 +51,14 @@ func (c *connection) findEntries(ctx context.Context, whereClause string, args .
 		var objBlob []byte
 		e := &drivers.CatalogEntry{}
 
-		err := rows.Scan(&e.Name, &e.Type, &objBlob, &e.Path, &e.CreatedOn, &e.UpdatedOn, &e.RefreshedOn)
+		err := rows.Scan(&e.Name, &e.Type, &objBlob, &e.Path, &e.ContentHash, &e.Artifact, &e.CreatedOn, &e.UpdatedOn, &e.RefreshedOn)
 		if err != nil {
 			panic(err)
 		}
 
 		// Parse object protobuf
 		if objBlob != nil {
-			switch e.Type {
-			case drivers.ObjectTypeTable:
-				e.Object = &runtimev1.Table{}
-			case drivers.ObjectTypeSource:
-				e.Object = &runtimev1.Source{}
-			case drivers.ObjectTypeModel:
-				e.Object = &runtimev1.Model{}
-			case drivers.ObjectTypeMetricsView:
-				e.Object = &runtimev1.MetricsView{}
-			default:
-				panic(fmt.Errorf("unexpected object type: %v", e.Type))
-			}
-
-			err = proto.Unmarshal(objBlob, e.Object)
+			e.Object, err = unmarshalObject(e.Type, objBlob)
 			if err != nil {
 				panic(err)
 			}
@@ -91,18 +83,24 @@ func (c *connection) CreateEntry(ctx context.Context, instanceID string, e *driv
 		return err
 	}
 
+	tx, err := conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	now := time.Now()
-	_, err = conn.ExecContext(
-		ctx,
-		"INSERT INTO rill.catalog(name, type, object, path, created_on, updated_on, refreshed_on) VALUES (?, ?, ?, ?, ?, ?, ?)",
-		e.Name,
-		e.Type,
-		obj,
-		e.Path,
-		now,
-		now,
-		now,
-	)
+	err = insertEntry(ctx, tx, e, obj, now, now)
+	if err != nil {
+		return err
+	}
+
+	err = insertVersion(ctx, tx, drivers.CatalogOperationCreate, "", e, obj, now)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
 	if err != nil {
 		return err
 	}
@@ -126,12 +124,20 @@ func (c *connection) UpdateEntry(ctx context.Context, instanceID string, e *driv
 		return err
 	}
 
-	_, err = conn.ExecContext(
+	tx, err := conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(
 		ctx,
-		"UPDATE rill.catalog SET type = ?, object = ?, path = ?, updated_on = ?, refreshed_on = ? WHERE name = ?",
+		"UPDATE rill.catalog SET type = ?, object = ?, path = ?, content_hash = ?, artifact = ?, updated_on = ?, refreshed_on = ? WHERE name = ?",
 		e.Type,
 		obj,
 		e.Path,
+		e.ContentHash,
+		e.Artifact,
 		e.UpdatedOn, // TODO: Use time.Now()
 		e.RefreshedOn,
 		e.Name,
@@ -140,7 +146,12 @@ func (c *connection) UpdateEntry(ctx context.Context, instanceID string, e *driv
 		return err
 	}
 
-	return nil
+	err = insertVersion(ctx, tx, drivers.CatalogOperationUpdate, "", e, obj, time.Now())
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
 
 func (c *connection) DeleteEntry(ctx context.Context, instanceID, name string) error {
@@ -150,6 +161,342 @@ func (c *connection) DeleteEntry(ctx context.Context, instanceID, name string) e
 	}
 	defer func() { _ = release() }()
 
-	_, err = conn.ExecContext(ctx, "DELETE FROM rill.catalog WHERE LOWER(name) = LOWER(?)", name)
+	tx, err := conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// Record the deleted entry, so it can be restored
+	_, err = tx.ExecContext(
+		ctx,
+		"INSERT INTO rill.catalog_versions(operation, name, from_name, type, object, path, content_hash, artifact, created_on) "+
+			"SELECT ?, name, '', type, object, path, content_hash, artifact, ? FROM rill.catalog WHERE LOWER(name) = LOWER(?)",
+		drivers.CatalogOperationDelete,
+		time.Now(),
+		name,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = pruneVersions(ctx, tx, name)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM rill.catalog WHERE LOWER(name) = LOWER(?)", name)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (c *connection) RenameEntry(ctx context.Context, instanceID, fromName string, e *drivers.CatalogEntry) error {
+	conn, release, err := c.acquireMetaConn(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = release() }()
+
+	// Serialize object
+	obj, err := proto.Marshal(e.Object)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	var current string
+	createdOn := now
+	err = conn.QueryRowxContext(ctx, "SELECT name, created_on FROM rill.catalog WHERE LOWER(name) = LOWER(?)", fromName).Scan(&current, &createdOn)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	// DuckDB checks unique indexes eagerly, so an entry can't be deleted and re-inserted with a name that only differs in case
+	// in one transaction. Like the model migrator, we go through a temporary name instead.
+	if current != e.Name && strings.EqualFold(current, e.Name) {
+		tempName := fmt.Sprintf("__rill_temp_%s", current)
+		err = moveEntry(ctx, conn, current, tempName)
+		if err != nil {
+			return err
+		}
+		from := current
+		current = tempName
+		defer func() {
+			if current == tempName {
+				// The rename failed, so restore the original name
+				_ = moveEntry(context.Background(), conn, tempName, from)
+			}
+		}()
+	}
+
+	tx, err := conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if current == e.Name {
+		_, err = tx.ExecContext(
+			ctx,
+			"UPDATE rill.catalog SET type = ?, object = ?, path = ?, content_hash = ?, artifact = ?, updated_on = ?, refreshed_on = ? WHERE name = ?",
+			e.Type,
+			obj,
+			e.Path,
+			e.ContentHash,
+			e.Artifact,
+			now,
+			now,
+			e.Name,
+		)
+		if err != nil {
+			return err
+		}
+	} else {
+		_, err = tx.ExecContext(ctx, "DELETE FROM rill.catalog WHERE name = ?", current)
+		if err != nil {
+			return err
+		}
+		err = insertEntry(ctx, tx, e, obj, createdOn, now)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = insertVersion(ctx, tx, drivers.CatalogOperationRename, fromName, e, obj, now)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+	current = e.Name
+
+	e.CreatedOn = createdOn
+	e.UpdatedOn = now
+	e.RefreshedOn = now
+	return nil
+}
+
+func (c *connection) FindEntryVersions(ctx context.Context, instanceID, name string) ([]*drivers.CatalogEntryVersion, error) {
+	return c.findVersions(ctx, "WHERE LOWER(name) = LOWER(?) OR LOWER(from_name) = LOWER(?)", name, name)
+}
+
+func (c *connection) FindEntryVersion(ctx context.Context, instanceID string, version int64) (*drivers.CatalogEntryVersion, error) {
+	vs, err := c.findVersions(ctx, "WHERE version = ?", version)
+	if err != nil {
+		return nil, err
+	}
+	if len(vs) == 0 {
+		return nil, drivers.ErrNotFound
+	}
+	return vs[0], nil
+}
+
+func (c *connection) findVersions(ctx context.Context, whereClause string, args ...any) ([]*drivers.CatalogEntryVersion, error) {
+	conn, release, err := c.acquireMetaConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = release() }()
+
+	sql := fmt.Sprintf("SELECT version, operation, from_name, name, type, object, path, content_hash, artifact, created_on FROM rill.catalog_versions %s ORDER BY version DESC", whereClause)
+	rows, err := conn.QueryxContext(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []*drivers.CatalogEntryVersion
+	for rows.Next() {
+		var objBlob []byte
+		v := &drivers.CatalogEntryVersion{Entry: &drivers.CatalogEntry{}}
+
+		err := rows.Scan(&v.Version, &v.Operation, &v.FromName, &v.Entry.Name, &v.Entry.Type, &objBlob, &v.Entry.Path, &v.Entry.ContentHash, &v.Entry.Artifact, &v.CreatedOn)
+		if err != nil {
+			return nil, err
+		}
+
+		v.Entry.Object, err = unmarshalObject(v.Entry.Type, objBlob)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, v)
+	}
+
+	return res, rows.Err()
+}
+
+func (c *connection) DeleteEntryVersions(ctx context.Context, instanceID string) error {
+	conn, release, err := c.acquireMetaConn(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = release() }()
+
+	_, err = conn.ExecContext(ctx, "DELETE FROM rill.catalog_versions")
+	return err
+}
+
+// insertEntry inserts an entry into the catalog. obj is the entry's serialized object.
+func insertEntry(ctx context.Context, tx *sqlx.Tx, e *drivers.CatalogEntry, obj []byte, createdOn, now time.Time) error {
+	_, err := tx.ExecContext(
+		ctx,
+		"INSERT INTO rill.catalog(name, type, object, path, content_hash, artifact, created_on, updated_on, refreshed_on) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		e.Name,
+		e.Type,
+		obj,
+		e.Path,
+		e.ContentHash,
+		e.Artifact,
+		createdOn,
+		now,
+		now,
+	)
+	return err
+}
+
+// moveEntry renames the entry named from to to in its own transaction, keeping the rest of the entry unchanged.
+func moveEntry(ctx context.Context, conn *sqlx.Conn, from, to string) error {
+	tx, err := conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(
+		ctx,
+		"INSERT INTO rill.catalog(name, type, object, path, content_hash, artifact, created_on, updated_on, refreshed_on) "+
+			"SELECT ?, type, object, path, content_hash, artifact, created_on, updated_on, refreshed_on FROM rill.catalog WHERE name = ?",
+		to,
+		from,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM rill.catalog WHERE name = ?", from)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// insertVersion records a version of an entry. obj is the entry's serialized object.
+func insertVersion(ctx context.Context, tx *sqlx.Tx, op drivers.CatalogOperation, fromName string, e *drivers.CatalogEntry, obj []byte, now time.Time) error {
+	_, err := tx.ExecContext(
+		ctx,
+		"INSERT INTO rill.catalog_versions(operation, name, from_name, type, object, path, content_hash, artifact, created_on) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		op,
+		e.Name,
+		fromName,
+		e.Type,
+		obj,
+		e.Path,
+		e.ContentHash,
+		e.Artifact,
+		now,
+	)
+	if err != nil {
+		return err
+	}
+	return pruneVersions(ctx, tx, e.Name)
+}
+
+// pruneVersions deletes the versions of a name beyond the latest drivers.MaxCatalogEntryVersions.
+func pruneVersions(ctx context.Context, tx *sqlx.Tx, name string) error {
+	_, err := tx.ExecContext(
+		ctx,
+		"DELETE FROM rill.catalog_versions WHERE LOWER(name) = LOWER(?) AND version NOT IN "+
+			"(SELECT version FROM rill.catalog_versions WHERE LOWER(name) = LOWER(?) ORDER BY version DESC LIMIT ?)",
+		name,
+		name,
+		drivers.MaxCatalogEntryVersions,
+	)
+	return err
+}
+
+func unmarshalObject(typ drivers.ObjectType, blob []byte) (proto.Message, error) {
+	var obj proto.Message
+	switch typ {
+	case drivers.ObjectTypeTable:
+		obj = &runtimev1.Table{}
+	case drivers.ObjectTypeSource:
+		obj = &runtimev1.Source{}
+	case drivers.ObjectTypeModel:
+		obj = &runtimev1.Model{}
+	case drivers.ObjectTypeMetricsView:
+		obj = &runtimev1.MetricsView{}
+	default:
+		return nil, fmt.Errorf("unexpected object type: %v", typ)
+	}
+
+	err := proto.Unmarshal(blob, obj)
+	if err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func (c *connection) FindReconcileState(ctx context.Context, instanceID string) (*drivers.ReconcileState, error) {
+	conn, release, err := c.acquireMetaConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = release() }()
+
+	var state string
+	err = conn.QueryRowContext(ctx, "SELECT state FROM rill.reconcile_state").Scan(&state)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, drivers.ErrNotFound
+		}
+		return nil, err
+	}
+
+	res := &drivers.ReconcileState{}
+	err = json.Unmarshal([]byte(state), res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *connection) SaveReconcileState(ctx context.Context, instanceID string, state *drivers.ReconcileState) error {
+	conn, release, err := c.acquireMetaConn(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = release() }()
+
+	b, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	// The embedded catalog holds a single instance, so the table has at most one row
+	_, err = conn.ExecContext(ctx, "DELETE FROM rill.reconcile_state")
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.ExecContext(ctx, "INSERT INTO rill.reconcile_state(state, updated_on) VALUES (?, ?)", string(b), time.Now())
+	return err
+}
+
+func (c *connection) DeleteReconcileState(ctx context.Context, instanceID string) error {
+	conn, release, err := c.acquireMetaConn(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = release() }()
+
+	_, err = conn.ExecContext(ctx, "DELETE FROM rill.reconcile_state")
 	return err
 }
@@ -4,9 +4,22 @@ import (
 	"fmt"
 	"net/url"
 	"strconv"
+	"strings"
 )
 
-const poolSizeKey = "rill_pool_size"
+const (
+	poolSizeKey   = "rill_pool_size"
+	ingestModeKey = "rill_ingest_mode"
+)
+
+// Supported values for ingestModeKey
+const (
+	// ingestModeShared runs ingestion on the OLAP connections, where it competes with queries for slots
+	ingestModeShared = "shared"
+	// ingestModeStaged runs ingestion on a dedicated connection. Data is written to a staging table,
+	// which is swapped in when ingestion completes, so reads never queue behind it.
+	ingestModeStaged = "staged"
+)
 
 // config represents the Driver config, extracted from the DSN
 type config struct {
@@ -14,6 +27,8 @@ type config struct {
 	DSN string
 	// PoolSize is the number of concurrent connections and queries allowed
 	PoolSize int
+	// IngestMode controls how ingestion is isolated from queries (one of ingestModeShared or ingestModeStaged)
+	IngestMode string
 }
 
 func newConfig(dsn string) (*config, error) {
@@ -42,14 +57,34 @@ func newConfig(dsn string) (*config, error) {
 		return nil, fmt.Errorf("%s must be >= 1", poolSizeKey)
 	}
 
+	// If ingestModeKey is in the DSN, validate and remove it
+	ingestMode := ingestModeShared
+	if qry.Has(ingestModeKey) {
+		ingestMode = qry.Get(ingestModeKey)
+		if ingestMode != ingestModeShared && ingestMode != ingestModeStaged {
+			return nil, fmt.Errorf("%s must be one of %q or %q", ingestModeKey, ingestModeShared, ingestModeStaged)
+		}
+		qry.Del(ingestModeKey)
+	}
+
 	// Rebuild DuckDB DSN (which should be "path?key=val&...")
 	uri.RawQuery = qry.Encode()
 	dsn = uri.String()
 
 	// Return config
 	cfg := &config{
-		DSN:      dsn,
-		PoolSize: poolSize,
+		DSN:        dsn,
+		PoolSize:   poolSize,
+		IngestMode: ingestMode,
 	}
 	return cfg, nil
 }
+
+// dbFilePath returns the path of the database file, or an empty string for in-memory databases.
+func (c *config) dbFilePath() string {
+	path, _, _ := strings.Cut(c.DSN, "?")
+	if path == ":memory:" {
+		return ""
+	}
+	return path
+}
@@ -32,4 +32,25 @@ func TestConfig(t *testing.T) {
 
 	cfg, err = newConfig("path/to/duck.db?rill_pool_size=0&hello=world")
 	require.Error(t, err)
+
+	cfg, err = newConfig("path/to/duck.db")
+	require.NoError(t, err)
+	require.Equal(t, ingestModeShared, cfg.IngestMode)
+
+	cfg, err = newConfig("path/to/duck.db?rill_pool_size=4&rill_ingest_mode=staged&hello=world")
+	require.NoError(t, err)
+	require.Equal(t, "path/to/duck.db?hello=world", cfg.DSN)
+	require.Equal(t, 4, cfg.PoolSize)
+	require.Equal(t, ingestModeStaged, cfg.IngestMode)
+
+	_, err = newConfig("path/to/duck.db?rill_ingest_mode=other")
+	require.Error(t, err)
+
+	cfg, err = newConfig("path/to/duck.db?rill_pool_size=4&hello=world")
+	require.NoError(t, err)
+	require.Equal(t, "path/to/duck.db", cfg.dbFilePath())
+
+	cfg, err = newConfig("?access_mode=read_write")
+	require.NoError(t, err)
+	require.Equal(t, "", cfg.dbFilePath())
 }
@@ -47,10 +47,10 @@ func (c *connection) ingestFile(ctx context.Context, env *connectors.Env, source
 	path := conf.Path
 	if !filepath.IsAbs(path) {
 		// If the path is relative, it's relative to the repo root
-		if env.RepoDriver != "file" || env.RepoDSN == "" {
+		if env.RepoRoot == "" {
 			return fmt.Errorf("file connector cannot ingest source '%s': path is relative, but repo is not available", source.Name)
 		}
-		path = filepath.Join(env.RepoDSN, path)
+		path = filepath.Join(env.RepoRoot, path)
 	}
 
 	// Not using query args since not quite sure about behaviour of injecting table names that way.
@@ -66,9 +66,7 @@ func (c *connection) ingestFile(ctx context.Context, env *connectors.Env, source
 		}
 	}
 
-	qry := fmt.Sprintf("CREATE OR REPLACE TABLE %s AS (SELECT * FROM %s)", source.Name, from)
-
-	return c.Exec(ctx, &drivers.Statement{Query: qry, Priority: 1})
+	return c.ingestTable(ctx, source.Name, from)
 }
 
 func (c *connection) ingestFromRawFile(ctx context.Context, source *connectors.Source, path string) error {
@@ -76,10 +74,56 @@ func (c *connection) ingestFromRawFile(ctx context.Context, source *connectors.S
 	if err != nil {
 		return err
 	}
-	return c.Exec(ctx, &drivers.Statement{
-		Query:    fmt.Sprintf("CREATE OR REPLACE TABLE %s AS (SELECT * FROM %s);", source.Name, from),
-		Priority: 1,
+	return c.ingestTable(ctx, source.Name, from)
+}
+
+// stagingTablePrefix prefixes the tables that hold data while it's being ingested in the staged ingest mode.
+// Tables with this prefix are hidden from the information schema.
+const stagingTablePrefix = "__rill_ingest_"
+
+// ingestTable creates or replaces the table name with the output of from.
+func (c *connection) ingestTable(ctx context.Context, name, from string) error {
+	if c.ingestMode != ingestModeStaged {
+		return c.Exec(ctx, &drivers.Statement{
+			Query:    fmt.Sprintf("CREATE OR REPLACE TABLE %s AS (SELECT * FROM %s)", name, from),
+			Priority: 1,
+		})
+	}
+
+	// Use the dedicated ingestion connection so we don't take a slot from OLAP queries
+	conn, release, err := c.acquireIngestConn(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = release() }()
+	ctx = contextWithConn(ctx, conn)
+
+	// Load the data into a staging table. Queries against the existing table are unaffected while this runs.
+	staging := stagingTablePrefix + name
+	err = c.Exec(ctx, &drivers.Statement{
+		Query: fmt.Sprintf("CREATE OR REPLACE TABLE %s AS (SELECT * FROM %s)", staging, from),
 	})
+	if err != nil {
+		// Clean up using a fresh context since ctx may be cancelled
+		ensuredCtx := contextWithConn(context.Background(), conn)
+		_ = c.Exec(ensuredCtx, &drivers.Statement{Query: fmt.Sprintf("DROP TABLE IF EXISTS %s", staging)})
+		return err
+	}
+
+	// Swap the staging table in. This is a short catalog change, so readers see either the old or the new table.
+	tx, err := conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, fmt.Sprintf("DROP TABLE IF EXISTS %s", name))
+	if err == nil {
+		_, err = tx.ExecContext(ctx, fmt.Sprintf("ALTER TABLE %s RENAME TO %s", staging, name))
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
 }
 
 func getSourceReader(path string) (string, error) {
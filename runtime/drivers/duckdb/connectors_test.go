@@ -3,8 +3,10 @@ package duckdb
 import (
 	"context"
 	"fmt"
+	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/rilldata/rill/runtime/connectors"
 	"github.com/rilldata/rill/runtime/drivers"
@@ -63,6 +65,7 @@ func TestConnectorWithSourceVariations(t *testing.T) {
 			e := &connectors.Env{
 				RepoDriver: "file",
 				RepoDSN:    ".",
+				RepoRoot:   ".",
 			}
 			s := &connectors.Source{
 				Name:       "foo",
@@ -97,6 +100,7 @@ func TestCSVDelimiter(t *testing.T) {
 	err = olap.Ingest(ctx, &connectors.Env{
 		RepoDriver: "file",
 		RepoDSN:    ".",
+		RepoRoot:   ".",
 	}, &connectors.Source{
 		Name:      "foo",
 		Connector: "local_file",
@@ -116,6 +120,7 @@ func TestCSVDelimiter(t *testing.T) {
 	err = olap.Ingest(ctx, &connectors.Env{
 		RepoDriver: "file",
 		RepoDSN:    ".",
+		RepoRoot:   ".",
 	}, &connectors.Source{
 		Name:      "foo",
 		Connector: "local_file",
@@ -133,3 +138,139 @@ func TestCSVDelimiter(t *testing.T) {
 	require.Len(t, cols, 2)
 	require.NoError(t, rows.Close())
 }
+
+func TestRelativePathInLocalRepo(t *testing.T) {
+	ctx := context.Background()
+	conn, err := Driver{}.Open("?access_mode=read_write", zap.NewNop())
+	require.NoError(t, err)
+	defer conn.Close()
+	olap, _ := conn.OLAPStore()
+
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "data"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "data", "a.csv"), []byte("id,name\n1,a\n2,b\n"), 0o644))
+
+	// relative paths resolve against the repo's directory, which isn't necessarily its DSN
+	err = olap.Ingest(ctx, &connectors.Env{
+		RepoDriver: "git",
+		RepoDSN:    dir + "?author_name=test",
+		RepoRoot:   dir,
+	}, &connectors.Source{
+		Name:       "foo",
+		Connector:  "local_file",
+		Properties: map[string]any{"path": "data/a.csv"},
+	})
+	require.NoError(t, err)
+
+	// without a local repo, relative paths can't be resolved
+	err = olap.Ingest(ctx, &connectors.Env{RepoDriver: "postgres"}, &connectors.Source{
+		Name:       "foo",
+		Connector:  "local_file",
+		Properties: map[string]any{"path": "data/a.csv"},
+	})
+	require.Error(t, err)
+}
+
+func TestStagedIngest(t *testing.T) {
+	ctx := context.Background()
+	conn, err := Driver{}.Open("?access_mode=read_write&rill_pool_size=1&rill_ingest_mode=staged", zap.NewNop())
+	require.NoError(t, err)
+	defer conn.Close()
+	olap, _ := conn.OLAPStore()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.csv"), []byte("id,name\n1,a\n2,b\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b.csv"), []byte("id,name\n1,a\n2,b\n3,c\n"), 0o644))
+
+	ingest := func(path string) error {
+		return olap.Ingest(ctx, &connectors.Env{
+			RepoDriver: "file",
+			RepoDSN:    ".",
+			RepoRoot:   ".",
+		}, &connectors.Source{
+			Name:       "foo",
+			Connector:  "local_file",
+			Properties: map[string]any{"path": path},
+		})
+	}
+	count := func(ctx context.Context) (int, error) {
+		rows, err := olap.Execute(ctx, &drivers.Statement{Query: "SELECT count(*) FROM foo"})
+		if err != nil {
+			return 0, err
+		}
+		defer rows.Close()
+		var n int
+		rows.Next()
+		return n, rows.Scan(&n)
+	}
+
+	// ingesting twice swaps in the new table
+	require.NoError(t, ingest(filepath.Join(dir, "a.csv")))
+	require.NoError(t, ingest(filepath.Join(dir, "b.csv")))
+	n, err := count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+
+	// a failed ingestion keeps the existing table and cleans up the staging table
+	require.Error(t, ingest(filepath.Join(dir, "missing.csv")))
+	n2, err := count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, n, n2)
+	tables, err := olap.InformationSchema().All(ctx)
+	require.NoError(t, err)
+	require.Len(t, tables, 1)
+	require.Equal(t, "foo", tables[0].Name)
+
+	// reads don't queue behind an in-progress ingestion, even with a single OLAP connection
+	c := conn.(*connection)
+	done := make(chan error, 1)
+	go func() {
+		done <- c.ingestTable(ctx, "foo", "(SELECT SUM(a.range * b.range) AS id FROM range(10000000) a CROSS JOIN range(20) b)")
+	}()
+
+	// wait for the ingestion to hold the ingest connection
+	require.Eventually(t, func() bool {
+		if c.ingestSem.TryAcquire(1) {
+			c.ingestSem.Release(1)
+			return false
+		}
+		return true
+	}, 5*time.Second, time.Millisecond)
+
+	readCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	n3, err := count(readCtx)
+	require.NoError(t, err)
+	require.Equal(t, n, n3)
+	select {
+	case err := <-done:
+		t.Fatalf("ingestion completed before the read: %v", err)
+	default:
+	}
+
+	// the ingested table is swapped in when the ingestion completes
+	require.NoError(t, <-done)
+	n4, err := count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, n4)
+}
+
+func TestSharedIngestQueues(t *testing.T) {
+	ctx := context.Background()
+	conn, err := Driver{}.Open("?access_mode=read_write&rill_pool_size=1", zap.NewNop())
+	require.NoError(t, err)
+	defer conn.Close()
+	olap, _ := conn.OLAPStore()
+
+	// in the shared mode, ingestion holds an OLAP slot, so reads queue behind it
+	c := conn.(*connection)
+	_, release, err := c.acquireOLAPConn(ctx, 1)
+	require.NoError(t, err)
+
+	readCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	_, err = olap.Execute(readCtx, &drivers.Statement{Query: "SELECT 1"})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	require.NoError(t, release())
+}
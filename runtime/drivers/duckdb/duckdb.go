@@ -4,7 +4,12 @@ import (
 	"context"
 	"database/sql"
 	"database/sql/driver"
+	"errors"
+	"io/fs"
+	"os"
+	"sync"
 
+	lru "github.com/hashicorp/golang-lru"
 	"github.com/jmoiron/sqlx"
 	"github.com/marcboeker/go-duckdb"
 	"github.com/rilldata/rill/runtime/drivers"
@@ -58,10 +63,17 @@ func (d Driver) Open(dsn string, logger *zap.Logger) (drivers.Connection, error)
 	//
 	// When cfg.PoolSize is 1, we set olapSem to still allow 1 query at a time.
 	// This creates contention for the same connection in database/sql's pool, but its locks will handle that.
+	//
+	// In the staged ingest mode, ingestion gets an extra connection gated by ingestSem, so it never takes a slot from olapSem.
+	// DuckDB's MVCC lets queries keep reading existing tables while the ingestion's write transaction is open.
 
 	sqlDB := sql.OpenDB(connector)
 	db := sqlx.NewDb(sqlDB, "duckdb")
-	db.SetMaxOpenConns(cfg.PoolSize)
+	maxOpenConns := cfg.PoolSize
+	if cfg.IngestMode == ingestModeStaged {
+		maxOpenConns++
+	}
+	db.SetMaxOpenConns(maxOpenConns)
 
 	// We want to use all except one connection for OLAP queries.
 	olapSemSize := cfg.PoolSize - 1
@@ -69,16 +81,53 @@ func (d Driver) Open(dsn string, logger *zap.Logger) (drivers.Connection, error)
 		olapSemSize = 1
 	}
 
+	columnStats, err := lru.New(columnStatsCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
 	c := &connection{
-		db:      db,
-		metaSem: semaphore.NewWeighted(1),
-		olapSem: priorityqueue.NewSemaphore(olapSemSize),
-		logger:  logger,
+		db:          db,
+		metaSem:     semaphore.NewWeighted(1),
+		olapSem:     priorityqueue.NewSemaphore(olapSemSize),
+		ingestSem:   semaphore.NewWeighted(1),
+		ingestMode:  cfg.IngestMode,
+		columnStats: columnStats,
+		logger:      logger,
 	}
 
 	return c, nil
 }
 
+// Drop implements drivers.Dropper. It deletes the database file (and its write-ahead log) for file-backed DSNs.
+func (d Driver) Drop(dsn string, logger *zap.Logger) (bool, error) {
+	cfg, err := newConfig(dsn)
+	if err != nil {
+		return false, err
+	}
+
+	path := cfg.dbFilePath()
+	if path == "" {
+		// In-memory databases are gone when their connections are closed
+		return false, nil
+	}
+
+	dropped := false
+	for _, p := range []string{path, path + ".wal"} {
+		err := os.Remove(p)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return dropped, err
+		}
+		dropped = true
+		logger.Info("deleted duckdb file", zap.String("path", p))
+	}
+
+	return dropped, nil
+}
+
 type connection struct {
 	db     *sqlx.DB
 	logger *zap.Logger
@@ -86,8 +135,18 @@ type connection struct {
 	metaSem *semaphore.Weighted
 	// olapSem gates OLAP queries
 	olapSem *priorityqueue.Semaphore
+	// ingestSem gates ingestion when ingestMode is ingestModeStaged
+	ingestSem  *semaphore.Weighted
+	ingestMode string
+	// logInterruptUnsupported logs once that timed out queries can't be interrupted (see interrupter)
+	logInterruptUnsupported sync.Once
+	// columnStats caches the column statistics of tables by name (see addColumnStats)
+	columnStats *lru.Cache
 }
 
+// columnStatsCacheSize is the number of tables to cache column statistics for
+const columnStatsCacheSize = 100
+
 // Close implements drivers.Connection.
 func (c *connection) Close() error {
 	return c.db.Close()
@@ -176,3 +235,29 @@ func (c *connection) acquireOLAPConn(ctx context.Context, priority int) (*sqlx.C
 
 	return conn, release, nil
 }
+
+// acquireIngestConn gets a dedicated connection from the pool for ingestion in the staged ingest mode.
+// It returns a function that puts the connection back in the pool.
+func (c *connection) acquireIngestConn(ctx context.Context) (*sqlx.Conn, func() error, error) {
+	// Acquire semaphore
+	err := c.ingestSem.Acquire(ctx, 1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Get new conn
+	conn, err := c.db.Connx(ctx)
+	if err != nil {
+		c.ingestSem.Release(1)
+		return nil, nil, err
+	}
+
+	// Build release func
+	release := func() error {
+		err := conn.Close()
+		c.ingestSem.Release(1)
+		return err
+	}
+
+	return conn, release, nil
+}
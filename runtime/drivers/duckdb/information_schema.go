@@ -2,7 +2,11 @@ package duckdb
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/jmoiron/sqlx"
@@ -25,23 +29,15 @@ func (i informationSchema) All(ctx context.Context) ([]*drivers.Table, error) {
 	}
 	defer func() { _ = release() }()
 
-	q := `
-		select
-			coalesce(t.table_catalog, '') as "database",
-			t.table_schema as "schema",
-			t.table_name as "name",
-			t.table_type as "type", 
-			array_agg(c.column_name order by c.ordinal_position) as "column_names",
-			array_agg(c.data_type order by c.ordinal_position) as "column_types",
-			array_agg(c.is_nullable = 'YES' order by c.ordinal_position) as "column_nullable"
-		from information_schema.tables t
-		join information_schema.columns c on t.table_schema = c.table_schema and t.table_name = c.table_name
-		where t.table_schema = 'main'
-		group by 1, 2, 3, 4
-		order by 1, 2, 3, 4
-	`
+	// The rill schema contains the catalog tables when DuckDB is also used as the instance's catalog store (see Migrate)
+	q := fmt.Sprintf(`
+		%s
+		where t.table_schema not in ('information_schema', 'pg_catalog', 'temp', 'rill') and not prefix(t.table_name, ?)
+		group by 1, 2, 3, 4, 5, 6, 7
+		order by 1, 2, 3, 4, 5, 6, 7
+	`, tablesSelect)
 
-	rows, err := conn.QueryxContext(ctx, q)
+	rows, err := conn.QueryxContext(ctx, q, stagingTablePrefix)
 	if err != nil {
 		return nil, err
 	}
@@ -55,30 +51,31 @@ func (i informationSchema) All(ctx context.Context) ([]*drivers.Table, error) {
 	return tables, nil
 }
 
+// Lookup returns a table with column statistics (see addColumnStats), which All leaves out since computing them queries each table.
+
 func (i informationSchema) Lookup(ctx context.Context, name string) (*drivers.Table, error) {
+	database, schema, table, err := drivers.ParseTableName(name)
+	if err != nil {
+		return nil, drivers.ErrNotFound
+	}
+
 	conn, release, err := i.c.acquireMetaConn(ctx)
 	if err != nil {
 		return nil, err
 	}
 	defer func() { _ = release() }()
 
-	q := `
-		select
-			coalesce(t.table_catalog, '') as "database",
-			t.table_schema as "schema",
-			t.table_name as "name",
-			t.table_type as "type", 
-			array_agg(c.column_name order by c.ordinal_position) as "column_names",
-			array_agg(c.data_type order by c.ordinal_position) as "column_types",
-			array_agg(c.is_nullable = 'YES' order by c.ordinal_position) as "column_nullable"
-		from information_schema.tables t
-		join information_schema.columns c on t.table_schema = c.table_schema and t.table_name = c.table_name
-		where t.table_schema = 'main' and t.table_name = ?
-		group by 1, 2, 3, 4
-		order by 1, 2, 3, 4
-	`
-
-	rows, err := conn.QueryxContext(ctx, q, name)
+	// Empty database and schema parts resolve to the defaults
+	q := fmt.Sprintf(`
+		%s
+		where (case when ? = '' then coalesce(t.table_catalog, current_database()) = current_database() else coalesce(t.table_catalog, '') = ? end)
+			and (case when ? = '' then t.table_schema = current_schema() else t.table_schema = ? end)
+			and t.table_name = ?
+		group by 1, 2, 3, 4, 5, 6, 7
+		order by 1, 2, 3, 4, 5, 6, 7
+	`, tablesSelect)
+
+	rows, err := conn.QueryxContext(ctx, q, database, database, schema, schema, table)
 	if err != nil {
 		return nil, err
 	}
@@ -92,32 +89,68 @@ func (i informationSchema) Lookup(ctx context.Context, name string) (*drivers.Ta
 	if len(tables) == 0 {
 		return nil, drivers.ErrNotFound
 	}
+	rows.Close()
+
+	err = i.addColumnStats(ctx, conn, tables[0])
+	if err != nil {
+		return nil, err
+	}
 
 	return tables[0], nil
 }
 
+// tablesSelect selects tables and their columns from DuckDB's information schema.
+// It must be followed by a where clause and grouped by the first seven columns.
+// DuckDB versions without support for attaching databases return a NULL catalog.
+const tablesSelect = `
+		select
+			coalesce(t.table_catalog, '') as "database",
+			t.table_schema as "schema",
+			coalesce(t.table_catalog, current_database()) = current_database() as "is_default_database",
+			t.table_schema = current_schema() as "is_default_schema",
+			t.table_name as "name",
+			t.table_type as "type",
+			dt.estimated_size as "row_count",
+			array_agg(c.column_name order by c.ordinal_position) as "column_names",
+			array_agg(c.data_type order by c.ordinal_position) as "column_types",
+			array_agg(c.is_nullable = 'YES' order by c.ordinal_position) as "column_nullable"
+		from information_schema.tables t
+		join information_schema.columns c on coalesce(t.table_catalog, '') = coalesce(c.table_catalog, '') and t.table_schema = c.table_schema and t.table_name = c.table_name
+		left join duckdb_tables() dt on t.table_schema = dt.schema_name and t.table_name = dt.table_name`
+
 func (i informationSchema) scanTables(rows *sqlx.Rows) ([]*drivers.Table, error) {
 	var res []*drivers.Table
 
 	for rows.Next() {
 		var database string
 		var schema string
+		var isDefaultDatabase bool
+		var isDefaultSchema bool
 		var name string
 		var tableType string
+		var rowCount sql.NullInt64
 		var columnNames []any
 		var columnTypes []any
 		var columnNullable []any
 
-		err := rows.Scan(&database, &schema, &name, &tableType, &columnNames, &columnTypes, &columnNullable)
+		err := rows.Scan(&database, &schema, &isDefaultDatabase, &isDefaultSchema, &name, &tableType, &rowCount, &columnNames, &columnTypes, &columnNullable)
 		if err != nil {
 			return nil, err
 		}
 
 		t := &drivers.Table{
-			Database:       database,
-			DatabaseSchema: schema,
-			Name:           name,
-			Schema:         &runtimev1.StructType{},
+			Database:                database,
+			DatabaseSchema:          schema,
+			IsDefaultDatabase:       isDefaultDatabase,
+			IsDefaultDatabaseSchema: isDefaultSchema,
+			Name:                    name,
+			View:                    tableType == "VIEW",
+			Schema:                  &runtimev1.StructType{},
+		}
+
+		// DuckDB reports an estimated row count for base tables, which isn't guaranteed to be exact (views have no stats)
+		if rowCount.Valid {
+			t.Stats = &runtimev1.TableStats{RowCount: &rowCount.Int64}
 		}
 
 		// should NEVER happen, but just to be safe
@@ -149,6 +182,127 @@ func (i informationSchema) scanTables(rows *sqlx.Rows) ([]*drivers.Table, error)
 	return res, nil
 }
 
+// columnStatsVersion identifies the state of a table that cached column statistics were computed for.
+// Replacing a table gives it a new OID, and appending to or deleting from it changes its row count.
+type columnStatsVersion struct {
+	oid      int64
+	rowCount int64
+}
+
+// cachedColumnStats are the column statistics of a table, keyed by column name.
+type cachedColumnStats struct {
+	version columnStatsVersion
+	columns map[string]*runtimev1.ColumnStats
+}
+
+// addColumnStats adds column statistics to a table that has table stats (i.e. a base table).
+// It uses DuckDB's stats function, which returns the statistics DuckDB maintains for a column without scanning it.
+// The statistics are cached until the table's OID or row count changes.
+func (i informationSchema) addColumnStats(ctx context.Context, conn *sqlx.Conn, t *drivers.Table) error {
+	if t.Stats == nil || len(t.Schema.Fields) == 0 {
+		return nil
+	}
+
+	var oid int64
+	err := conn.QueryRowxContext(ctx, "SELECT table_oid FROM duckdb_tables() WHERE schema_name = ? AND table_name = ?", t.DatabaseSchema, t.Name).Scan(&oid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// The table was dropped after it was looked up
+			return nil
+		}
+		return err
+	}
+	version := columnStatsVersion{oid: oid, rowCount: t.Stats.GetRowCount()}
+
+	name := drivers.FormatTableName(t.Database, t.DatabaseSchema, t.Name)
+	if val, ok := i.c.columnStats.Get(name); ok {
+		cached := val.(*cachedColumnStats)
+		if cached.version == version {
+			t.Stats.Columns = cached.columns
+			return nil
+		}
+	}
+
+	exprs := make([]string, len(t.Schema.Fields))
+	for idx, f := range t.Schema.Fields {
+		exprs[idx] = fmt.Sprintf(`stats("%s")::VARCHAR`, strings.ReplaceAll(f.Name, `"`, `""`))
+	}
+	qry := fmt.Sprintf("SELECT %s FROM %s LIMIT 1", strings.Join(exprs, ", "), drivers.QuoteTableName(name))
+
+	row, err := conn.QueryxContext(ctx, qry)
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		// Stats are best effort
+		return nil
+	}
+	vals := make([]sql.NullString, len(exprs))
+	ptrs := make([]any, len(exprs))
+	for idx := range vals {
+		ptrs[idx] = &vals[idx]
+	}
+	if row.Next() {
+		err = row.Scan(ptrs...)
+	}
+	row.Close()
+	if err != nil {
+		return err
+	}
+
+	columns := make(map[string]*runtimev1.ColumnStats)
+	for idx, f := range t.Schema.Fields {
+		if cs := parseColumnStats(vals[idx].String); cs != nil {
+			columns[f.Name] = cs
+		}
+	}
+	i.c.columnStats.Add(name, &cachedColumnStats{version: version, columns: columns})
+
+	t.Stats.Columns = columns
+	return nil
+}
+
+var (
+	approxUniqueRegexp = regexp.MustCompile(`Approx Unique: (\d+)`)
+	hasNullRegexp      = regexp.MustCompile(`Has Null: (true|false), Has No Null: (true|false)`)
+)
+
+// parseColumnStats parses the output of DuckDB's stats function, which looks like
+// "[Min: 0, Max: 6][Has Null: true, Has No Null: true][Approx Unique: 7]".
+// DuckDB only tracks whether a column has nulls, so the null fraction is only known if it's 0 or 1.
+func parseColumnStats(s string) *runtimev1.ColumnStats {
+	cs := &runtimev1.ColumnStats{}
+	found := false
+
+	if m := approxUniqueRegexp.FindStringSubmatch(s); m != nil {
+		n, err := strconv.ParseInt(m[1], 10, 64)
+		if err == nil {
+			cs.DistinctEstimate = &n
+			found = true
+		}
+	}
+
+	if m := hasNullRegexp.FindStringSubmatch(s); m != nil {
+		hasNull, hasNoNull := m[1] == "true", m[2] == "true"
+		var frac float64
+		switch {
+		case !hasNull:
+			frac = 0
+			cs.NullFraction = &frac
+			found = true
+		case !hasNoNull:
+			frac = 1
+			cs.NullFraction = &frac
+			found = true
+		}
+	}
+
+	if !found {
+		return nil
+	}
+	return cs
+}
+
 func databaseTypeToPB(dbt string, nullable bool) (*runtimev1.Type, error) {
 	t := &runtimev1.Type{Nullable: nullable}
 	match := true
@@ -33,6 +33,28 @@ func TestInformationSchemaAll(t *testing.T) {
 	require.Equal(t, runtimev1.Type_CODE_INT32, tables[1].Schema.Fields[1].Type.Code)
 }
 
+func TestInformationSchemaAllWithEmbeddedCatalog(t *testing.T) {
+	conn := prepareConn(t)
+	olap, _ := conn.OLAPStore()
+	ctx := context.Background()
+
+	// the catalog tables aren't listed when the connection is also the instance's catalog
+	require.NoError(t, conn.Migrate(ctx))
+	catalog, _ := conn.CatalogStore()
+	err := catalog.CreateEntry(ctx, "default", &drivers.CatalogEntry{
+		Name: "foo",
+		Type: drivers.ObjectTypeTable,
+		Path: "foo",
+	})
+	require.NoError(t, err)
+
+	tables, err := olap.InformationSchema().All(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(tables))
+	require.Equal(t, "bar", tables[0].Name)
+	require.Equal(t, "foo", tables[1].Name)
+}
+
 func TestInformationSchemaLookup(t *testing.T) {
 	conn := prepareConn(t)
 	olap, _ := conn.OLAPStore()
@@ -55,6 +77,101 @@ func TestInformationSchemaLookup(t *testing.T) {
 	require.Equal(t, "model", table.Name)
 }
 
+func TestInformationSchemaSchemas(t *testing.T) {
+	conn := prepareConn(t)
+	olap, _ := conn.OLAPStore()
+	ctx := context.Background()
+
+	err := olap.Exec(ctx, &drivers.Statement{Query: "CREATE SCHEMA aux"})
+	require.NoError(t, err)
+	err = olap.Exec(ctx, &drivers.Statement{Query: "CREATE TABLE aux.foo(a INTEGER)"})
+	require.NoError(t, err)
+
+	tables, err := olap.InformationSchema().All(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(tables))
+	require.Equal(t, "aux", tables[0].DatabaseSchema)
+	require.False(t, tables[0].IsDefaultDatabaseSchema)
+	require.True(t, tables[0].IsDefaultDatabase)
+	require.Equal(t, "aux.foo", tables[0].QualifiedName())
+	require.Equal(t, "main", tables[1].DatabaseSchema)
+	require.True(t, tables[1].IsDefaultDatabaseSchema)
+	require.Equal(t, "bar", tables[1].QualifiedName())
+
+	table, err := olap.InformationSchema().Lookup(ctx, "aux.foo")
+	require.NoError(t, err)
+	require.Equal(t, "aux", table.DatabaseSchema)
+	require.Equal(t, 1, len(table.Schema.Fields))
+
+	table, err = olap.InformationSchema().Lookup(ctx, "main.foo")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(table.Schema.Fields))
+
+	table, err = olap.InformationSchema().Lookup(ctx, "foo")
+	require.NoError(t, err)
+	require.Equal(t, "main", table.DatabaseSchema)
+
+	_, err = olap.InformationSchema().Lookup(ctx, "missing.foo")
+	require.Equal(t, drivers.ErrNotFound, err)
+}
+
+func TestInformationSchemaStats(t *testing.T) {
+	conn := prepareConn(t)
+	olap, _ := conn.OLAPStore()
+	ctx := context.Background()
+
+	err := olap.Exec(ctx, &drivers.Statement{Query: "INSERT INTO foo VALUES (NULL, 5)"})
+	require.NoError(t, err)
+	err = olap.Exec(ctx, &drivers.Statement{Query: "CREATE VIEW model AS SELECT * FROM foo"})
+	require.NoError(t, err)
+
+	table, err := olap.InformationSchema().Lookup(ctx, "foo")
+	require.NoError(t, err)
+	require.NotNil(t, table.Stats)
+	require.Equal(t, int64(5), table.Stats.GetRowCount())
+	require.Equal(t, int64(3), table.Stats.Columns["bar"].GetDistinctEstimate())
+	require.Nil(t, table.Stats.Columns["bar"].NullFraction)
+	require.Equal(t, float64(0), table.Stats.Columns["baz"].GetNullFraction())
+	require.NotNil(t, table.Stats.Columns["baz"].NullFraction)
+
+	// views don't have stats
+	table, err = olap.InformationSchema().Lookup(ctx, "model")
+	require.NoError(t, err)
+	require.Nil(t, table.Stats)
+
+	// All doesn't compute column stats
+	tables, err := olap.InformationSchema().All(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "bar", tables[0].Name)
+	require.Equal(t, int64(4), tables[0].Stats.GetRowCount())
+	require.Nil(t, tables[0].Stats.Columns)
+
+	// cached column stats are recomputed when the table changes
+	err = olap.Exec(ctx, &drivers.Statement{Query: "INSERT INTO foo VALUES ('d', 6)"})
+	require.NoError(t, err)
+	table, err = olap.InformationSchema().Lookup(ctx, "foo")
+	require.NoError(t, err)
+	require.Equal(t, int64(4), table.Stats.Columns["bar"].GetDistinctEstimate())
+	err = olap.Exec(ctx, &drivers.Statement{Query: "CREATE OR REPLACE TABLE foo AS SELECT 'x' AS bar, i::INTEGER AS baz FROM range(6) t(i)"})
+	require.NoError(t, err)
+	table, err = olap.InformationSchema().Lookup(ctx, "foo")
+	require.NoError(t, err)
+	require.Equal(t, int64(6), table.Stats.GetRowCount())
+	require.Equal(t, int64(1), table.Stats.Columns["bar"].GetDistinctEstimate())
+}
+
+func TestParseColumnStats(t *testing.T) {
+	cs := parseColumnStats("[Min: 0, Max: 6][Has Null: true, Has No Null: true][Approx Unique: 7]")
+	require.Equal(t, int64(7), cs.GetDistinctEstimate())
+	require.Nil(t, cs.NullFraction)
+
+	cs = parseColumnStats("[Min: x0, Max: x4, Has Unicode: false, Max String Length: 2][Has Null: true, Has No Null: false]")
+	require.Nil(t, cs.DistinctEstimate)
+	require.Equal(t, float64(1), cs.GetNullFraction())
+
+	require.Nil(t, parseColumnStats("[Min: 0, Max: 6]"))
+}
+
 func TestDatabaseTypeToPB(t *testing.T) {
 	tests := []struct {
 		input  string
@@ -0,0 +1,106 @@
+package duckdb
+
+/*
+// DuckDB's C API (as of 0.6) doesn't expose interrupting a running query, so we call duckdb::Connection::Interrupt() directly.
+// The symbol is declared weak, so the driver still links (and just can't interrupt queries) if it's missing from the DuckDB library.
+extern void _ZN6duckdb10Connection9InterruptEv(void *connection) __attribute__((weak));
+
+static int rill_duckdb_can_interrupt() {
+	return _ZN6duckdb10Connection9InterruptEv != 0;
+}
+
+static void rill_duckdb_interrupt(void *connection) {
+	_ZN6duckdb10Connection9InterruptEv(connection);
+}
+*/
+import "C"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime/debug"
+	"sync"
+	"unsafe"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// interruptDriverVersion is the version of go-duckdb that interrupter relies on the internals of.
+// Interrupts are disabled for other versions. When upgrading go-duckdb, check that its conn type still keeps the
+// duckdb_connection in its "con" field (and that DuckDB still exports duckdb::Connection::Interrupt), then update the version.
+// TestInterrupterSupported fails until then.
+const interruptDriverVersion = "v1.0.8"
+
+const interruptDriverModule = "github.com/marcboeker/go-duckdb"
+
+var errInterruptUnsupported = errors.New("duckdb: interrupting queries is not supported")
+
+var (
+	interruptSupportOnce sync.Once
+	interruptSupportErr  error
+)
+
+// checkInterruptSupport returns an error if the go-duckdb version or DuckDB library that the binary was built with
+// doesn't match the ones that interrupter was written for.
+func checkInterruptSupport() error {
+	interruptSupportOnce.Do(func() {
+		info, ok := debug.ReadBuildInfo()
+		if !ok {
+			interruptSupportErr = fmt.Errorf("%w: build info not available", errInterruptUnsupported)
+			return
+		}
+		version := ""
+		for _, dep := range info.Deps {
+			if dep.Path == interruptDriverModule && dep.Replace == nil {
+				version = dep.Version
+			}
+		}
+		if version != interruptDriverVersion {
+			interruptSupportErr = fmt.Errorf("%w: built with %s version %q, expected %q", errInterruptUnsupported, interruptDriverModule, version, interruptDriverVersion)
+			return
+		}
+		if C.rill_duckdb_can_interrupt() == 0 {
+			interruptSupportErr = fmt.Errorf("%w: duckdb::Connection::Interrupt is missing from the DuckDB library", errInterruptUnsupported)
+		}
+	})
+	return interruptSupportErr
+}
+
+// interrupter returns a function that interrupts the query running on conn, making it fail with an error.
+// It can be called concurrently with the query. It returns an error wrapping errInterruptUnsupported if interrupts
+// are not supported (see checkInterruptSupport).
+// The interrupter must not be called after conn has been released.
+func interrupter(ctx context.Context, conn *sqlx.Conn) (func(), error) {
+	err := checkInterruptSupport()
+	if err != nil {
+		return nil, err
+	}
+
+	var handle unsafe.Pointer
+	err = conn.Raw(func(driverConn any) error {
+		// go-duckdb's conn keeps the duckdb_connection in its unexported "con" field (of type *C.duckdb_connection).
+		// A duckdb_connection is a pointer to a duckdb::Connection.
+		v := reflect.ValueOf(driverConn)
+		if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Struct {
+			return fmt.Errorf("%w: unexpected driver connection type %T", errInterruptUnsupported, driverConn)
+		}
+		f := v.Elem().FieldByName("con")
+		if !f.IsValid() || f.Kind() != reflect.Pointer || f.Type().Elem().PkgPath() != interruptDriverModule || f.Type().Elem().Name() != "_Ctype_duckdb_connection" {
+			return fmt.Errorf("%w: driver connection type %T has no duckdb_connection field", errInterruptUnsupported, driverConn)
+		}
+		if f.IsNil() {
+			return fmt.Errorf("%w: driver connection is closed", errInterruptUnsupported)
+		}
+		handle = *(*unsafe.Pointer)(f.UnsafePointer())
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return func() {
+		C.rill_duckdb_interrupt(handle)
+	}, nil
+}
@@ -0,0 +1,26 @@
+package duckdb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// TestInterrupterSupported fails when go-duckdb is upgraded, until interrupter has been checked against the new version.
+func TestInterrupterSupported(t *testing.T) {
+	require.NoError(t, checkInterruptSupport(), "check that interrupter works with the new go-duckdb version and update interruptDriverVersion")
+
+	conn, err := Driver{}.Open("?access_mode=read_write", zap.NewNop())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	sqlConn, err := conn.(*connection).db.Connx(context.Background())
+	require.NoError(t, err)
+	defer sqlConn.Close()
+
+	interrupt, err := interrupter(context.Background(), sqlConn)
+	require.NoError(t, err)
+	require.NotNil(t, interrupt)
+}
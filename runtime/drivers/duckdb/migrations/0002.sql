@@ -0,0 +1,4 @@
+CREATE TABLE rill.reconcile_state (
+	state TEXT NOT NULL,
+	updated_on TIMESTAMPTZ NOT NULL
+);
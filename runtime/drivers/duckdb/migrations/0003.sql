@@ -0,0 +1,19 @@
+-- DuckDB can't alter a table that has an index
+DROP INDEX rill.lower_name_unique_idx;
+ALTER TABLE rill.catalog ADD COLUMN content_hash TEXT DEFAULT '';
+CREATE UNIQUE INDEX lower_name_unique_idx ON rill.catalog (lower(name));
+
+CREATE SEQUENCE rill.catalog_versions_seq;
+
+CREATE TABLE rill.catalog_versions (
+	version BIGINT NOT NULL DEFAULT nextval('rill.catalog_versions_seq'),
+	operation INTEGER NOT NULL,
+	name TEXT NOT NULL,
+	from_name TEXT NOT NULL,
+	type INTEGER NOT NULL,
+	object BLOB NOT NULL,
+	path TEXT NOT NULL,
+	content_hash TEXT NOT NULL,
+	created_on TIMESTAMPTZ NOT NULL,
+	PRIMARY KEY (version)
+);
@@ -0,0 +1,5 @@
+-- DuckDB can't alter a table that has an index
+DROP INDEX rill.lower_name_unique_idx;
+ALTER TABLE rill.catalog ADD COLUMN artifact TEXT DEFAULT '';
+ALTER TABLE rill.catalog_versions ADD COLUMN artifact TEXT DEFAULT '';
+CREATE UNIQUE INDEX lower_name_unique_idx ON rill.catalog (lower(name));
@@ -2,10 +2,14 @@ package duckdb
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/jmoiron/sqlx"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
+	"go.uber.org/zap"
 )
 
 func (c *connection) Dialect() drivers.Dialect {
@@ -36,9 +40,6 @@ func (c *connection) Exec(ctx context.Context, stmt *drivers.Statement) error {
 	if err != nil {
 		return err
 	}
-	if stmt.DryRun {
-		return nil
-	}
 	return res.Close()
 }
 
@@ -55,21 +56,40 @@ func (c *connection) Execute(ctx context.Context, stmt *drivers.Statement) (*dri
 		if err != nil {
 			return nil, err
 		}
+		err = prepared.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		schema, err := describeSchema(ctx, conn, stmt)
+		if err != nil {
+			return nil, err
+		}
+
+		return &drivers.Result{Schema: schema}, nil
+	}
 
-		return nil, prepared.Close()
+	// NOTE: stmt.MaxMemory is not supported. DuckDB's memory_limit is a database-wide setting (there's no per-query limit),
+	// so it's set with the memory_limit option in the DSN instead.
+
+	// Enforce the statement's timeout. The deadline also applies while scanning the result.
+	cancel := func() {}
+	if stmt.Timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, stmt.Timeout)
 	}
 
 	// Acquire connection
 	conn, release, err := c.acquireOLAPConn(ctx, stmt.Priority)
 	if err != nil {
+		cancel()
 		return nil, err
 	}
 	// NOTE: We can't just "defer release()" because release() will block until rows.Close() is called.
 	// We must be careful to make sure release() is called on all code paths.
 
-	rows, err := conn.QueryxContext(ctx, stmt.Query, stmt.Args...)
+	rows, err := c.queryWithDeadline(ctx, conn, stmt.Query, stmt.Args, release)
 	if err != nil {
-		_ = release()
+		cancel()
 		return nil, err
 	}
 
@@ -77,15 +97,177 @@ func (c *connection) Execute(ctx context.Context, stmt *drivers.Statement) (*dri
 	if err != nil {
 		_ = rows.Close()
 		_ = release()
+		cancel()
 		return nil, err
 	}
 
 	res := &drivers.Result{Rows: rows, Schema: schema}
-	res.SetCleanupFunc(release) // Will call release when res.Close() is called.
+	res.SetMaxRows(stmt.MaxRows)
+	// Will call release and cancel the timeout when res.Close() is called.
+	res.SetCleanupFunc(func() error {
+		defer cancel()
+		return release()
+	})
 
 	return res, nil
 }
 
+// ExecuteArrow implements drivers.OLAPStore.
+// go-duckdb doesn't expose DuckDB's Arrow interface, so we convert from the rows returned by Execute.
+// Since go-duckdb scans decimals as floats, the statement's decimal columns are cast to strings to keep them exact.
+func (c *connection) ExecuteArrow(ctx context.Context, stmt *drivers.Statement) (drivers.RecordReader, error) {
+	if stmt.DryRun {
+		res, err := c.Execute(ctx, stmt)
+		if err != nil {
+			return nil, err
+		}
+		return drivers.NewRecordReader(res, drivers.DefaultArrowBatchSize)
+	}
+
+	schema, err := c.describeWithMetaConn(ctx, stmt)
+	if err != nil {
+		return nil, err
+	}
+
+	exactStmt := *stmt
+	exactStmt.Query = decimalsAsStrings(stmt.Query, schema)
+	res, err := c.Execute(ctx, &exactStmt)
+	if err != nil {
+		return nil, err
+	}
+
+	// Report the decimal columns with their actual type
+	if schema != nil && len(schema.Fields) == len(res.Schema.Fields) {
+		res.Schema = schema
+	}
+
+	return drivers.NewRecordReader(res, drivers.DefaultArrowBatchSize)
+}
+
+// describeWithMetaConn returns the schema of the rows that a statement would return, without executing it (see describeSchema).
+func (c *connection) describeWithMetaConn(ctx context.Context, stmt *drivers.Statement) (*runtimev1.StructType, error) {
+	conn, release, err := c.acquireMetaConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = release() }()
+
+	return describeSchema(ctx, conn, stmt)
+}
+
+// decimalsAsStrings wraps a query to cast the DECIMAL columns in its result to VARCHAR.
+// It returns the query unchanged if the result has no DECIMAL columns (or if schema is nil, i.e. the query doesn't return rows).
+func decimalsAsStrings(qry string, schema *runtimev1.StructType) string {
+	if schema == nil {
+		return qry
+	}
+
+	var casts []string
+	for _, f := range schema.Fields {
+		if f.Type.Code == runtimev1.Type_CODE_DECIMAL {
+			casts = append(casts, fmt.Sprintf("CAST(%s AS VARCHAR) AS %s", safeName(f.Name), safeName(f.Name)))
+		}
+	}
+	if len(casts) == 0 {
+		return qry
+	}
+
+	qry = strings.TrimRight(strings.TrimSpace(qry), ";")
+	return fmt.Sprintf("SELECT * REPLACE (%s) FROM (%s)", strings.Join(casts, ", "), qry)
+}
+
+// queryWithDeadline runs a query, but interrupts it when ctx is done.
+// It only returns after the query has stopped running, so a timed out query doesn't keep holding the connection.
+// If interrupts are not supported (see interrupter), it waits for the query to complete before returning ctx.Err().
+// Unless the query is completed before ctx is done, it calls release. Otherwise calling release is left to the caller.
+func (c *connection) queryWithDeadline(ctx context.Context, conn *sqlx.Conn, qry string, args []any, release func() error) (*sqlx.Rows, error) {
+	interrupt, err := interrupter(ctx, conn)
+	if err != nil {
+		if !errors.Is(err, errInterruptUnsupported) {
+			_ = release()
+			return nil, err
+		}
+		c.logInterruptUnsupported.Do(func() {
+			c.logger.Warn("duckdb: cannot interrupt queries, timed out queries will run to completion", zap.Error(err))
+		})
+	}
+
+	type result struct {
+		rows *sqlx.Rows
+		err  error
+	}
+
+	done := make(chan result, 1)
+	go func() {
+		// database/sql closes the rows when ctx is done, which stops scanning after a timeout
+		rows, err := conn.QueryxContext(ctx, qry, args...)
+		done <- result{rows: rows, err: err}
+	}()
+
+	var res result
+	select {
+	case res = <-done:
+	case <-ctx.Done():
+		if interrupt != nil {
+			interrupt()
+		}
+		res = <-done
+	}
+
+	if res.err != nil {
+		_ = release()
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, res.err
+	}
+	if ctx.Err() != nil {
+		_ = res.rows.Close()
+		_ = release()
+		return nil, ctx.Err()
+	}
+	return res.rows, nil
+}
+
+// describeSchema returns the schema of the rows that a statement would return, without executing it.
+// It returns a nil schema for statements that can't be described (i.e. statements that don't return rows, like DDL).
+func describeSchema(ctx context.Context, conn *sqlx.Conn, stmt *drivers.Statement) (*runtimev1.StructType, error) {
+	rows, err := conn.QueryxContext(ctx, "DESCRIBE "+stmt.Query, stmt.Args...)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, nil
+	}
+	defer rows.Close()
+
+	schema := &runtimev1.StructType{}
+	for rows.Next() {
+		var name, typ, null string
+		var key, def, extra any
+		err := rows.Scan(&name, &typ, &null, &key, &def, &extra)
+		if err != nil {
+			return nil, err
+		}
+
+		t, err := databaseTypeToPB(typ, null == "YES")
+		if err != nil {
+			return nil, err
+		}
+
+		schema.Fields = append(schema.Fields, &runtimev1.StructType_Field{
+			Name: name,
+			Type: t,
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return schema, nil
+}
+
 func rowsToSchema(r *sqlx.Rows) (*runtimev1.StructType, error) {
 	if r == nil {
 		return nil, nil
@@ -116,3 +298,8 @@ func rowsToSchema(r *sqlx.Rows) (*runtimev1.StructType, error) {
 
 	return &runtimev1.StructType{Fields: fields}, nil
 }
+
+// safeName quotes a name for use as an identifier in SQL.
+func safeName(name string) string {
+	return fmt.Sprintf(`"%s"`, strings.ReplaceAll(name, `"`, `""`))
+}
@@ -5,7 +5,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/apache/arrow/go/v9/arrow/array"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/drivers/driverstest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
@@ -29,6 +32,129 @@ func TestQuery(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestDryRun(t *testing.T) {
+	conn := prepareConn(t)
+	olap, _ := conn.OLAPStore()
+	defer conn.Close()
+
+	res, err := olap.Execute(context.Background(), &drivers.Statement{
+		Query:  "SELECT bar, SUM(baz) AS total FROM foo GROUP BY bar",
+		DryRun: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Schema.Fields, 2)
+	require.Equal(t, "bar", res.Schema.Fields[0].Name)
+	require.Equal(t, runtimev1.Type_CODE_STRING, res.Schema.Fields[0].Type.Code)
+	require.Equal(t, "total", res.Schema.Fields[1].Name)
+	require.Equal(t, runtimev1.Type_CODE_INT128, res.Schema.Fields[1].Type.Code)
+	require.False(t, res.Next())
+	require.NoError(t, res.Close())
+
+	// statements that don't return rows have no schema
+	res, err = olap.Execute(context.Background(), &drivers.Statement{
+		Query:  "CREATE VIEW dry AS SELECT * FROM foo",
+		DryRun: true,
+	})
+	require.NoError(t, err)
+	require.Nil(t, res.Schema)
+	require.NoError(t, res.Close())
+
+	_, err = olap.Execute(context.Background(), &drivers.Statement{
+		Query:  "SELECT missing FROM foo",
+		DryRun: true,
+	})
+	require.Error(t, err)
+}
+
+func TestExecuteArrow(t *testing.T) {
+	conn := prepareConn(t)
+	olap, _ := conn.OLAPStore()
+	defer conn.Close()
+
+	// decimals are exact
+	rdr, err := olap.ExecuteArrow(context.Background(), &drivers.Statement{
+		Query: "SELECT 12345678901234.5678::DECIMAL(18,4) AS \"d\"\"d\", 1 AS i;",
+	})
+	require.NoError(t, err)
+	require.Equal(t, runtimev1.Type_CODE_DECIMAL, rdr.ResultSchema().Fields[0].Type.Code)
+	require.Equal(t, `d"d`, rdr.Schema().Field(0).Name)
+	require.True(t, rdr.Next())
+	require.Equal(t, "12345678901234.5678", rdr.Record().Column(0).(*array.String).Value(0))
+	require.Equal(t, int32(1), rdr.Record().Column(1).(*array.Int32).Value(0))
+	require.False(t, rdr.Next())
+	require.NoError(t, rdr.Err())
+	rdr.Release()
+
+	// dry runs of statements that don't return rows have an empty schema
+	rdr, err = olap.ExecuteArrow(context.Background(), &drivers.Statement{Query: "CREATE VIEW arrow_view AS SELECT * FROM foo", DryRun: true})
+	require.NoError(t, err)
+	require.Nil(t, rdr.ResultSchema())
+	require.Len(t, rdr.Schema().Fields(), 0)
+	require.False(t, rdr.Next())
+	require.NoError(t, rdr.Err())
+	rdr.Release()
+}
+
+func TestQueryLimits(t *testing.T) {
+	conn := prepareConn(t)
+	olap, _ := conn.OLAPStore()
+	defer conn.Close()
+
+	// max rows
+	rows, err := olap.Execute(context.Background(), &drivers.Statement{Query: "SELECT * FROM foo", MaxRows: 2})
+	require.NoError(t, err)
+	n := 0
+	for rows.Next() {
+		n++
+	}
+	require.Equal(t, 2, n)
+	require.ErrorIs(t, rows.Err(), drivers.ErrMaxRowsExceeded)
+	require.NoError(t, rows.Close())
+
+	rows, err = olap.Execute(context.Background(), &drivers.Statement{Query: "SELECT * FROM foo", MaxRows: 4})
+	require.NoError(t, err)
+	for rows.Next() {
+	}
+	require.NoError(t, rows.Err())
+	require.NoError(t, rows.Close())
+
+	// max memory is not supported, since DuckDB's memory limit is database-wide
+	memoryLimit := func(stmt *drivers.Statement) string {
+		rows, err := olap.Execute(context.Background(), stmt)
+		require.NoError(t, err)
+		defer rows.Close()
+		var limit string
+		require.True(t, rows.Next())
+		require.NoError(t, rows.Scan(&limit))
+		return limit
+	}
+	qry := "SELECT current_setting('memory_limit')"
+	require.Equal(t, memoryLimit(&drivers.Statement{Query: qry}), memoryLimit(&drivers.Statement{Query: qry, MaxMemory: 100 << 20}))
+}
+
+func TestQueryTimeout(t *testing.T) {
+	conn, err := Driver{}.Open("?access_mode=read_write&rill_pool_size=2", zap.NewNop())
+	require.NoError(t, err)
+	olap, _ := conn.OLAPStore()
+	defer conn.Close()
+
+	// the query is interrupted, so the timeout is returned long before the query would complete
+	start := time.Now()
+	_, err = olap.Execute(context.Background(), &drivers.Statement{
+		Query:   "SELECT SUM(a.range * b.range) FROM range(1000000000) a CROSS JOIN range(100) b",
+		Timeout: 100 * time.Millisecond,
+	})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Less(t, time.Since(start), 5*time.Second)
+
+	// the timed out query doesn't keep holding the only OLAP connection
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	rows, err := olap.Execute(ctx, &drivers.Statement{Query: "SELECT 1"})
+	require.NoError(t, err)
+	require.NoError(t, rows.Close())
+}
+
 func TestPriorityQueue(t *testing.T) {
 	if testing.Short() {
 		t.Skip("duckdb: skipping test in short mode")
@@ -221,3 +347,13 @@ func prepareConn(t *testing.T) drivers.Connection {
 
 	return conn
 }
+
+func TestOLAPScheduling(t *testing.T) {
+	// With a pool size of 2, one connection is reserved for meta queries and one for OLAP queries
+	conn, err := Driver{}.Open("?access_mode=read_write&rill_pool_size=2", zap.NewNop())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	olap, _ := conn.OLAPStore()
+	driverstest.TestOLAPScheduling(t, olap, conn.(*connection).olapSem.Waiting)
+}
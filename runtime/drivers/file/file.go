@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"sync"
 
 	"github.com/rilldata/rill/runtime/drivers"
 	"go.uber.org/zap"
@@ -16,7 +17,7 @@ func init() {
 type driver struct{}
 
 func (d driver) Open(dsn string, logger *zap.Logger) (drivers.Connection, error) {
-	c := &connection{root: dsn}
+	c := &connection{root: dsn, logger: logger}
 	if err := c.checkRoot(); err != nil {
 		return nil, err
 	}
@@ -25,6 +26,9 @@ func (d driver) Open(dsn string, logger *zap.Logger) (drivers.Connection, error)
 
 type connection struct {
 	root string
+	// lock makes the precondition checks and write in Put atomic (within this process)
+	lock   sync.Mutex
+	logger *zap.Logger
 }
 
 // Close implements drivers.Connection.
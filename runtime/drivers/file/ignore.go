@@ -0,0 +1,115 @@
+package file
+
+import (
+	"bufio"
+	"errors"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
+)
+
+// IgnoreFileName is the name of the file in the repo root that lists paths to skip (in .gitignore syntax).
+// Ignored paths are not returned by ListRecursive and not watched for changes.
+const IgnoreFileName = ".rillignore"
+
+// defaultIgnorePatterns are always skipped, even without a .rillignore file.
+var defaultIgnorePatterns = []string{".git/"}
+
+// ignoreMatcher loads the ignore rules for the repo.
+// It's loaded on every call, so changes to the ignore file apply without reopening the connection.
+func (c *connection) ignoreMatcher() (gitignore.Matcher, error) {
+	var ps []gitignore.Pattern
+	for _, p := range defaultIgnorePatterns {
+		ps = append(ps, gitignore.ParsePattern(p, nil))
+	}
+
+	f, err := os.Open(filepath.Join(c.root, IgnoreFileName))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return gitignore.NewMatcher(ps), nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		ps = append(ps, gitignore.ParsePattern(line, nil))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return gitignore.NewMatcher(ps), nil
+}
+
+// ignoreFS wraps a file system and hides paths matched by the ignore rules.
+// Hiding ignored directories (instead of filtering matches) means glob walks never traverse them.
+type ignoreFS struct {
+	fsys    fs.FS
+	matcher gitignore.Matcher
+}
+
+var (
+	_ fs.ReadDirFS = ignoreFS{}
+	_ fs.StatFS    = ignoreFS{}
+)
+
+// Open implements fs.FS.
+func (f ignoreFS) Open(name string) (fs.File, error) {
+	if _, err := f.Stat(name); err != nil {
+		return nil, err
+	}
+	return f.fsys.Open(name)
+}
+
+// Stat implements fs.StatFS.
+func (f ignoreFS) Stat(name string) (fs.FileInfo, error) {
+	info, err := fs.Stat(f.fsys, name)
+	if err != nil {
+		return nil, err
+	}
+	if isIgnored(f.matcher, name, info.IsDir()) {
+		return nil, &fs.PathError{Op: "stat", Path: name, Err: fs.ErrNotExist}
+	}
+	return info, nil
+}
+
+// ReadDir implements fs.ReadDirFS.
+func (f ignoreFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	entries, err := fs.ReadDir(f.fsys, name)
+	if err != nil {
+		return nil, err
+	}
+
+	res := entries[:0]
+	for _, e := range entries {
+		if !isIgnored(f.matcher, path.Join(name, e.Name()), e.IsDir()) {
+			res = append(res, e)
+		}
+	}
+	return res, nil
+}
+
+// isIgnored returns true if name (a slash-separated path relative to the repo root) or one of its parents is ignored.
+func isIgnored(matcher gitignore.Matcher, name string, isDir bool) bool {
+	if name == "." {
+		return false
+	}
+
+	parts := strings.Split(name, "/")
+	for i := 1; i < len(parts); i++ {
+		if matcher.Match(parts[:i], true) {
+			return true
+		}
+	}
+	return matcher.Match(parts, isDir)
+}
@@ -2,12 +2,17 @@ package file
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
 	"io/fs"
 	"os"
 	"path"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
@@ -15,8 +20,6 @@ import (
 	"github.com/rilldata/rill/runtime/drivers"
 )
 
-var limit = 500
-
 // Driver implements drivers.RepoStore.
 func (c *connection) Driver() string {
 	return "file"
@@ -27,39 +30,115 @@ func (c *connection) DSN() string {
 	return c.root
 }
 
+// Root implements drivers.RepoLocalStore.
+func (c *connection) Root() string {
+	return c.root
+}
+
 // ListRecursive implements drivers.RepoStore.
-func (c *connection) ListRecursive(ctx context.Context, instID, glob string) ([]string, error) {
+// It skips paths matched by the repo's ignore rules (see IgnoreFileName).
+// The page token is the last path of the previous page, encoded to keep it opaque to clients.
+func (c *connection) ListRecursive(ctx context.Context, instID, glob string, pageSize int, pageToken string) ([]string, string, error) {
 	// Check that folder hasn't been moved
 	if err := c.checkRoot(); err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
-	fsRoot := os.DirFS(c.root)
+	after, err := decodePageToken(pageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	matcher, err := c.ignoreMatcher()
+	if err != nil {
+		return nil, "", err
+	}
+
+	fsRoot := pathOrderFS{ignoreFS{fsys: os.DirFS(c.root), matcher: matcher}}
 	glob = path.Clean(path.Join("./", glob))
+	base, _ := doublestar.SplitPattern(glob)
 
+	// The walk visits files sorted by path (see pathOrderFS), so it stops once it has found a path past the page
 	var paths []string
-	err := doublestar.GlobWalk(fsRoot, glob, func(p string, d fs.DirEntry) error {
-		// Don't track directories
+	err = fs.WalkDir(fsRoot, base, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// Like a glob, the walk doesn't fail if the glob's base directory doesn't exist
+			if p == base && errors.Is(err, fs.ErrNotExist) {
+				return fs.SkipDir
+			}
+			return err
+		}
+
+		// p is relative to the FS root
+		repoPath := filepath.Join("/", p)
+
+		// Don't track directories, and skip those that only contain paths up to the page token
 		if d.IsDir() {
+			dirPrefix := repoPath + "/"
+			if p != base && dirPrefix < after && !strings.HasPrefix(after, dirPrefix) {
+				return fs.SkipDir
+			}
 			return nil
 		}
 
-		// Exit if we reached the limit
-		if len(paths) == limit {
-			return fmt.Errorf("glob exceeded limit of %d matched files", limit)
+		if repoPath <= after {
+			return nil
+		}
+		ok, err := doublestar.Match(glob, p)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
 		}
 
-		// Track file (p is already relative to the FS root)
-		p = filepath.Join("/", p)
-		paths = append(paths, p)
-
+		paths = append(paths, repoPath)
+		if pageSize > 0 && len(paths) > pageSize {
+			return errPageFull
+		}
 		return nil
 	})
+	if err != nil && !errors.Is(err, errPageFull) {
+		return nil, "", err
+	}
+
+	if pageSize <= 0 || len(paths) <= pageSize {
+		return paths, "", nil
+	}
+
+	paths = paths[:pageSize]
+	return paths, encodePageToken(paths[len(paths)-1]), nil
+}
+
+// errPageFull stops a walk in ListRecursive once it has found more paths than fit in a page.
+var errPageFull = errors.New("page full")
+
+// pathOrderFS lists directory entries in the order of the paths they contain, which differs from sorting them by name
+// when a directory's name is a prefix of another entry's name (e.g. the path "/a/b" sorts after "/a.sql", but the name "a" sorts before "a.sql").
+// Walking it with fs.WalkDir visits files sorted by their full paths.
+type pathOrderFS struct {
+	fs.ReadDirFS
+}
+
+// ReadDir implements fs.ReadDirFS.
+func (f pathOrderFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	entries, err := f.ReadDirFS.ReadDir(name)
 	if err != nil {
 		return nil, err
 	}
 
-	return paths, nil
+	sort.Slice(entries, func(i, j int) bool {
+		return pathOrderKey(entries[i]) < pathOrderKey(entries[j])
+	})
+	return entries, nil
+}
+
+// pathOrderKey returns the key that directory entries are sorted by in pathOrderFS.
+func pathOrderKey(e fs.DirEntry) string {
+	if e.IsDir() {
+		return e.Name() + "/"
+	}
+	return e.Name()
 }
 
 // Get implements drivers.RepoStore.
@@ -83,22 +162,63 @@ func (c *connection) Stat(ctx context.Context, instID, filePath string) (*driver
 		return nil, err
 	}
 
+	version, err := fileVersion(filePath)
+	if err != nil {
+		return nil, err
+	}
+
 	return &drivers.RepoObjectStat{
 		LastUpdated: info.ModTime(),
+		Version:     version,
 	}, nil
 }
 
 // Put implements drivers.RepoStore.
-func (c *connection) Put(ctx context.Context, instID, filePath string, reader io.Reader) error {
+func (c *connection) Put(ctx context.Context, instID, filePath string, reader io.Reader, opts drivers.PutOptions) error {
 	filePath = filepath.Join(c.root, filePath)
 
-	err := os.MkdirAll(filepath.Dir(filePath), os.ModePerm)
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	// Check preconditions
+	_, err := os.Stat(filePath)
+	exists := err == nil
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if exists && opts.CreateOnly {
+		return drivers.ErrFileAlreadyExists
+	}
+	if !exists && !opts.Create {
+		return drivers.ErrNotFound
+	}
+	if opts.ExpectedVersion != "" {
+		if !exists {
+			return drivers.ErrVersionConflict
+		}
+		version, err := fileVersion(filePath)
+		if err != nil {
+			return err
+		}
+		if version != opts.ExpectedVersion {
+			return drivers.ErrVersionConflict
+		}
+	}
+
+	err = os.MkdirAll(filepath.Dir(filePath), os.ModePerm)
 	if err != nil {
 		return err
 	}
 
-	f, err := os.Create(filePath)
+	flag := os.O_RDWR | os.O_CREATE | os.O_TRUNC
+	if opts.CreateOnly {
+		flag |= os.O_EXCL
+	}
+	f, err := os.OpenFile(filePath, flag, 0o666)
 	if err != nil {
+		if os.IsExist(err) {
+			return drivers.ErrFileAlreadyExists
+		}
 		return err
 	}
 	defer f.Close()
@@ -131,3 +251,37 @@ func (c *connection) Delete(ctx context.Context, instID, filePath string) error
 	filePath = filepath.Join(c.root, filePath)
 	return os.Remove(filePath)
 }
+
+// encodePageToken returns a page token for listing paths after p.
+func encodePageToken(p string) string {
+	return base64.URLEncoding.EncodeToString([]byte(p))
+}
+
+// decodePageToken returns the path that a page token lists after.
+func decodePageToken(token string) (string, error) {
+	if token == "" {
+		return "", nil
+	}
+	p, err := base64.URLEncoding.DecodeString(token)
+	if err != nil {
+		return "", fmt.Errorf("invalid page token: %w", err)
+	}
+	return string(p), nil
+}
+
+// fileVersion returns a hash of the file's contents, which serves as its version
+func fileVersion(filePath string) (string, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	_, err = io.Copy(h, f)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,184 @@
+package file
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
+	"go.uber.org/zap"
+)
+
+// watchDebounce is how long to wait for further changes before emitting a batch of changed paths.
+// Editors often emit several events for a single save (e.g. write to a temp file, then rename it).
+var watchDebounce = 250 * time.Millisecond
+
+// Watch implements drivers.RepoWatcher.
+// It ignores changes in hidden directories (like .git) and paths matched by the ignore rules when the watch started.
+func (c *connection) Watch(ctx context.Context, instID string) (<-chan []string, error) {
+	if err := c.checkRoot(); err != nil {
+		return nil, err
+	}
+
+	matcher, err := c.ignoreMatcher()
+	if err != nil {
+		return nil, err
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+
+	// fsnotify is not recursive, so we add every directory in the repo.
+	// We also track the files in them, since removing or renaming a directory only reports the directory's path.
+	known := make(map[string]bool)
+	err = c.watchDirs(watcher, c.root, matcher, known)
+	if err != nil {
+		watcher.Close()
+		return nil, err
+	}
+
+	ch := make(chan []string)
+	go func() {
+		defer close(ch)
+		defer watcher.Close()
+
+		pending := make(map[string]bool)
+		timer := time.NewTimer(watchDebounce)
+		timer.Stop()
+		defer timer.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				c.logger.Warn("repo watcher error", zap.String("root", c.root), zap.Error(err))
+			case e, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+
+				// Permission changes don't affect contents
+				if e.Op == fsnotify.Chmod {
+					continue
+				}
+
+				p, ok := c.repoPath(e.Name, matcher)
+				if !ok {
+					continue
+				}
+				pending[p] = true
+
+				// Report the files that were in removed or moved directories
+				if e.Op&(fsnotify.Remove|fsnotify.Rename) != 0 {
+					delete(known, p)
+					for k := range known {
+						if strings.HasPrefix(k, p+"/") {
+							pending[k] = true
+							delete(known, k)
+						}
+					}
+					// A moved directory is still watched under its old path
+					_ = watcher.Remove(e.Name)
+				}
+
+				// Start watching new directories, and track files that were moved into them
+				if e.Op&fsnotify.Create != 0 {
+					_ = filepath.WalkDir(e.Name, func(fullPath string, d fs.DirEntry, err error) error {
+						if err != nil {
+							return nil
+						}
+						if d.IsDir() {
+							return c.watchDir(watcher, fullPath, d, matcher)
+						}
+						if p, ok := c.repoPath(fullPath, matcher); ok {
+							pending[p] = true
+							known[p] = true
+						}
+						return nil
+					})
+				}
+
+				timer.Reset(watchDebounce)
+			case <-timer.C:
+				paths := make([]string, 0, len(pending))
+				for p := range pending {
+					paths = append(paths, p)
+				}
+				sort.Strings(paths)
+				pending = make(map[string]bool)
+
+				select {
+				case ch <- paths:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// watchDirs adds dir and all its non-hidden, non-ignored subdirectories to the watcher, and adds the repo paths of their files to known.
+func (c *connection) watchDirs(watcher *fsnotify.Watcher, dir string, matcher gitignore.Matcher, known map[string]bool) error {
+	return filepath.WalkDir(dir, func(fullPath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			if p, ok := c.repoPath(fullPath, matcher); ok {
+				known[p] = true
+			}
+			return nil
+		}
+		return c.watchDir(watcher, fullPath, d, matcher)
+	})
+}
+
+// watchDir adds a single directory to the watcher. It returns fs.SkipDir for hidden and ignored directories.
+func (c *connection) watchDir(watcher *fsnotify.Watcher, fullPath string, d fs.DirEntry, matcher gitignore.Matcher) error {
+	if fullPath == c.root {
+		return watcher.Add(fullPath)
+	}
+	if strings.HasPrefix(d.Name(), ".") {
+		return fs.SkipDir
+	}
+	if rel, err := filepath.Rel(c.root, fullPath); err == nil && isIgnored(matcher, filepath.ToSlash(rel), true) {
+		return fs.SkipDir
+	}
+	return watcher.Add(fullPath)
+}
+
+// repoPath converts a full path to a repo path (as returned by ListRecursive).
+// It returns false for paths outside the repo, inside hidden directories or matched by the ignore rules.
+func (c *connection) repoPath(fullPath string, matcher gitignore.Matcher) (string, bool) {
+	rel, err := filepath.Rel(c.root, fullPath)
+	if err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+		return "", false
+	}
+	rel = filepath.ToSlash(rel)
+
+	parts := strings.Split(rel, "/")
+	for _, part := range parts[:len(parts)-1] {
+		if strings.HasPrefix(part, ".") {
+			return "", false
+		}
+	}
+
+	// Deleted paths can't be stat'ed, so we match them as files (directory rules still apply to their parents)
+	if isIgnored(matcher, rel, false) {
+		return "", false
+	}
+
+	return "/" + rel, true
+}
@@ -0,0 +1,52 @@
+package git
+
+import (
+	"fmt"
+	"net/url"
+)
+
+const (
+	authorNameKey  = "author_name"
+	authorEmailKey = "author_email"
+)
+
+// config represents the driver config, extracted from the DSN
+type config struct {
+	// Path to the local git repository
+	Path string
+	// AuthorName is the name of the author recorded on commits
+	AuthorName string
+	// AuthorEmail is the email of the author recorded on commits
+	AuthorEmail string
+}
+
+func newConfig(dsn string) (*config, error) {
+	// Parse DSN as URL (which should be "path?key=val&...")
+	uri, err := url.Parse(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse dsn: %w", err)
+	}
+	qry, err := url.ParseQuery(uri.RawQuery)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse dsn: %w", err)
+	}
+
+	cfg := &config{
+		Path:        uri.Path,
+		AuthorName:  "Rill",
+		AuthorEmail: "",
+	}
+
+	if qry.Has(authorNameKey) {
+		cfg.AuthorName = qry.Get(authorNameKey)
+	}
+	if qry.Has(authorEmailKey) {
+		cfg.AuthorEmail = qry.Get(authorEmailKey)
+	}
+
+	if cfg.Path == "" {
+		return nil, fmt.Errorf("git driver: path to repository not set")
+	}
+
+	return cfg, nil
+}
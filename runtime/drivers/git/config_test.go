@@ -0,0 +1,27 @@
+package git
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig(t *testing.T) {
+	cfg, err := newConfig("path/to/repo")
+	require.NoError(t, err)
+	require.Equal(t, "path/to/repo", cfg.Path)
+	require.Equal(t, "Rill", cfg.AuthorName)
+	require.Equal(t, "", cfg.AuthorEmail)
+
+	cfg, err = newConfig("/path/to/repo?author_name=Jane%20Doe&author_email=jane@example.com")
+	require.NoError(t, err)
+	require.Equal(t, "/path/to/repo", cfg.Path)
+	require.Equal(t, "Jane Doe", cfg.AuthorName)
+	require.Equal(t, "jane@example.com", cfg.AuthorEmail)
+
+	_, err = newConfig("")
+	require.Error(t, err)
+
+	_, err = newConfig("?author_name=foo")
+	require.Error(t, err)
+}
@@ -0,0 +1,96 @@
+package git
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/rilldata/rill/runtime/drivers"
+	"go.uber.org/zap"
+
+	// Load file driver, which serves reads and writes against the repository's worktree
+	_ "github.com/rilldata/rill/runtime/drivers/file"
+)
+
+func init() {
+	drivers.Register("git", driver{})
+}
+
+type driver struct{}
+
+// Open opens a local git repository. It initializes a new repository if the directory isn't already one.
+func (d driver) Open(dsn string, logger *zap.Logger) (drivers.Connection, error) {
+	cfg, err := newConfig(dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	// The file driver checks that the directory exists
+	fileConn, err := drivers.Open("file", cfg.Path, logger)
+	if err != nil {
+		return nil, err
+	}
+	files, _ := fileConn.RepoStore()
+
+	repo, err := git.PlainOpen(cfg.Path)
+	if errors.Is(err, git.ErrRepositoryNotExists) {
+		repo, err = git.PlainInit(cfg.Path, false)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &connection{
+		dsn:    dsn,
+		config: cfg,
+		files:  files,
+		repo:   repo,
+		logger: logger,
+	}, nil
+}
+
+type connection struct {
+	dsn    string
+	config *config
+	files  drivers.RepoStore
+	repo   *git.Repository
+	// lock serializes writes since the worktree and index are not safe for concurrent use
+	lock   sync.Mutex
+	logger *zap.Logger
+}
+
+// Close implements drivers.Connection.
+func (c *connection) Close() error {
+	return nil
+}
+
+// Registry implements drivers.Connection.
+func (c *connection) RegistryStore() (drivers.RegistryStore, bool) {
+	return nil, false
+}
+
+// Catalog implements drivers.Connection.
+func (c *connection) CatalogStore() (drivers.CatalogStore, bool) {
+	return nil, false
+}
+
+// Repo implements drivers.Connection.
+func (c *connection) RepoStore() (drivers.RepoStore, bool) {
+	return c, true
+}
+
+// OLAP implements drivers.Connection.
+func (c *connection) OLAPStore() (drivers.OLAPStore, bool) {
+	return nil, false
+}
+
+// Migrate implements drivers.Connection.
+func (c *connection) Migrate(ctx context.Context) (err error) {
+	return nil
+}
+
+// MigrationStatus implements drivers.Connection.
+func (c *connection) MigrationStatus(ctx context.Context) (current, desired int, err error) {
+	return 0, 0, nil
+}
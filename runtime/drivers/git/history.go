@@ -0,0 +1,129 @@
+package git
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+// ListRevisions implements drivers.RepoHistoryStore.
+func (c *connection) ListRevisions(ctx context.Context, instID, filePath string) ([]*drivers.RepoRevision, error) {
+	p := gitPath(filePath)
+
+	iter, err := c.repo.Log(&git.LogOptions{FileName: &p})
+	if err != nil {
+		if errors.Is(err, plumbing.ErrReferenceNotFound) {
+			// No commits yet
+			return nil, c.checkUncommitted(ctx, instID, filePath)
+		}
+		return nil, err
+	}
+	defer iter.Close()
+
+	var revs []*drivers.RepoRevision
+	err = iter.ForEach(func(commit *object.Commit) error {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		revs = append(revs, revisionFromCommit(commit))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(revs) == 0 {
+		return nil, c.checkUncommitted(ctx, instID, filePath)
+	}
+
+	return revs, nil
+}
+
+// GetRevision implements drivers.RepoHistoryStore.
+func (c *connection) GetRevision(ctx context.Context, instID, filePath, revision string) (string, *drivers.RepoRevision, error) {
+	commit, err := c.resolveRevision(revision)
+	if err != nil {
+		return "", nil, err
+	}
+
+	f, err := commit.File(gitPath(filePath))
+	if err != nil {
+		if errors.Is(err, object.ErrFileNotFound) {
+			return "", nil, drivers.ErrNotFound
+		}
+		return "", nil, err
+	}
+
+	blob, err := f.Contents()
+	if err != nil {
+		return "", nil, err
+	}
+
+	return blob, revisionFromCommit(commit), nil
+}
+
+// Revert implements drivers.RepoHistoryStore.
+// If the file didn't exist as of the revision, it's deleted.
+func (c *connection) Revert(ctx context.Context, instID, filePath, revision string) error {
+	blob, _, err := c.GetRevision(ctx, instID, filePath, revision)
+	if err != nil && !errors.Is(err, drivers.ErrNotFound) {
+		return err
+	}
+	exists := err == nil
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if exists {
+		err = c.files.Put(ctx, instID, filePath, strings.NewReader(blob), drivers.PutOptions{Create: true})
+	} else {
+		err = c.files.Delete(ctx, instID, filePath)
+	}
+	if err != nil {
+		return err
+	}
+
+	return c.commit(fmt.Sprintf("Revert %s to %s", gitPath(filePath), revision), filePath)
+}
+
+// checkUncommitted returns drivers.ErrNotFound if a file without revisions doesn't exist in the worktree either.
+func (c *connection) checkUncommitted(ctx context.Context, instID, filePath string) error {
+	_, err := c.files.Stat(ctx, instID, filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return drivers.ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// resolveRevision looks up the commit for a revision, which can be a hash or any other revision supported by git (such as "HEAD~1").
+func (c *connection) resolveRevision(revision string) (*object.Commit, error) {
+	hash, err := c.repo.ResolveRevision(plumbing.Revision(revision))
+	if err != nil {
+		if errors.Is(err, plumbing.ErrReferenceNotFound) || errors.Is(err, plumbing.ErrObjectNotFound) {
+			return nil, drivers.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return c.repo.CommitObject(*hash)
+}
+
+func revisionFromCommit(commit *object.Commit) *drivers.RepoRevision {
+	return &drivers.RepoRevision{
+		ID:          commit.Hash.String(),
+		AuthorName:  commit.Author.Name,
+		AuthorEmail: commit.Author.Email,
+		Message:     strings.TrimSpace(commit.Message),
+		CreatedOn:   commit.Author.When,
+	}
+}
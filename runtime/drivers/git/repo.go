@@ -0,0 +1,150 @@
+package git
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/format/index"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+// Driver implements drivers.RepoStore.
+func (c *connection) Driver() string {
+	return "git"
+}
+
+// DSN implements drivers.RepoStore.
+func (c *connection) DSN() string {
+	return c.dsn
+}
+
+// Root implements drivers.RepoLocalStore.
+// Files are read and written in the repository's worktree.
+func (c *connection) Root() string {
+	return c.config.Path
+}
+
+// ListRecursive implements drivers.RepoStore.
+// The underlying file driver skips the repository's .git directory.
+func (c *connection) ListRecursive(ctx context.Context, instID, glob string, pageSize int, pageToken string) ([]string, string, error) {
+	return c.files.ListRecursive(ctx, instID, glob, pageSize, pageToken)
+}
+
+// Get implements drivers.RepoStore.
+func (c *connection) Get(ctx context.Context, instID, filePath string) (string, error) {
+	return c.files.Get(ctx, instID, filePath)
+}
+
+// Stat implements drivers.RepoStore.
+func (c *connection) Stat(ctx context.Context, instID, filePath string) (*drivers.RepoObjectStat, error) {
+	return c.files.Stat(ctx, instID, filePath)
+}
+
+// Put implements drivers.RepoStore.
+// It commits the new contents of the file.
+func (c *connection) Put(ctx context.Context, instID, filePath string, reader io.Reader, opts drivers.PutOptions) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	err := c.files.Put(ctx, instID, filePath, reader, opts)
+	if err != nil {
+		return err
+	}
+
+	return c.commit(fmt.Sprintf("Update %s", gitPath(filePath)), filePath)
+}
+
+// Rename implements drivers.RepoStore.
+// It commits the removal of the old path and the addition of the new path.
+func (c *connection) Rename(ctx context.Context, instID, fromPath, toPath string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	err := c.files.Rename(ctx, instID, fromPath, toPath)
+	if err != nil {
+		return err
+	}
+
+	return c.commit(fmt.Sprintf("Rename %s to %s", gitPath(fromPath), gitPath(toPath)), fromPath, toPath)
+}
+
+// Delete implements drivers.RepoStore.
+// It commits the removal of the file.
+func (c *connection) Delete(ctx context.Context, instID, filePath string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	err := c.files.Delete(ctx, instID, filePath)
+	if err != nil {
+		return err
+	}
+
+	return c.commit(fmt.Sprintf("Delete %s", gitPath(filePath)), filePath)
+}
+
+// commit stages the current state of the given paths in the worktree and commits them.
+// It doesn't create a commit if the staged paths are unchanged. It must be called while holding c.lock.
+func (c *connection) commit(msg string, paths ...string) error {
+	wt, err := c.repo.Worktree()
+	if err != nil {
+		return err
+	}
+
+	for i, p := range paths {
+		paths[i] = gitPath(p)
+
+		// Add also stages deletes, but errors for removed files that were never tracked
+		_, err := wt.Add(paths[i])
+		if err != nil && !errors.Is(err, index.ErrEntryNotFound) {
+			return err
+		}
+	}
+
+	status, err := wt.Status()
+	if err != nil {
+		return err
+	}
+
+	changed := false
+	for _, p := range paths {
+		s := status.File(p).Staging
+		if s != git.Unmodified && s != git.Untracked {
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+
+	_, err = wt.Commit(msg, &git.CommitOptions{
+		Author: &object.Signature{
+			Name:  c.config.AuthorName,
+			Email: c.config.AuthorEmail,
+			When:  time.Now(),
+		},
+	})
+	return err
+}
+
+// gitPath converts a repo path to a path relative to the root of the git worktree
+func gitPath(p string) string {
+	return strings.TrimPrefix(path.Clean(filepath.ToSlash(filepath.Join("/", p))), "/")
+}
+
+// Watch implements drivers.RepoWatcher.
+// Changes in the .git directory are ignored.
+func (c *connection) Watch(ctx context.Context, instID string) (<-chan []string, error) {
+	watcher, ok := c.files.(drivers.RepoWatcher)
+	if !ok {
+		return nil, fmt.Errorf("git driver: file repo does not support watching")
+	}
+	return watcher.Watch(ctx, instID)
+}
@@ -3,6 +3,7 @@ package drivers
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
@@ -12,6 +13,9 @@ import (
 // ErrUnsupportedConnector is returned from Ingest for unsupported connectors.
 var ErrUnsupportedConnector = errors.New("drivers: connector not supported")
 
+// ErrMaxRowsExceeded is returned from Result.Err when a query returns more rows than Statement.MaxRows.
+var ErrMaxRowsExceeded = errors.New("drivers: query returned too many rows")
+
 // WithConnectionFunc is a callback function that provides a context to be used in further OLAP store calls to enforce affinity to a single connection.
 // It's called with two contexts: wrappedCtx wraps the input context (including cancellation),
 // and ensuredCtx wraps a background context (ensuring it can never be cancelled).
@@ -23,6 +27,9 @@ type OLAPStore interface {
 	WithConnection(ctx context.Context, priority int, fn WithConnectionFunc) error
 	Exec(ctx context.Context, stmt *Statement) error
 	Execute(ctx context.Context, stmt *Statement) (*Result, error)
+	// ExecuteArrow executes a statement and returns the result as Arrow records.
+	// For dry run statements, the reader only has a schema and no records.
+	ExecuteArrow(ctx context.Context, stmt *Statement) (RecordReader, error)
 	Ingest(ctx context.Context, env *connectors.Env, source *connectors.Source) error
 	InformationSchema() InformationSchema
 }
@@ -33,19 +40,68 @@ type Statement struct {
 	Args     []any
 	DryRun   bool
 	Priority int
+	// Timeout cancels the statement if it hasn't completed in time. If 0, only ctx's deadline applies.
+	Timeout time.Duration
+	// MaxRows makes the result fail with ErrMaxRowsExceeded if the query returns more rows. If 0, there's no limit.
+	MaxRows int64
+	// MaxMemory limits the memory (in bytes) the OLAP store may use to execute the statement. If 0, the store's default applies.
+	// It's not supported by all drivers.
+	MaxMemory int64
 }
 
 // Result wraps the results of query.
+// For dry run statements, Rows is nil and the Result only describes the Schema the statement would return.
 type Result struct {
 	*sqlx.Rows
 	Schema    *runtimev1.StructType
 	cleanupFn func() error
+	maxRows   int64
+	rowCount  int64
+	err       error
+}
+
+// SetMaxRows limits the number of rows that can be scanned from the Result (see Statement.MaxRows).
+func (r *Result) SetMaxRows(n int64) {
+	r.maxRows = n
+}
+
+// Next wraps rows.Next and enforces the Result's max rows.
+func (r *Result) Next() bool {
+	if r.Rows == nil || r.err != nil || !r.Rows.Next() {
+		return false
+	}
+	r.rowCount++
+	if r.maxRows > 0 && r.rowCount > r.maxRows {
+		r.err = ErrMaxRowsExceeded
+		return false
+	}
+	return true
+}
+
+// Err wraps rows.Err and returns ErrMaxRowsExceeded if the Result's max rows was exceeded.
+func (r *Result) Err() error {
+	if r.err != nil {
+		return r.err
+	}
+	if r.Rows == nil {
+		return nil
+	}
+	return r.Rows.Err()
 }
 
 // SetCleanupFunc sets a function, which will be called when the Result is closed.
+// If a cleanup function is already set, fn is called after it (e.g. to release resources held by the caller of the driver).
 func (r *Result) SetCleanupFunc(fn func() error) {
 	if r.cleanupFn != nil {
-		panic("cleanup function already set")
+		prev := r.cleanupFn
+		r.cleanupFn = func() error {
+			err := prev()
+			if fnErr := fn(); err == nil {
+				err = fnErr
+			}
+			return err
+		}
+		return
 	}
 	r.cleanupFn = fn
 }
@@ -53,7 +109,10 @@ func (r *Result) SetCleanupFunc(fn func() error) {
 // Close wraps rows.Close and calls the Result's cleanup function (if it is set).
 // Close should be idempotent.
 func (r *Result) Close() error {
-	firstErr := r.Rows.Close()
+	var firstErr error
+	if r.Rows != nil {
+		firstErr = r.Rows.Close()
+	}
 	if r.cleanupFn != nil {
 		err := r.cleanupFn()
 		if firstErr == nil {
@@ -68,6 +127,8 @@ func (r *Result) Close() error {
 }
 
 // InformationSchema contains information about existing tables in an OLAP driver.
+// All lists tables across all databases and schemas (excluding system schemas).
+// Lookup accepts an unqualified or qualified name (see ParseTableName). Unqualified parts resolve to the default database and schema.
 type InformationSchema interface {
 	All(ctx context.Context) ([]*Table, error)
 	Lookup(ctx context.Context, name string) (*Table, error)
@@ -75,10 +136,29 @@ type InformationSchema interface {
 
 // Table represents a table in an information schema.
 type Table struct {
-	Database       string
-	DatabaseSchema string
-	Name           string
-	Schema         *runtimev1.StructType
+	Database                string
+	DatabaseSchema          string
+	IsDefaultDatabase       bool
+	IsDefaultDatabaseSchema bool
+	Name                    string
+	// View is true if the table is a view (as opposed to a base table)
+	View   bool
+	Schema *runtimev1.StructType
+	// Stats contains statistics the driver can provide cheaply (nil if none).
+	// Drivers may leave out statistics from All that they include in Lookup.
+	Stats *runtimev1.TableStats
+}
+
+// QualifiedName returns the name the table should be addressed by.
+// Tables in the default database and schema are addressed by their plain name.
+func (t *Table) QualifiedName() string {
+	if t.IsDefaultDatabase && t.IsDefaultDatabaseSchema {
+		return t.Name
+	}
+	if t.IsDefaultDatabase {
+		return FormatTableName("", t.DatabaseSchema, t.Name)
+	}
+	return FormatTableName(t.Database, t.DatabaseSchema, t.Name)
 }
 
 // Dialect enumerates OLAP query languages.
@@ -1,12 +0,0 @@
-package drivers_test
-
-import (
-	"testing"
-
-	"github.com/rilldata/rill/runtime/drivers"
-	"github.com/stretchr/testify/require"
-)
-
-func testOLAP(t *testing.T, olap drivers.OLAPStore) {
-	require.True(t, true)
-}
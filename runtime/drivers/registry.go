@@ -10,6 +10,7 @@ type RegistryStore interface {
 	FindInstances(ctx context.Context) ([]*Instance, error)
 	FindInstance(ctx context.Context, id string) (*Instance, error)
 	CreateInstance(ctx context.Context, instance *Instance) error
+	EditInstance(ctx context.Context, instance *Instance) error
 	DeleteInstance(ctx context.Context, id string) error
 }
 
@@ -22,15 +23,37 @@ type Instance struct {
 	OLAPDriver string
 	// DSN for connection to OLAP
 	OLAPDSN string
-	// Driver for reading/editing code artifacts (options: file, metastore)
+	// Driver for reading/editing code artifacts (options: file, git, metastore)
 	RepoDriver string
 	// DSN for connecting to repo
 	RepoDSN string
 	// EmbedCatalog tells the runtime to store the instance's catalog in its OLAP store instead
 	// of in the runtime's metadata store. Currently only supported for the duckdb driver.
 	EmbedCatalog bool `db:"embed_catalog"`
+	// QueryTimeout is the default timeout for queries served by the runtime's query APIs. If 0, there's no timeout.
+	// It's stored in milliseconds.
+	QueryTimeout time.Duration `db:"query_timeout_ms"`
+	// QueryMaxRows is the default max number of rows for queries served by the runtime's query APIs. If 0, there's no limit.
+	QueryMaxRows int64 `db:"query_max_rows"`
+	// QueryMaxMemory is the default max memory (in bytes) for queries served by the runtime's query APIs. If 0, the OLAP store's default applies.
+	QueryMaxMemory int64 `db:"query_max_memory"`
+	// Variables are interpolated into the instance's code artifacts. They override defaults set in the project's rill.yaml.
+	Variables map[string]string
 	// CreatedOn is when the instance was created
 	CreatedOn time.Time `db:"created_on"`
 	// UpdatedOn is when the instance was last updated in the registry
 	UpdatedOn time.Time `db:"updated_on"`
 }
+
+// EqualVariables returns true if the two sets of variables contain the same names and values.
+func EqualVariables(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if bv, ok := b[k]; !ok || bv != v {
+			return false
+		}
+	}
+	return true
+}
@@ -12,16 +12,72 @@ import (
 type RepoStore interface {
 	Driver() string
 	DSN() string
-	ListRecursive(ctx context.Context, instID string, glob string) ([]string, error)
+	// ListRecursive returns the paths of files matching glob, sorted by path.
+	// It returns at most pageSize paths (or all paths if pageSize is 0) and a token for fetching the next page,
+	// which is empty when there are no more results.
+	ListRecursive(ctx context.Context, instID string, glob string, pageSize int, pageToken string) ([]string, string, error)
 	Get(ctx context.Context, instID string, path string) (string, error)
 	Stat(ctx context.Context, instID string, path string) (*RepoObjectStat, error)
-	Put(ctx context.Context, instID string, path string, reader io.Reader) error
+	Put(ctx context.Context, instID string, path string, reader io.Reader, opts PutOptions) error
 	Rename(ctx context.Context, instID string, fromPath string, toPath string) error
 	Delete(ctx context.Context, instID string, path string) error
 }
 
+// RepoHistoryStore is implemented by repos that track the revision history of their files (such as the git driver).
+// Use a type assertion on a RepoStore to check if it's supported.
+type RepoHistoryStore interface {
+	// ListRevisions returns the revisions that changed a file, ordered from newest to oldest.
+	ListRevisions(ctx context.Context, instID string, path string) ([]*RepoRevision, error)
+	// GetRevision returns the contents of a file as of a revision.
+	GetRevision(ctx context.Context, instID string, path string, revision string) (string, *RepoRevision, error)
+	// Revert restores a file to its contents as of a revision and records the change as a new revision.
+	Revert(ctx context.Context, instID string, path string, revision string) error
+}
+
+// RepoWatcher is implemented by repos that can notify about changes to their files (such as the file driver).
+// Use a type assertion on a RepoStore to check if it's supported.
+type RepoWatcher interface {
+	// Watch emits batches of paths that were created, updated, renamed or deleted in the repo.
+	// Changes are debounced, so a batch may contain multiple changes. The channel is closed when ctx is cancelled.
+	Watch(ctx context.Context, instID string) (<-chan []string, error)
+}
+
+// RepoLocalStore is implemented by repos that store their files in a local directory (such as the file and git drivers).
+// Use a type assertion on a RepoStore to check if it's supported.
+type RepoLocalStore interface {
+	// Root returns the path of the directory that contains the repo's files.
+	Root() string
+}
+
+// PutOptions sets the preconditions for a RepoStore.Put call.
+type PutOptions struct {
+	// Create allows Put to create the file if it doesn't already exist.
+	// If false, Put returns ErrNotFound if the file doesn't exist.
+	Create bool
+	// CreateOnly makes Put return ErrFileAlreadyExists if the file already exists.
+	// It should only be set when Create is true.
+	CreateOnly bool
+	// ExpectedVersion makes Put return ErrVersionConflict if the file's current version (see RepoObjectStat)
+	// doesn't match. It enables optimistic concurrency control for concurrent editors.
+	ExpectedVersion string
+}
+
 type RepoObjectStat struct {
 	LastUpdated time.Time
+	// Version is an opaque identifier of the file's contents. It changes whenever the contents change.
+	Version string
+}
+
+// RepoRevision represents one revision of a file in a RepoHistoryStore.
+type RepoRevision struct {
+	ID          string
+	AuthorName  string
+	AuthorEmail string
+	Message     string
+	CreatedOn   time.Time
 }
 
 var ErrFileAlreadyExists = errors.New("file already exists")
+
+// ErrVersionConflict indicates that a file was changed after the version passed in PutOptions.ExpectedVersion.
+var ErrVersionConflict = errors.New("file has been changed since it was last read")
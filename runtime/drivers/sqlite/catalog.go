@@ -2,9 +2,13 @@ package sqlite
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/jmoiron/sqlx"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
 	"google.golang.org/protobuf/proto"
@@ -27,7 +31,7 @@ func (c *connection) FindEntry(ctx context.Context, instanceID, name string) (*d
 }
 
 func (c *connection) findEntries(ctx context.Context, whereClause string, args ...any) []*drivers.CatalogEntry {
-	sql := fmt.Sprintf("SELECT name, type, object, path, created_on, updated_on, refreshed_on FROM catalog %s ORDER BY lower(name)", whereClause)
+	sql := fmt.Sprintf("SELECT name, type, object, path, content_hash, artifact, created_on, updated_on, refreshed_on FROM catalog %s ORDER BY lower(name)", whereClause)
 
 	rows, err := c.db.QueryxContext(ctx, sql, args...)
 	if err != nil {
@@ -40,27 +44,14 @@ func (c *connection) findEntries(ctx context.Context, whereClause string, args .
 		var objBlob []byte
 		e := &drivers.CatalogEntry{}
 
-		err := rows.Scan(&e.Name, &e.Type, &objBlob, &e.Path, &e.CreatedOn, &e.UpdatedOn, &e.RefreshedOn)
+		err := rows.Scan(&e.Name, &e.Type, &objBlob, &e.Path, &e.ContentHash, &e.Artifact, &e.CreatedOn, &e.UpdatedOn, &e.RefreshedOn)
 		if err != nil {
 			panic(err)
 		}
 
 		// Parse object protobuf
 		if objBlob != nil {
-			switch e.Type {
-			case drivers.ObjectTypeTable:
-				e.Object = &runtimev1.Table{}
-			case drivers.ObjectTypeSource:
-				e.Object = &runtimev1.Source{}
-			case drivers.ObjectTypeModel:
-				e.Object = &runtimev1.Model{}
-			case drivers.ObjectTypeMetricsView:
-				e.Object = &runtimev1.MetricsView{}
-			default:
-				panic(fmt.Errorf("unexpected object type: %v", e.Type))
-			}
-
-			err = proto.Unmarshal(objBlob, e.Object)
+			e.Object, err = unmarshalObject(e.Type, objBlob)
 			if err != nil {
 				panic(err)
 			}
@@ -79,15 +70,23 @@ func (c *connection) CreateEntry(ctx context.Context, instanceID string, e *driv
 		return err
 	}
 
+	tx, err := c.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	now := time.Now()
-	_, err = c.db.ExecContext(
+	_, err = tx.ExecContext(
 		ctx,
-		"INSERT INTO catalog(instance_id, name, type, object, path, created_on, updated_on, refreshed_on) VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
+		"INSERT INTO catalog(instance_id, name, type, object, path, content_hash, artifact, created_on, updated_on, refreshed_on) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
 		instanceID,
 		e.Name,
 		e.Type,
 		obj,
 		e.Path,
+		e.ContentHash,
+		e.Artifact,
 		now,
 		now,
 		now,
@@ -96,6 +95,16 @@ func (c *connection) CreateEntry(ctx context.Context, instanceID string, e *driv
 		return err
 	}
 
+	err = insertVersion(ctx, tx, instanceID, drivers.CatalogOperationCreate, "", e, obj, now)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
 	e.CreatedOn = now
 	e.UpdatedOn = now
 	e.RefreshedOn = now
@@ -109,13 +118,21 @@ func (c *connection) UpdateEntry(ctx context.Context, instanceID string, e *driv
 		return err
 	}
 
+	tx, err := c.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	now := time.Now()
-	_, err = c.db.ExecContext(
+	_, err = tx.ExecContext(
 		ctx,
-		"UPDATE catalog SET type = ?, object = ?, path = ?, updated_on = ?, refreshed_on = ? WHERE instance_id = ? AND name = ?",
+		"UPDATE catalog SET type = ?, object = ?, path = ?, content_hash = ?, artifact = ?, updated_on = ?, refreshed_on = ? WHERE instance_id = ? AND name = ?",
 		e.Type,
 		obj,
 		e.Path,
+		e.ContentHash,
+		e.Artifact,
 		now,
 		e.RefreshedOn,
 		instanceID,
@@ -125,11 +142,257 @@ func (c *connection) UpdateEntry(ctx context.Context, instanceID string, e *driv
 		return err
 	}
 
+	err = insertVersion(ctx, tx, instanceID, drivers.CatalogOperationUpdate, "", e, obj, now)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
 	e.UpdatedOn = now
 	return nil
 }
 
 func (c *connection) DeleteEntry(ctx context.Context, instanceID, name string) error {
-	_, err := c.db.ExecContext(ctx, "DELETE FROM catalog WHERE instance_id = ? AND LOWER(name) = LOWER(?)", instanceID, name)
+	tx, err := c.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// Record the deleted entry, so it can be restored
+	_, err = tx.ExecContext(
+		ctx,
+		"INSERT INTO catalog_versions(instance_id, operation, name, from_name, type, object, path, content_hash, artifact, created_on) "+
+			"SELECT instance_id, ?, name, '', type, object, path, content_hash, artifact, ? FROM catalog WHERE instance_id = ? AND LOWER(name) = LOWER(?)",
+		drivers.CatalogOperationDelete,
+		time.Now(),
+		instanceID,
+		name,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = pruneVersions(ctx, tx, instanceID, name)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM catalog WHERE instance_id = ? AND LOWER(name) = LOWER(?)", instanceID, name)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (c *connection) RenameEntry(ctx context.Context, instanceID, fromName string, e *drivers.CatalogEntry) error {
+	// Serialize object
+	obj, err := proto.Marshal(e.Object)
+	if err != nil {
+		return err
+	}
+
+	tx, err := c.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	now := time.Now()
+	createdOn := now
+	err = tx.QueryRowContext(ctx, "SELECT created_on FROM catalog WHERE instance_id = ? AND LOWER(name) = LOWER(?)", instanceID, fromName).Scan(&createdOn)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM catalog WHERE instance_id = ? AND LOWER(name) = LOWER(?)", instanceID, fromName)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		"INSERT INTO catalog(instance_id, name, type, object, path, content_hash, artifact, created_on, updated_on, refreshed_on) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		instanceID,
+		e.Name,
+		e.Type,
+		obj,
+		e.Path,
+		e.ContentHash,
+		e.Artifact,
+		createdOn,
+		now,
+		now,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = insertVersion(ctx, tx, instanceID, drivers.CatalogOperationRename, fromName, e, obj, now)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	e.CreatedOn = createdOn
+	e.UpdatedOn = now
+	e.RefreshedOn = now
+	return nil
+}
+
+func (c *connection) FindEntryVersions(ctx context.Context, instanceID, name string) ([]*drivers.CatalogEntryVersion, error) {
+	return c.findVersions(ctx, "WHERE instance_id = ? AND (LOWER(name) = LOWER(?) OR LOWER(from_name) = LOWER(?))", instanceID, name, name)
+}
+
+func (c *connection) FindEntryVersion(ctx context.Context, instanceID string, version int64) (*drivers.CatalogEntryVersion, error) {
+	vs, err := c.findVersions(ctx, "WHERE instance_id = ? AND version = ?", instanceID, version)
+	if err != nil {
+		return nil, err
+	}
+	if len(vs) == 0 {
+		return nil, drivers.ErrNotFound
+	}
+	return vs[0], nil
+}
+
+func (c *connection) findVersions(ctx context.Context, whereClause string, args ...any) ([]*drivers.CatalogEntryVersion, error) {
+	sql := fmt.Sprintf("SELECT version, operation, from_name, name, type, object, path, content_hash, artifact, created_on FROM catalog_versions %s ORDER BY version DESC", whereClause)
+
+	rows, err := c.db.QueryxContext(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []*drivers.CatalogEntryVersion
+	for rows.Next() {
+		var objBlob []byte
+		v := &drivers.CatalogEntryVersion{Entry: &drivers.CatalogEntry{}}
+
+		err := rows.Scan(&v.Version, &v.Operation, &v.FromName, &v.Entry.Name, &v.Entry.Type, &objBlob, &v.Entry.Path, &v.Entry.ContentHash, &v.Entry.Artifact, &v.CreatedOn)
+		if err != nil {
+			return nil, err
+		}
+
+		v.Entry.Object, err = unmarshalObject(v.Entry.Type, objBlob)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, v)
+	}
+
+	return res, rows.Err()
+}
+
+func (c *connection) DeleteEntryVersions(ctx context.Context, instanceID string) error {
+	_, err := c.db.ExecContext(ctx, "DELETE FROM catalog_versions WHERE instance_id = ?", instanceID)
+	return err
+}
+
+// insertVersion records a version of an entry. obj is the entry's serialized object.
+func insertVersion(ctx context.Context, tx *sqlx.Tx, instanceID string, op drivers.CatalogOperation, fromName string, e *drivers.CatalogEntry, obj []byte, now time.Time) error {
+	_, err := tx.ExecContext(
+		ctx,
+		"INSERT INTO catalog_versions(instance_id, operation, name, from_name, type, object, path, content_hash, artifact, created_on) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		instanceID,
+		op,
+		e.Name,
+		fromName,
+		e.Type,
+		obj,
+		e.Path,
+		e.ContentHash,
+		e.Artifact,
+		now,
+	)
+	if err != nil {
+		return err
+	}
+	return pruneVersions(ctx, tx, instanceID, e.Name)
+}
+
+// pruneVersions deletes the versions of a name beyond the latest drivers.MaxCatalogEntryVersions.
+func pruneVersions(ctx context.Context, tx *sqlx.Tx, instanceID, name string) error {
+	_, err := tx.ExecContext(
+		ctx,
+		"DELETE FROM catalog_versions WHERE instance_id = ? AND LOWER(name) = LOWER(?) AND version NOT IN "+
+			"(SELECT version FROM catalog_versions WHERE instance_id = ? AND LOWER(name) = LOWER(?) ORDER BY version DESC LIMIT ?)",
+		instanceID,
+		name,
+		instanceID,
+		name,
+		drivers.MaxCatalogEntryVersions,
+	)
+	return err
+}
+
+func unmarshalObject(typ drivers.ObjectType, blob []byte) (proto.Message, error) {
+	var obj proto.Message
+	switch typ {
+	case drivers.ObjectTypeTable:
+		obj = &runtimev1.Table{}
+	case drivers.ObjectTypeSource:
+		obj = &runtimev1.Source{}
+	case drivers.ObjectTypeModel:
+		obj = &runtimev1.Model{}
+	case drivers.ObjectTypeMetricsView:
+		obj = &runtimev1.MetricsView{}
+	default:
+		return nil, fmt.Errorf("unexpected object type: %v", typ)
+	}
+
+	err := proto.Unmarshal(blob, obj)
+	if err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func (c *connection) FindReconcileState(ctx context.Context, instanceID string) (*drivers.ReconcileState, error) {
+	var state string
+	err := c.db.QueryRowContext(ctx, "SELECT state FROM reconcile_state WHERE instance_id = ?", instanceID).Scan(&state)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, drivers.ErrNotFound
+		}
+		return nil, err
+	}
+
+	res := &drivers.ReconcileState{}
+	err = json.Unmarshal([]byte(state), res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *connection) SaveReconcileState(ctx context.Context, instanceID string, state *drivers.ReconcileState) error {
+	b, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.db.ExecContext(
+		ctx,
+		"INSERT INTO reconcile_state(instance_id, state, updated_on) VALUES (?, ?, ?) ON CONFLICT(instance_id) DO UPDATE SET state = excluded.state, updated_on = excluded.updated_on",
+		instanceID,
+		string(b),
+		time.Now(),
+	)
+	return err
+}
+
+func (c *connection) DeleteReconcileState(ctx context.Context, instanceID string) error {
+	_, err := c.db.ExecContext(ctx, "DELETE FROM reconcile_state WHERE instance_id = ?", instanceID)
 	return err
 }
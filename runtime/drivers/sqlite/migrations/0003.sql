@@ -0,0 +1,3 @@
+ALTER TABLE instances ADD COLUMN query_timeout_ms INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE instances ADD COLUMN query_max_rows INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE instances ADD COLUMN query_max_memory INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1 @@
+ALTER TABLE instances ADD COLUMN variables TEXT NOT NULL DEFAULT '{}';
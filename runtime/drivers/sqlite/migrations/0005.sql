@@ -0,0 +1,5 @@
+CREATE TABLE reconcile_state (
+    instance_id TEXT PRIMARY KEY,
+    state TEXT NOT NULL,
+    updated_on TIMESTAMP NOT NULL
+);
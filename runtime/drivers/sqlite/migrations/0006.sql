@@ -0,0 +1,16 @@
+ALTER TABLE catalog ADD COLUMN content_hash TEXT NOT NULL DEFAULT '';
+
+CREATE TABLE catalog_versions (
+    version INTEGER PRIMARY KEY AUTOINCREMENT,
+    instance_id TEXT NOT NULL,
+    operation INTEGER NOT NULL,
+    name TEXT NOT NULL,
+    from_name TEXT NOT NULL,
+    type INTEGER NOT NULL,
+    object BLOB NOT NULL,
+    path TEXT NOT NULL,
+    content_hash TEXT NOT NULL,
+    created_on TIMESTAMP NOT NULL
+);
+
+CREATE INDEX catalog_versions_name_idx ON catalog_versions (instance_id, lower(name));
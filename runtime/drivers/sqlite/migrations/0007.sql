@@ -0,0 +1,2 @@
+ALTER TABLE catalog ADD COLUMN artifact TEXT NOT NULL DEFAULT '';
+ALTER TABLE catalog_versions ADD COLUMN artifact TEXT NOT NULL DEFAULT '';
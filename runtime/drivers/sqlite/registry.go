@@ -2,6 +2,7 @@ package sqlite
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -30,7 +31,7 @@ func (c *connection) findInstances(_ context.Context, whereClause string, args .
 	// Override ctx because sqlite sometimes segfaults on context cancellation
 	ctx := context.Background()
 
-	sql := fmt.Sprintf("SELECT id, olap_driver, olap_dsn, repo_driver, repo_dsn, embed_catalog, created_on, updated_on FROM instances %s ORDER BY id", whereClause)
+	sql := fmt.Sprintf("SELECT id, olap_driver, olap_dsn, repo_driver, repo_dsn, embed_catalog, query_timeout_ms, query_max_rows, query_max_memory, variables, created_on, updated_on FROM instances %s ORDER BY id", whereClause)
 
 	rows, err := c.db.QueryxContext(ctx, sql, args...)
 	if err != nil {
@@ -41,7 +42,14 @@ func (c *connection) findInstances(_ context.Context, whereClause string, args .
 	var res []*drivers.Instance
 	for rows.Next() {
 		i := &drivers.Instance{}
-		err := rows.Scan(&i.ID, &i.OLAPDriver, &i.OLAPDSN, &i.RepoDriver, &i.RepoDSN, &i.EmbedCatalog, &i.CreatedOn, &i.UpdatedOn)
+		var queryTimeoutMS int64
+		var variables string
+		err := rows.Scan(&i.ID, &i.OLAPDriver, &i.OLAPDSN, &i.RepoDriver, &i.RepoDSN, &i.EmbedCatalog, &queryTimeoutMS, &i.QueryMaxRows, &i.QueryMaxMemory, &variables, &i.CreatedOn, &i.UpdatedOn)
+		if err != nil {
+			return nil, err
+		}
+		i.QueryTimeout = time.Duration(queryTimeoutMS) * time.Millisecond
+		err = json.Unmarshal([]byte(variables), &i.Variables)
 		if err != nil {
 			return nil, err
 		}
@@ -60,17 +68,26 @@ func (c *connection) CreateInstance(_ context.Context, inst *drivers.Instance) e
 		inst.ID = uuid.NewString()
 	}
 
+	variables, err := marshalVariables(inst.Variables)
+	if err != nil {
+		return err
+	}
+
 	now := time.Now()
-	_, err := c.db.ExecContext(
+	_, err = c.db.ExecContext(
 		ctx,
-		"INSERT INTO instances(id, olap_driver, olap_dsn, repo_driver, repo_dsn, embed_catalog, created_on, updated_on) "+
-			"VALUES ($1, $2, $3, $4, $5, $6, $7, $7)",
+		"INSERT INTO instances(id, olap_driver, olap_dsn, repo_driver, repo_dsn, embed_catalog, query_timeout_ms, query_max_rows, query_max_memory, variables, created_on, updated_on) "+
+			"VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $11)",
 		inst.ID,
 		inst.OLAPDriver,
 		inst.OLAPDSN,
 		inst.RepoDriver,
 		inst.RepoDSN,
 		inst.EmbedCatalog,
+		inst.QueryTimeout.Milliseconds(),
+		inst.QueryMaxRows,
+		inst.QueryMaxMemory,
+		variables,
 		now,
 	)
 	if err != nil {
@@ -83,6 +100,48 @@ func (c *connection) CreateInstance(_ context.Context, inst *drivers.Instance) e
 	return nil
 }
 
+// EditInstance implements drivers.RegistryStore.
+func (c *connection) EditInstance(_ context.Context, inst *drivers.Instance) error {
+	// Override ctx because sqlite sometimes segfaults on context cancellation
+	ctx := context.Background()
+
+	variables, err := marshalVariables(inst.Variables)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	res, err := c.db.ExecContext(
+		ctx,
+		"UPDATE instances SET olap_driver = $2, olap_dsn = $3, repo_driver = $4, repo_dsn = $5, embed_catalog = $6, query_timeout_ms = $7, query_max_rows = $8, query_max_memory = $9, variables = $10, updated_on = $11 "+
+			"WHERE id = $1",
+		inst.ID,
+		inst.OLAPDriver,
+		inst.OLAPDSN,
+		inst.RepoDriver,
+		inst.RepoDSN,
+		inst.EmbedCatalog,
+		inst.QueryTimeout.Milliseconds(),
+		inst.QueryMaxRows,
+		inst.QueryMaxMemory,
+		variables,
+		now,
+	)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return drivers.ErrNotFound
+	}
+
+	inst.UpdatedOn = now
+	return nil
+}
+
 // DeleteInstance implements drivers.RegistryStore.
 func (c *connection) DeleteInstance(_ context.Context, id string) error {
 	// Override ctx because sqlite sometimes segfaults on context cancellation
@@ -91,3 +150,15 @@ func (c *connection) DeleteInstance(_ context.Context, id string) error {
 	_, err := c.db.ExecContext(ctx, "DELETE FROM instances WHERE id=$1", id)
 	return err
 }
+
+// marshalVariables serializes instance variables for storage. A nil map is stored as an empty object.
+func marshalVariables(vars map[string]string) (string, error) {
+	if vars == nil {
+		vars = map[string]string{}
+	}
+	b, err := json.Marshal(vars)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
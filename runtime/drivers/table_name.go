@@ -0,0 +1,95 @@
+package drivers
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseTableName splits a table name of the form "table", "schema.table" or "database.schema.table" into its parts.
+// Parts may be double-quoted to contain dots or quotes (escaped by doubling them).
+// Parts that are not present in the name are returned as empty strings.
+func ParseTableName(name string) (database, schema, table string, err error) {
+	var parts []string
+	var part strings.Builder
+	quoted := false
+	wasQuoted := false
+	for i := 0; i < len(name); i++ {
+		ch := name[i]
+		switch {
+		case quoted && ch == '"':
+			if i+1 < len(name) && name[i+1] == '"' {
+				part.WriteByte('"')
+				i++
+			} else {
+				quoted = false
+			}
+		case quoted:
+			part.WriteByte(ch)
+		case ch == '"' && part.Len() == 0 && !wasQuoted:
+			quoted = true
+			wasQuoted = true
+		case ch == '.':
+			if part.Len() == 0 {
+				return "", "", "", fmt.Errorf("invalid table name %q", name)
+			}
+			parts = append(parts, part.String())
+			part.Reset()
+			wasQuoted = false
+		case wasQuoted:
+			return "", "", "", fmt.Errorf("invalid table name %q", name)
+		default:
+			part.WriteByte(ch)
+		}
+	}
+	if quoted || part.Len() == 0 {
+		return "", "", "", fmt.Errorf("invalid table name %q", name)
+	}
+	parts = append(parts, part.String())
+
+	switch len(parts) {
+	case 1:
+		return "", "", parts[0], nil
+	case 2:
+		return "", parts[0], parts[1], nil
+	case 3:
+		return parts[0], parts[1], parts[2], nil
+	default:
+		return "", "", "", fmt.Errorf("invalid table name %q: too many parts", name)
+	}
+}
+
+// FormatTableName joins the non-empty parts of a table name with dots, quoting parts that need it.
+// It's the inverse of ParseTableName.
+func FormatTableName(database, schema, table string) string {
+	var parts []string
+	for _, p := range []string{database, schema, table} {
+		if p == "" {
+			continue
+		}
+		if strings.ContainsAny(p, `."`) {
+			p = `"` + strings.ReplaceAll(p, `"`, `""`) + `"`
+		}
+		parts = append(parts, p)
+	}
+	return strings.Join(parts, ".")
+}
+
+// QuoteTableName returns a SQL identifier for a possibly qualified table name, with every part quoted.
+// Names that can't be parsed are quoted as a single identifier.
+func QuoteTableName(name string) string {
+	database, schema, table, err := ParseTableName(name)
+	if err != nil {
+		return quoteIdentifier(name)
+	}
+	var parts []string
+	for _, p := range []string{database, schema, table} {
+		if p != "" {
+			parts = append(parts, quoteIdentifier(p))
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
+func quoteIdentifier(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
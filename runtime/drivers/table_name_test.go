@@ -0,0 +1,52 @@
+package drivers_test
+
+import (
+	"testing"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTableName(t *testing.T) {
+	tests := []struct {
+		name     string
+		database string
+		schema   string
+		table    string
+		quoted   string
+		err      bool
+	}{
+		{name: "foo", table: "foo", quoted: `"foo"`},
+		{name: "aux.foo", schema: "aux", table: "foo", quoted: `"aux"."foo"`},
+		{name: "db.aux.foo", database: "db", schema: "aux", table: "foo", quoted: `"db"."aux"."foo"`},
+		{name: `"a.b".foo`, schema: "a.b", table: "foo", quoted: `"a.b"."foo"`},
+		{name: `"say ""hi"""`, table: `say "hi"`, quoted: `"say ""hi"""`},
+		{name: "", err: true},
+		{name: "a..b", err: true},
+		{name: "a.b.c.d", err: true},
+		{name: `"unterminated`, err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			database, schema, table, err := drivers.ParseTableName(tt.name)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.database, database)
+			require.Equal(t, tt.schema, schema)
+			require.Equal(t, tt.table, table)
+			require.Equal(t, tt.quoted, drivers.QuoteTableName(tt.name))
+			require.Equal(t, tt.name, drivers.FormatTableName(database, schema, table))
+		})
+	}
+
+	tbl := &drivers.Table{Database: "db", DatabaseSchema: "main", Name: "foo", IsDefaultDatabase: true, IsDefaultDatabaseSchema: true}
+	require.Equal(t, "foo", tbl.QualifiedName())
+	tbl.IsDefaultDatabaseSchema = false
+	require.Equal(t, "main.foo", tbl.QualifiedName())
+	tbl.IsDefaultDatabase = false
+	require.Equal(t, "db.main.foo", tbl.QualifiedName())
+}
@@ -1,6 +1,9 @@
 package dag
 
+import "sort"
+
 // DAG is a simple implementation of a directed acyclic graph.
+// Adding edges that form a cycle is allowed, so that the cycle can be reported (see Cycles).
 type DAG struct {
 	NameMap map[string]*Node
 }
@@ -11,8 +14,6 @@ func NewDAG() *DAG {
 	}
 }
 
-// TODO: handle cycles when we support model to model connection
-
 type Node struct {
 	Name     string
 	Present  bool
@@ -52,33 +53,103 @@ func (d *DAG) Delete(name string) {
 	d.deleteBranch(n)
 }
 
+// GetChildren returns all descendants of a node, with immediate children first and deeper children after.
+// If the node is part of a cycle, it's not included in its own descendants.
 func (d *DAG) GetChildren(name string) []string {
 	children := make([]string, 0)
-	childMap := make(map[string]bool)
 
 	n, ok := d.NameMap[name]
 	if !ok {
-		return []string{}
+		return children
 	}
 
-	// we need the immediate children to be loaded 1st.
-	for _, child := range n.Children {
-		children = append(children, child.Name)
-		childMap[child.Name] = true
+	// walk breadth first so that the immediate children are loaded 1st.
+	visited := map[string]bool{name: true}
+	queue := []*Node{n}
+	for len(queue) > 0 {
+		next := queue[0]
+		queue = queue[1:]
+		for _, child := range next.Children {
+			if visited[child.Name] {
+				continue
+			}
+			visited[child.Name] = true
+			children = append(children, child.Name)
+			queue = append(queue, child)
+		}
 	}
 
-	// then we load deeper children
-	for _, child := range n.Children {
-		deepChildren := d.GetChildren(child.Name)
-		for _, deepChild := range deepChildren {
-			if _, ok := childMap[deepChild]; !ok {
-				children = append(children, deepChild)
-				childMap[deepChild] = true
+	return children
+}
+
+// Cycles returns the groups of present nodes that depend on each other, including nodes that depend on themselves.
+// The names in each group and the groups themselves are sorted.
+func (d *DAG) Cycles() [][]string {
+	// Tarjan's strongly connected components algorithm
+	index := make(map[string]int)
+	lowLink := make(map[string]int)
+	onStack := make(map[string]bool)
+	var stack []string
+	var cycles [][]string
+
+	var connect func(n *Node)
+	connect = func(n *Node) {
+		index[n.Name] = len(index)
+		lowLink[n.Name] = index[n.Name]
+		stack = append(stack, n.Name)
+		onStack[n.Name] = true
+
+		for _, child := range n.Children {
+			if !child.Present {
+				continue
+			}
+			if _, ok := index[child.Name]; !ok {
+				connect(child)
+				if lowLink[child.Name] < lowLink[n.Name] {
+					lowLink[n.Name] = lowLink[child.Name]
+				}
+			} else if onStack[child.Name] && index[child.Name] < lowLink[n.Name] {
+				lowLink[n.Name] = index[child.Name]
+			}
+		}
+
+		if lowLink[n.Name] != index[n.Name] {
+			return
+		}
+
+		var component []string
+		for {
+			last := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[last] = false
+			component = append(component, last)
+			if last == n.Name {
+				break
 			}
 		}
+		if _, selfLoop := n.Children[n.Name]; len(component) > 1 || selfLoop {
+			sort.Strings(component)
+			cycles = append(cycles, component)
+		}
 	}
 
-	return children
+	names := make([]string, 0, len(d.NameMap))
+	for name, n := range d.NameMap {
+		if n.Present {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if _, ok := index[name]; !ok {
+			connect(d.NameMap[name])
+		}
+	}
+
+	sort.Slice(cycles, func(i, j int) bool {
+		return cycles[i][0] < cycles[j][0]
+	})
+	return cycles
 }
 
 func (d *DAG) Has(name string) bool {
@@ -82,3 +82,21 @@ func getTestDAG() *DAG {
 	//     B2  A2
 	return d
 }
+
+func TestDAG_Cycles(t *testing.T) {
+	d := getTestDAG()
+	require.Empty(t, d.Cycles())
+
+	d.Add("A0", []string{"B2"})
+	d.Add("C1", []string{"C1"})
+	// A0 -> A1 -> B2 -> A0, and C1 depends on itself
+	require.Equal(t, [][]string{{"A0", "A1", "B2"}, {"C1"}}, d.Cycles())
+	require.ElementsMatch(t, []string{"A1", "B2"}, d.GetChildren("A0"))
+	require.Equal(t, []string{}, d.GetChildren("C1"))
+
+	// breaking the cycle
+	d.Add("A0", []string{})
+	d.Delete("C1")
+	require.Empty(t, d.Cycles())
+	require.Equal(t, []string{"A1", "B2"}, d.GetChildren("A0"))
+}
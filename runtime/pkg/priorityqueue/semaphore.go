@@ -67,6 +67,13 @@ func (s *Semaphore) TryAcquire() bool {
 	return ok
 }
 
+// Waiting returns the number of callers that are blocked in Acquire.
+func (s *Semaphore) Waiting() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.pq.Len()
+}
+
 // Release releases a semaphore previously acquired with Acquire or TryAcquire.
 func (s *Semaphore) Release() {
 	s.mu.Lock()
@@ -39,16 +39,17 @@ func (q *ColumnCardinality) UnmarshalResult(v any) error {
 }
 
 func (q *ColumnCardinality) Resolve(ctx context.Context, rt *runtime.Runtime, instanceID string, priority int) error {
-	olap, err := rt.OLAP(ctx, instanceID)
+	olap, release, err := rt.OLAP(ctx, instanceID)
 	if err != nil {
 		return err
 	}
+	defer release()
 
 	if olap.Dialect() != drivers.DialectDuckDB {
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
-	requestSQL := fmt.Sprintf("SELECT approx_count_distinct(%s) as count from %s", safeName(q.ColumnName), safeName(q.TableName))
+	requestSQL := fmt.Sprintf("SELECT approx_count_distinct(%s) as count from %s", safeName(q.ColumnName), safeTableName(q.TableName))
 
 	rows, err := olap.Execute(ctx, &drivers.Statement{
 		Query:    requestSQL,
@@ -40,10 +40,11 @@ func (q *ColumnDescriptiveStatistics) UnmarshalResult(v any) error {
 }
 
 func (q *ColumnDescriptiveStatistics) Resolve(ctx context.Context, rt *runtime.Runtime, instanceID string, priority int) error {
-	olap, err := rt.OLAP(ctx, instanceID)
+	olap, release, err := rt.OLAP(ctx, instanceID)
 	if err != nil {
 		return err
 	}
+	defer release()
 
 	if olap.Dialect() != drivers.DialectDuckDB {
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
@@ -66,7 +67,7 @@ func (q *ColumnDescriptiveStatistics) Resolve(ctx context.Context, rt *runtime.R
 		sanitizedColumnName,
 		sanitizedColumnName,
 		sanitizedColumnName,
-		safeName(q.TableName))
+		safeTableName(q.TableName))
 
 	rows, err := olap.Execute(ctx, &drivers.Statement{
 		Query:    descriptiveStatisticsSQL,
@@ -38,17 +38,18 @@ func (q *ColumnNullCount) UnmarshalResult(v any) error {
 }
 
 func (q *ColumnNullCount) Resolve(ctx context.Context, rt *runtime.Runtime, instanceID string, priority int) error {
-	olap, err := rt.OLAP(ctx, instanceID)
+	olap, release, err := rt.OLAP(ctx, instanceID)
 	if err != nil {
 		return err
 	}
+	defer release()
 
 	if olap.Dialect() != drivers.DialectDuckDB {
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
 	nullCountSQL := fmt.Sprintf("SELECT count(*) as count from %s WHERE %s IS NULL",
-		safeName(q.TableName),
+		safeTableName(q.TableName),
 		safeName(q.ColumnName),
 	)
 
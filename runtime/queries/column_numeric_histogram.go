@@ -49,7 +49,7 @@ func (q *ColumnNumericHistogram) calculateBucketSize(ctx context.Context, olap d
 		sanitizedColumnName,
 		sanitizedColumnName,
 		sanitizedColumnName,
-		safeName(q.TableName),
+		safeTableName(q.TableName),
 	)
 
 	rows, err := olap.Execute(ctx, &drivers.Statement{
@@ -87,10 +87,11 @@ func (q *ColumnNumericHistogram) calculateBucketSize(ctx context.Context, olap d
 }
 
 func (q *ColumnNumericHistogram) Resolve(ctx context.Context, rt *runtime.Runtime, instanceID string, priority int) error {
-	olap, err := rt.OLAP(ctx, instanceID)
+	olap, release, err := rt.OLAP(ctx, instanceID)
 	if err != nil {
 		return err
 	}
+	defer release()
 
 	if olap.Dialect() != drivers.DialectDuckDB {
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
@@ -161,7 +162,7 @@ func (q *ColumnNumericHistogram) Resolve(ctx context.Context, rt *runtime.Runtim
 	      `,
 		selectColumn,
 		sanitizedColumnName,
-		safeName(q.TableName),
+		safeTableName(q.TableName),
 		bucketSize,
 	)
 
@@ -93,14 +93,15 @@ func (q *ColumnTimeGrain) Resolve(ctx context.Context, rt *runtime.Runtime, inst
       FROM time_grains
       `,
 		safeName(q.ColumnName),
-		safeName(q.TableName),
+		safeTableName(q.TableName),
 		useSample,
 	)
 
-	olap, err := rt.OLAP(ctx, instanceID)
+	olap, release, err := rt.OLAP(ctx, instanceID)
 	if err != nil {
 		return err
 	}
+	defer release()
 
 	if olap.Dialect() != drivers.DialectDuckDB {
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
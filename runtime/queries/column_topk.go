@@ -44,10 +44,11 @@ func (q *ColumnTopK) UnmarshalResult(v any) error {
 
 func (q *ColumnTopK) Resolve(ctx context.Context, rt *runtime.Runtime, instanceID string, priority int) error {
 	// Get OLAP connection
-	olap, err := rt.OLAP(ctx, instanceID)
+	olap, release, err := rt.OLAP(ctx, instanceID)
 	if err != nil {
 		return err
 	}
+	defer release()
 
 	// Check dialect
 	if olap.Dialect() != drivers.DialectDuckDB {
@@ -58,7 +59,7 @@ func (q *ColumnTopK) Resolve(ctx context.Context, rt *runtime.Runtime, instanceI
 	qry := fmt.Sprintf("SELECT CAST(%s as VARCHAR) AS value, %s AS count FROM %s GROUP BY %s ORDER BY count DESC, value ASC LIMIT %d",
 		safeName(q.ColumnName),
 		q.Agg,
-		safeName(q.TableName),
+		safeTableName(q.TableName),
 		safeName(q.ColumnName),
 		q.K,
 	)
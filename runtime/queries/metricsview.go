@@ -2,8 +2,10 @@ package queries
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
@@ -27,20 +29,38 @@ func lookupMetricsView(ctx context.Context, rt *runtime.Runtime, instanceID, nam
 	return obj.GetMetricsView(), nil
 }
 
-func metricsQuery(ctx context.Context, olap drivers.OLAPStore, priority int, sql string, args []any) ([]*runtimev1.MetricsViewColumn, []*structpb.Struct, error) {
-	rows, err := olap.Execute(ctx, &drivers.Statement{
+// metricsQuery executes a metrics view query. A non-zero timeout overrides the instance's default query timeout.
+func metricsQuery(ctx context.Context, rt *runtime.Runtime, instanceID string, olap drivers.OLAPStore, priority int, timeout time.Duration, sql string, args []any) ([]*runtimev1.MetricsViewColumn, []*structpb.Struct, error) {
+	stmt := &drivers.Statement{
 		Query:    sql,
 		Args:     args,
 		Priority: priority,
-	})
+		Timeout:  timeout,
+	}
+	err := rt.ApplyQueryLimits(ctx, instanceID, stmt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows, err := olap.Execute(ctx, stmt)
 	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return nil, nil, status.Error(codes.DeadlineExceeded, err.Error())
+		}
 		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 	defer rows.Close()
 
 	data, err := rowsToData(rows)
 	if err != nil {
-		return nil, nil, status.Error(codes.Internal, err.Error())
+		switch {
+		case errors.Is(err, drivers.ErrMaxRowsExceeded):
+			return nil, nil, status.Error(codes.ResourceExhausted, err.Error())
+		case errors.Is(err, context.DeadlineExceeded):
+			return nil, nil, status.Error(codes.DeadlineExceeded, err.Error())
+		default:
+			return nil, nil, status.Error(codes.Internal, err.Error())
+		}
 	}
 
 	return structTypeToMetricsViewColumn(rows.Schema), data, nil
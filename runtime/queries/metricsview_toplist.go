@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
@@ -22,6 +23,8 @@ type MetricsViewToplist struct {
 	Offset          int64                        `json:"offset,omitempty"`
 	Sort            []*runtimev1.MetricsViewSort `json:"sort,omitempty"`
 	Filter          *runtimev1.MetricsViewFilter `json:"filter,omitempty"`
+	// Timeout overrides the instance's default query timeout. It's not part of the cache key.
+	Timeout time.Duration `json:"-"`
 
 	Result *runtimev1.MetricsViewToplistResponse `json:"-"`
 }
@@ -54,10 +57,11 @@ func (q *MetricsViewToplist) UnmarshalResult(v any) error {
 }
 
 func (q *MetricsViewToplist) Resolve(ctx context.Context, rt *runtime.Runtime, instanceID string, priority int) error {
-	olap, err := rt.OLAP(ctx, instanceID)
+	olap, release, err := rt.OLAP(ctx, instanceID)
 	if err != nil {
 		return err
 	}
+	defer release()
 
 	if olap.Dialect() != drivers.DialectDuckDB {
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
@@ -79,7 +83,7 @@ func (q *MetricsViewToplist) Resolve(ctx context.Context, rt *runtime.Runtime, i
 	}
 
 	// Execute
-	meta, data, err := metricsQuery(ctx, olap, priority, sql, args)
+	meta, data, err := metricsQuery(ctx, rt, instanceID, olap, priority, q.Timeout, sql, args)
 	if err != nil {
 		return err
 	}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
@@ -18,6 +19,8 @@ type MetricsViewTotals struct {
 	TimeStart       *timestamppb.Timestamp       `json:"time_start,omitempty"`
 	TimeEnd         *timestamppb.Timestamp       `json:"time_end,omitempty"`
 	Filter          *runtimev1.MetricsViewFilter `json:"filter,omitempty"`
+	// Timeout overrides the instance's default query timeout. It's not part of the cache key.
+	Timeout time.Duration `json:"-"`
 
 	Result *runtimev1.MetricsViewTotalsResponse `json:"-"`
 }
@@ -50,10 +53,11 @@ func (q *MetricsViewTotals) UnmarshalResult(v any) error {
 }
 
 func (q *MetricsViewTotals) Resolve(ctx context.Context, rt *runtime.Runtime, instanceID string, priority int) error {
-	olap, err := rt.OLAP(ctx, instanceID)
+	olap, release, err := rt.OLAP(ctx, instanceID)
 	if err != nil {
 		return err
 	}
+	defer release()
 
 	if olap.Dialect() != drivers.DialectDuckDB {
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
@@ -73,7 +77,7 @@ func (q *MetricsViewTotals) Resolve(ctx context.Context, rt *runtime.Runtime, in
 		return fmt.Errorf("error building query: %w", err)
 	}
 
-	meta, data, err := metricsQuery(ctx, olap, priority, ql, args)
+	meta, data, err := metricsQuery(ctx, rt, instanceID, olap, priority, q.Timeout, ql, args)
 	if err != nil {
 		return err
 	}
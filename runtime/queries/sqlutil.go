@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
 )
 
 func quoteName(name string) string {
@@ -23,6 +24,14 @@ func safeName(name string) string {
 	return quoteName(escapeDoubleQuotes(name))
 }
 
+// safeTableName quotes a possibly qualified table name like "schema.table" for use in SQL.
+func safeTableName(name string) string {
+	if name == "" {
+		return name
+	}
+	return drivers.QuoteTableName(name)
+}
+
 func tempName(prefix string) string {
 	return prefix + strings.ReplaceAll(uuid.New().String(), "-", "")
 }
@@ -4,11 +4,44 @@ import (
 	"context"
 	"testing"
 
+	"github.com/rilldata/rill/runtime/drivers"
 	_ "github.com/rilldata/rill/runtime/drivers/duckdb"
 	"github.com/rilldata/rill/runtime/testruntime"
 	"github.com/stretchr/testify/require"
 )
 
+func TestTableCardinality(t *testing.T) {
+	ctx := context.Background()
+	rt, instanceID := testruntime.NewInstanceWithModel(t, "foo", "SELECT * FROM range(3)")
+
+	olap, release, err := rt.OLAP(ctx, instanceID)
+	require.NoError(t, err)
+	defer release()
+	err = olap.Exec(ctx, &drivers.Statement{Query: "CREATE TABLE bar AS SELECT * FROM range(5)"})
+	require.NoError(t, err)
+	err = rt.SyncExistingTables(ctx, instanceID)
+	require.NoError(t, err)
+
+	// models are views, so the count is computed
+	q := &TableCardinality{TableName: "foo"}
+	err = q.Resolve(ctx, rt, instanceID, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), q.Result)
+
+	// tables have a row count cached in the catalog
+	entry, err := rt.GetCatalogEntry(ctx, instanceID, "bar")
+	require.NoError(t, err)
+	require.Equal(t, int64(5), entry.GetTable().Stats.GetRowCount())
+
+	// the cached row count is approximate and may be stale, so it's not used for the cardinality
+	err = olap.Exec(ctx, &drivers.Statement{Query: "INSERT INTO bar SELECT * FROM range(2)"})
+	require.NoError(t, err)
+	q = &TableCardinality{TableName: "bar"}
+	err = q.Resolve(ctx, rt, instanceID, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(7), q.Result)
+}
+
 func BenchmarkTableCardinality(b *testing.B) {
 	rt, instanceID := testruntime.NewInstanceForProject(b, "ad_bids")
 	b.ResetTimer()
@@ -37,14 +37,16 @@ func (q *TableCardinality) UnmarshalResult(v any) error {
 }
 
 func (q *TableCardinality) Resolve(ctx context.Context, rt *runtime.Runtime, instanceID string, priority int) error {
+	// NOTE: The row count in the catalog's table stats is approximate and may be stale, so we always count the rows
 	countSQL := fmt.Sprintf("SELECT count(*) AS count FROM %s",
-		safeName(q.TableName),
+		safeTableName(q.TableName),
 	)
 
-	olap, err := rt.OLAP(ctx, instanceID)
+	olap, release, err := rt.OLAP(ctx, instanceID)
 	if err != nil {
 		return err
 	}
+	defer release()
 
 	if olap.Dialect() != drivers.DialectDuckDB {
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
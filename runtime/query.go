@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"strings"
+
+	"github.com/rilldata/rill/runtime/drivers"
 )
 
 type Query interface {
@@ -59,3 +61,24 @@ func (r *Runtime) Query(ctx context.Context, instanceID string, query Query, pri
 	r.queryCache.add(key, query.MarshalResult())
 	return nil
 }
+
+// ApplyQueryLimits sets the instance's default query limits (timeout, max rows and max memory) on limits that aren't already set in stmt.
+// It should be called for statements issued by the query APIs (not for internal statements like ingestion).
+func (r *Runtime) ApplyQueryLimits(ctx context.Context, instanceID string, stmt *drivers.Statement) error {
+	inst, err := r.FindInstance(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+
+	if stmt.Timeout == 0 {
+		stmt.Timeout = inst.QueryTimeout
+	}
+	if stmt.MaxRows == 0 {
+		stmt.MaxRows = inst.QueryMaxRows
+	}
+	if stmt.MaxMemory == 0 {
+		stmt.MaxMemory = inst.QueryMaxMemory
+	}
+
+	return nil
+}
@@ -4,8 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"sync"
 
 	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/services/catalog/migrator"
+	"go.uber.org/zap"
 )
 
 func (r *Runtime) FindInstances(ctx context.Context) ([]*drivers.Instance, error) {
@@ -17,24 +21,119 @@ func (r *Runtime) FindInstance(ctx context.Context, instanceID string) (*drivers
 }
 
 func (r *Runtime) CreateInstance(ctx context.Context, inst *drivers.Instance) error {
-	// Check OLAP connection
-	olap, err := drivers.Open(inst.OLAPDriver, inst.OLAPDSN, r.logger)
+	err := r.checkOLAP(ctx, inst)
 	if err != nil {
 		return err
 	}
-	_, ok := olap.OLAPStore()
-	if !ok {
-		return fmt.Errorf("not a valid OLAP driver: '%s'", inst.OLAPDriver)
+
+	err = r.checkRepo(ctx, inst)
+	if err != nil {
+		return err
 	}
 
-	// Check repo connection
-	repo, err := drivers.Open(inst.RepoDriver, inst.RepoDSN, r.logger)
+	// Create instance
+	err = r.Registry().CreateInstance(ctx, inst)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// EditInstance updates an existing instance's drivers and settings. The instance is matched on inst.ID.
+// New connections are validated like in CreateInstance. If EmbedCatalog is toggled, the catalog entries are moved to the new catalog store.
+// The instance's cached catalog service and any cached connections that no longer apply are evicted.
+// If the instance's variables change, the instance is reconciled so its artifacts pick up the new values.
+// Note that data is not moved when the OLAP connection changes, so the next reconcile will re-create the instance's sources and models.
+func (r *Runtime) EditInstance(ctx context.Context, inst *drivers.Instance) error {
+	old, err := r.FindInstance(ctx, inst.ID)
+	if err != nil {
+		return err
+	}
+
+	inst.CreatedOn = old.CreatedOn
+	olapChanged := inst.OLAPDriver != old.OLAPDriver || inst.OLAPDSN != old.OLAPDSN
+	repoChanged := inst.RepoDriver != old.RepoDriver || inst.RepoDSN != old.RepoDSN
+	embedChanged := inst.EmbedCatalog != old.EmbedCatalog
+	varsChanged := !drivers.EqualVariables(inst.Variables, old.Variables)
+
+	// Check new connections. An unchanged OLAP connection is only checked if it must now embed the catalog.
+	// (We don't open it again, since drivers like DuckDB don't support multiple handles to the same file.)
+	if olapChanged {
+		err = r.checkOLAP(ctx, inst)
+		if err != nil {
+			return err
+		}
+	} else if embedChanged && inst.EmbedCatalog {
+		conn, release, err := r.connCache.get(ctx, inst.ID, inst.OLAPDriver, inst.OLAPDSN)
+		if err != nil {
+			return err
+		}
+		_, ok := conn.CatalogStore()
+		release()
+		if !ok {
+			return errors.New("driver does not support embedded catalogs")
+		}
+	}
+	if repoChanged {
+		err = r.checkRepo(ctx, inst)
+		if err != nil {
+			return err
+		}
+	}
+
+	if embedChanged {
+		// Block reconciles while the catalog is moved, so they don't write to the old catalog after it has been copied.
+		// The lock is held until the catalog service (which uses the old catalog) has been evicted.
+		unlock := r.catalogLocks.lockMove(inst.ID)
+		err = r.moveCatalog(ctx, old, inst, func() error {
+			return r.Registry().EditInstance(ctx, inst)
+		})
+		if err != nil {
+			unlock()
+			return fmt.Errorf("failed to migrate catalog: %w", err)
+		}
+		r.catalogCache.evict(inst.ID)
+		unlock()
+	} else {
+		err = r.Registry().EditInstance(ctx, inst)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Invalidate caches. Connections that are still used by the instance are kept, so in-memory data isn't lost.
+	if olapChanged || repoChanged || varsChanged {
+		r.catalogCache.evict(inst.ID)
+	}
+	if olapChanged {
+		r.connCache.evictConnection(inst.ID, old.OLAPDriver, old.OLAPDSN)
+		r.queryCache.evict(inst.ID)
+	}
+	if repoChanged {
+		r.connCache.evictConnection(inst.ID, old.RepoDriver, old.RepoDSN)
+	}
+
+	if varsChanged {
+		_, err = r.Reconcile(ctx, inst.ID, nil, nil, false, false)
+		if err != nil {
+			return fmt.Errorf("instance updated, but failed to reconcile: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// checkOLAP checks that the instance's OLAP connection can be opened and prepared for use.
+func (r *Runtime) checkOLAP(ctx context.Context, inst *drivers.Instance) error {
+	olap, err := drivers.Open(inst.OLAPDriver, inst.OLAPDSN, r.logger)
 	if err != nil {
 		return err
 	}
-	_, ok = repo.RepoStore()
+	defer olap.Close()
+	_, ok := olap.OLAPStore()
 	if !ok {
-		return fmt.Errorf("not a valid repo driver: '%s'", inst.RepoDriver)
+		return fmt.Errorf("not a valid OLAP driver: '%s'", inst.OLAPDriver)
 	}
 
 	// Check that it's a driver that supports embedded catalogs
@@ -45,30 +144,277 @@ func (r *Runtime) CreateInstance(ctx context.Context, inst *drivers.Instance) er
 		}
 	}
 
-	// Prepare connections for use
+	// Prepare connection for use
 	err = olap.Migrate(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to prepare instance: %w", err)
 	}
+
+	return nil
+}
+
+// checkRepo checks that the instance's repo connection can be opened and prepared for use.
+func (r *Runtime) checkRepo(ctx context.Context, inst *drivers.Instance) error {
+	repo, err := drivers.Open(inst.RepoDriver, inst.RepoDSN, r.logger)
+	if err != nil {
+		return err
+	}
+	defer repo.Close()
+	_, ok := repo.RepoStore()
+	if !ok {
+		return fmt.Errorf("not a valid repo driver: '%s'", inst.RepoDriver)
+	}
+
+	// Prepare connection for use
 	err = repo.Migrate(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to prepare instance: %w", err)
 	}
 
-	// Create instance
-	err = r.Registry().CreateInstance(ctx, inst)
+	return nil
+}
+
+// catalogStore returns the catalog store for the instance (either embedded in its OLAP store or in the metastore).
+// The returned func must be called when the caller is done using the store.
+func (r *Runtime) catalogStore(ctx context.Context, inst *drivers.Instance) (drivers.CatalogStore, func(), error) {
+	if !inst.EmbedCatalog {
+		store, ok := r.metastore.CatalogStore()
+		if !ok {
+			return nil, nil, fmt.Errorf("metastore cannot serve as catalog")
+		}
+		return store, func() {}, nil
+	}
+
+	conn, release, err := r.connCache.get(ctx, inst.ID, inst.OLAPDriver, inst.OLAPDSN)
 	if err != nil {
+		return nil, nil, err
+	}
+	store, ok := conn.CatalogStore()
+	if !ok {
+		release()
+		return nil, nil, fmt.Errorf("instance cannot embed catalog")
+	}
+	return store, release, nil
+}
+
+// moveCatalog moves the catalog entries of an instance from the catalog store used by from to the one used by to.
+// The entries are copied, then persist is called to switch the instance to the new catalog store, and only then are
+// the entries deleted from the old catalog store. If copying or persist fails, the copies are rolled back.
+func (r *Runtime) moveCatalog(ctx context.Context, from, to *drivers.Instance, persist func() error) error {
+	src, releaseSrc, err := r.catalogStore(ctx, from)
+	if err != nil {
+		return err
+	}
+	defer releaseSrc()
+
+	dst, releaseDst, err := r.catalogStore(ctx, to)
+	if err != nil {
+		return err
+	}
+	defer releaseDst()
+
+	var undo []func() error
+	rollback := func() {
+		for i := len(undo) - 1; i >= 0; i-- {
+			err := undo[i]()
+			if err != nil {
+				r.logger.Error("failed to roll back catalog move", zap.String("instance_id", to.ID), zap.Error(err))
+			}
+		}
+	}
+
+	entries := src.FindEntries(ctx, from.ID, drivers.ObjectTypeUnspecified)
+	for _, entry := range entries {
+		entry := entry
+		if prev, ok := dst.FindEntry(ctx, to.ID, entry.Name); ok {
+			err = dst.UpdateEntry(ctx, to.ID, entry)
+			undo = append(undo, func() error { return dst.UpdateEntry(ctx, to.ID, prev) })
+		} else {
+			err = dst.CreateEntry(ctx, to.ID, entry)
+			undo = append(undo, func() error { return dst.DeleteEntry(ctx, to.ID, entry.Name) })
+		}
+		if err != nil {
+			undo = undo[:len(undo)-1]
+			rollback()
+			return err
+		}
+	}
+
+	err = persist()
+	if err != nil {
+		rollback()
 		return err
 	}
 
+	// The instance now uses the new catalog store, so the entries can be removed from the old one
+	for _, entry := range entries {
+		err = src.DeleteEntry(ctx, from.ID, entry.Name)
+		if err != nil {
+			return fmt.Errorf("instance updated, but failed to delete '%s' from the old catalog: %w", entry.Name, err)
+		}
+	}
+
 	return nil
 }
 
-func (r *Runtime) DeleteInstance(ctx context.Context, instanceID string) error {
-	err := r.Registry().DeleteInstance(ctx, instanceID)
+// catalogLocks contains a lock per instance that serializes moving the instance's catalog with writes to it (like reconciles).
+// Writers take a read lock, so they can run concurrently with each other.
+type catalogLocks struct {
+	lock  sync.Mutex
+	locks map[string]*sync.RWMutex
+}
+
+func newCatalogLocks() *catalogLocks {
+	return &catalogLocks{locks: make(map[string]*sync.RWMutex)}
+}
+
+// lockWrites blocks until the instance's catalog isn't being moved, and prevents it from being moved until the returned func is called.
+func (l *catalogLocks) lockWrites(instanceID string) func() {
+	lock := l.get(instanceID)
+	lock.RLock()
+	return lock.RUnlock
+}
+
+// lockMove blocks until there are no writes to the instance's catalog, and blocks new writes until the returned func is called.
+func (l *catalogLocks) lockMove(instanceID string) func() {
+	lock := l.get(instanceID)
+	lock.Lock()
+	return lock.Unlock
+}
+
+func (l *catalogLocks) get(instanceID string) *sync.RWMutex {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	lock, ok := l.locks[instanceID]
+	if !ok {
+		lock = &sync.RWMutex{}
+		l.locks[instanceID] = lock
+	}
+	return lock
+}
+
+func (l *catalogLocks) delete(instanceID string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	delete(l.locks, instanceID)
+}
+
+// DeleteInstanceResult reports what was removed when deleting an instance.
+type DeleteInstanceResult struct {
+	// ConnectionsEvicted is the number of cached connections that were evicted (and closed)
+	ConnectionsEvicted int
+	// QueryCacheEntriesPurged is the number of cached query results that were purged
+	QueryCacheEntriesPurged int
+	// CatalogEntriesDeleted are the names of the deleted catalog entries
+	CatalogEntriesDeleted []string
+	// DroppedObjects are the names of the dropped OLAP objects (only set when dropping data)
+	DroppedObjects []string
+	// OLAPDataDropped is true if the data stored by the OLAP driver was deleted (only set when dropping data)
+	OLAPDataDropped bool
+}
+
+// DeleteInstance tears down an instance and removes it from the registry.
+// It evicts (and closes) the instance's cached connections, purges its cached catalog service and query results,
+// and deletes its catalog entries from the metastore.
+//
+// If dropData is true, it also drops the OLAP objects of the instance's sources and models, deletes its embedded catalog,
+// and deletes the data stored by the OLAP driver (such as a DuckDB file). It then waits for the instance's connections
+// to close, so the call blocks until queries running against the instance have completed (or ctx is cancelled).
+// The instance's repo is never modified.
+func (r *Runtime) DeleteInstance(ctx context.Context, instanceID string, dropData bool) (*DeleteInstanceResult, error) {
+	inst, err := r.FindInstance(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &DeleteInstanceResult{}
+
+	// Delete catalog entries. Entries in an embedded catalog are part of the instance's data, so they're only deleted when dropping data.
+	if dropData || !inst.EmbedCatalog {
+		err = r.deleteInstanceData(ctx, inst, dropData, res)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Purge caches
+	r.catalogCache.evict(instanceID)
+	evicted := r.connCache.evict(instanceID)
+	res.ConnectionsEvicted = len(evicted)
+	res.QueryCacheEntriesPurged = r.queryCache.evict(instanceID)
+
+	err = r.Registry().DeleteInstance(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	r.catalogLocks.delete(instanceID)
+
+	if dropData {
+		// The stored data can only be deleted when the connections have been closed
+		err = r.connCache.waitClosed(ctx, evicted)
+		if err != nil {
+			return nil, fmt.Errorf("instance deleted, but failed waiting for connections to close: %w", err)
+		}
+
+		res.OLAPDataDropped, err = drivers.Drop(inst.OLAPDriver, inst.OLAPDSN, r.logger)
+		if err != nil {
+			return nil, fmt.Errorf("instance deleted, but failed to drop OLAP data: %w", err)
+		}
+	}
+
+	return res, nil
+}
+
+// deleteInstanceData deletes the instance's catalog entries, their history and the reconcile state, and if dropObjects is true, the OLAP objects they represent.
+func (r *Runtime) deleteInstanceData(ctx context.Context, inst *drivers.Instance, dropObjects bool, res *DeleteInstanceResult) error {
+	store, releaseStore, err := r.catalogStore(ctx, inst)
 	if err != nil {
 		return err
 	}
+	defer releaseStore()
+
+	// Only open the OLAP store when dropping objects, so deleting an instance with an unreachable OLAP driver doesn't fail
+	var olap drivers.OLAPStore
+	if dropObjects {
+		var release func()
+		olap, release, err = r.OLAP(ctx, inst.ID)
+		if err != nil {
+			return err
+		}
+		defer release()
+	}
+
+	// Delete dependents before their dependencies (metrics views, then models, then sources)
+	entries := store.FindEntries(ctx, inst.ID, drivers.ObjectTypeUnspecified)
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Type > entries[j].Type
+	})
+
+	for _, entry := range entries {
+		if dropObjects && (entry.Type == drivers.ObjectTypeSource || entry.Type == drivers.ObjectTypeModel) {
+			err := migrator.Delete(ctx, olap, entry)
+			if err != nil {
+				return fmt.Errorf("failed to drop '%s': %w", entry.Name, err)
+			}
+			res.DroppedObjects = append(res.DroppedObjects, entry.Name)
+		}
+
+		err := store.DeleteEntry(ctx, inst.ID, entry.Name)
+		if err != nil {
+			return fmt.Errorf("failed to delete catalog entry '%s': %w", entry.Name, err)
+		}
+		res.CatalogEntriesDeleted = append(res.CatalogEntriesDeleted, entry.Name)
+	}
+
+	err = store.DeleteReconcileState(ctx, inst.ID)
+	if err != nil {
+		return fmt.Errorf("failed to delete reconcile state: %w", err)
+	}
+
+	err = store.DeleteEntryVersions(ctx, inst.ID)
+	if err != nil {
+		return fmt.Errorf("failed to delete catalog history: %w", err)
+	}
 
 	return nil
 }
@@ -0,0 +1,58 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	_ "github.com/rilldata/rill/runtime/drivers/duckdb"
+	_ "github.com/rilldata/rill/runtime/drivers/file"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestMoveCatalog(t *testing.T) {
+	ctx := context.Background()
+	rt, err := New(&Options{
+		ConnectionCacheSize: 10,
+		MetastoreDriver:     "sqlite",
+		MetastoreDSN:        "file:TestMoveCatalog?mode=memory&cache=shared",
+		QueryCacheSize:      10,
+	}, zap.NewNop())
+	require.NoError(t, err)
+	defer rt.Close()
+
+	from := &drivers.Instance{
+		OLAPDriver:   "duckdb",
+		RepoDriver:   "file",
+		RepoDSN:      t.TempDir(),
+		EmbedCatalog: true,
+	}
+	require.NoError(t, rt.CreateInstance(ctx, from))
+	to := *from
+	to.EmbedCatalog = false
+
+	src, release, err := rt.catalogStore(ctx, from)
+	require.NoError(t, err)
+	defer release()
+	dst, _, err := rt.catalogStore(ctx, &to)
+	require.NoError(t, err)
+	require.NoError(t, src.CreateEntry(ctx, from.ID, &drivers.CatalogEntry{Name: "foo", Type: drivers.ObjectTypeTable, Object: &runtimev1.Table{Name: "foo"}}))
+
+	// the copies are rolled back if the instance can't be updated
+	err = rt.moveCatalog(ctx, from, &to, func() error { return errors.New("persist failed") })
+	require.ErrorContains(t, err, "persist failed")
+	require.Len(t, src.FindEntries(ctx, from.ID, drivers.ObjectTypeUnspecified), 1)
+	require.Len(t, dst.FindEntries(ctx, to.ID, drivers.ObjectTypeUnspecified), 0)
+
+	// the old entries are only deleted once the instance has been updated
+	err = rt.moveCatalog(ctx, from, &to, func() error {
+		require.Len(t, src.FindEntries(ctx, from.ID, drivers.ObjectTypeUnspecified), 1)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, src.FindEntries(ctx, from.ID, drivers.ObjectTypeUnspecified), 0)
+	require.Len(t, dst.FindEntries(ctx, to.ID, drivers.ObjectTypeUnspecified), 1)
+}
@@ -0,0 +1,239 @@
+package runtime_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteInstance(t *testing.T) {
+	ctx := context.Background()
+	rt, instanceID, dbPath := newFileInstance(t)
+
+	// Populate the query cache
+	q := &constQuery{}
+	require.NoError(t, rt.Query(ctx, instanceID, q, 1))
+	require.Equal(t, 1, q.Result)
+
+	res, err := rt.DeleteInstance(ctx, instanceID, false)
+	require.NoError(t, err)
+	require.Equal(t, 2, res.ConnectionsEvicted)
+	require.Equal(t, 1, res.QueryCacheEntriesPurged)
+	// The catalog is embedded, so it's kept along with the data
+	require.Empty(t, res.CatalogEntriesDeleted)
+	require.Empty(t, res.DroppedObjects)
+	require.False(t, res.OLAPDataDropped)
+
+	_, err = rt.FindInstance(ctx, instanceID)
+	require.ErrorIs(t, err, drivers.ErrNotFound)
+	_, err = os.Stat(dbPath)
+	require.NoError(t, err)
+
+	_, err = rt.DeleteInstance(ctx, instanceID, false)
+	require.ErrorIs(t, err, drivers.ErrNotFound)
+}
+
+func TestDeleteInstanceDropData(t *testing.T) {
+	ctx := context.Background()
+	rt, instanceID, dbPath := newFileInstance(t)
+
+	// Hold a connection while deleting, which delays deleting the data until it's released
+	olap, release, err := rt.OLAP(ctx, instanceID)
+	require.NoError(t, err)
+	go func() {
+		rows, err := olap.Execute(ctx, &drivers.Statement{Query: "SELECT * FROM foo"})
+		if err == nil {
+			rows.Close()
+		}
+		release()
+	}()
+
+	res, err := rt.DeleteInstance(ctx, instanceID, true)
+	require.NoError(t, err)
+	require.Equal(t, 2, res.ConnectionsEvicted)
+	require.Equal(t, []string{"foo"}, res.CatalogEntriesDeleted)
+	require.Equal(t, []string{"foo"}, res.DroppedObjects)
+	require.True(t, res.OLAPDataDropped)
+
+	_, err = rt.FindInstance(ctx, instanceID)
+	require.ErrorIs(t, err, drivers.ErrNotFound)
+	_, err = os.Stat(dbPath)
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestDeleteInstanceUnreachableOLAP(t *testing.T) {
+	ctx := context.Background()
+	rt := testruntime.New(t)
+
+	// The OLAP store can't be opened, but it's not needed to delete the instance without dropping its data
+	inst := &drivers.Instance{
+		OLAPDriver: "duckdb",
+		OLAPDSN:    filepath.Join(t.TempDir(), "missing", "stage.db"),
+		RepoDriver: "file",
+		RepoDSN:    t.TempDir(),
+	}
+	require.NoError(t, rt.Registry().CreateInstance(ctx, inst))
+	_, _, err := rt.OLAP(ctx, inst.ID)
+	require.Error(t, err)
+
+	res, err := rt.DeleteInstance(ctx, inst.ID, false)
+	require.NoError(t, err)
+	require.Empty(t, res.DroppedObjects)
+
+	_, err = rt.FindInstance(ctx, inst.ID)
+	require.ErrorIs(t, err, drivers.ErrNotFound)
+}
+
+func TestEditInstance(t *testing.T) {
+	ctx := context.Background()
+	rt, instanceID, _ := newFileInstance(t)
+
+	inst, err := rt.FindInstance(ctx, instanceID)
+	require.NoError(t, err)
+	updatedOn := inst.UpdatedOn
+
+	// Move the catalog to the metastore
+	inst.EmbedCatalog = false
+	require.NoError(t, rt.EditInstance(ctx, inst))
+	require.True(t, inst.UpdatedOn.After(updatedOn))
+	_, err = rt.GetCatalogEntry(ctx, instanceID, "foo")
+	require.NoError(t, err)
+
+	// Switch to a new DuckDB file, which is populated on the next reconcile
+	inst.OLAPDSN = filepath.Join(t.TempDir(), "bigger.db")
+	require.NoError(t, rt.EditInstance(ctx, inst))
+	res, err := rt.Reconcile(ctx, instanceID, nil, nil, false, false)
+	require.NoError(t, err)
+	require.Empty(t, res.Errors)
+
+	olap, release, err := rt.OLAP(ctx, instanceID)
+	require.NoError(t, err)
+	defer release()
+	rows, err := olap.Execute(ctx, &drivers.Statement{Query: "SELECT a FROM foo"})
+	require.NoError(t, err)
+	require.True(t, rows.Next())
+	require.NoError(t, rows.Close())
+
+	// Invalid changes are rejected
+	inst.OLAPDriver = "file"
+	require.Error(t, rt.EditInstance(ctx, inst))
+	res2, err := rt.FindInstance(ctx, instanceID)
+	require.NoError(t, err)
+	require.Equal(t, "duckdb", res2.OLAPDriver)
+}
+
+func TestInstanceVariables(t *testing.T) {
+	ctx := context.Background()
+	rt := testruntime.New(t)
+
+	inst := &drivers.Instance{
+		OLAPDriver:   "duckdb",
+		RepoDriver:   "file",
+		RepoDSN:      t.TempDir(),
+		EmbedCatalog: true,
+	}
+	require.NoError(t, rt.CreateInstance(ctx, inst))
+
+	// Defaults are set in rill.yaml
+	err := rt.PutFile(ctx, inst.ID, "rill.yaml", strings.NewReader("vars:\n  n: 1\n"), drivers.PutOptions{Create: true})
+	require.NoError(t, err)
+	err = rt.PutFile(ctx, inst.ID, "models/foo.sql", strings.NewReader("SELECT {{ .vars.n }} AS a"), drivers.PutOptions{Create: true})
+	require.NoError(t, err)
+	res, err := rt.Reconcile(ctx, inst.ID, nil, nil, false, false)
+	require.NoError(t, err)
+	require.Empty(t, res.Errors)
+	require.Equal(t, 1, queryInt(t, rt, inst.ID, "SELECT a FROM foo"))
+
+	// Instance variables override the defaults, and changing them reconciles the instance
+	inst.Variables = map[string]string{"n": "2"}
+	require.NoError(t, rt.EditInstance(ctx, inst))
+	require.Equal(t, 2, queryInt(t, rt, inst.ID, "SELECT a FROM foo"))
+
+	// Changes to rill.yaml are picked up when reconciling
+	err = rt.PutFile(ctx, inst.ID, "rill.yaml", strings.NewReader("vars:\n  n: 3\n"), drivers.PutOptions{Create: true})
+	require.NoError(t, err)
+	inst.Variables = nil
+	require.NoError(t, rt.EditInstance(ctx, inst))
+	require.Equal(t, 3, queryInt(t, rt, inst.ID, "SELECT a FROM foo"))
+
+	err = rt.PutFile(ctx, inst.ID, "rill.yaml", strings.NewReader("vars:\n  n: 4\n"), drivers.PutOptions{Create: true})
+	require.NoError(t, err)
+	res, err = rt.Reconcile(ctx, inst.ID, []string{"rill.yaml"}, nil, false, false)
+	require.NoError(t, err)
+	require.Empty(t, res.Errors)
+	require.Equal(t, []string{"/models/foo.sql"}, res.AffectedPaths)
+	require.Equal(t, 4, queryInt(t, rt, inst.ID, "SELECT a FROM foo"))
+}
+
+func queryInt(t *testing.T, rt *runtime.Runtime, instanceID, qry string) int {
+	olap, release, err := rt.OLAP(context.Background(), instanceID)
+	require.NoError(t, err)
+	defer release()
+
+	rows, err := olap.Execute(context.Background(), &drivers.Statement{Query: qry})
+	require.NoError(t, err)
+	defer rows.Close()
+
+	var res int
+	require.True(t, rows.Next())
+	require.NoError(t, rows.Scan(&res))
+	return res
+}
+
+// newFileInstance creates an instance backed by a DuckDB file with a single model "foo".
+func newFileInstance(t *testing.T) (*runtime.Runtime, string, string) {
+	ctx := context.Background()
+	rt := testruntime.New(t)
+	dbPath := filepath.Join(t.TempDir(), "stage.db")
+
+	inst := &drivers.Instance{
+		OLAPDriver:   "duckdb",
+		OLAPDSN:      dbPath,
+		RepoDriver:   "file",
+		RepoDSN:      t.TempDir(),
+		EmbedCatalog: true,
+	}
+	require.NoError(t, rt.CreateInstance(ctx, inst))
+
+	err := rt.PutFile(ctx, inst.ID, "models/foo.sql", strings.NewReader("SELECT 1 AS a"), drivers.PutOptions{Create: true})
+	require.NoError(t, err)
+	res, err := rt.Reconcile(ctx, inst.ID, nil, nil, false, false)
+	require.NoError(t, err)
+	require.Empty(t, res.Errors)
+
+	return rt, inst.ID, dbPath
+}
+
+// constQuery is a cacheable query that doesn't touch the instance's infra.
+type constQuery struct {
+	Result int
+}
+
+func (q *constQuery) Key() string {
+	return "const"
+}
+
+func (q *constQuery) Deps() []string {
+	return nil
+}
+
+func (q *constQuery) MarshalResult() any {
+	return q.Result
+}
+
+func (q *constQuery) UnmarshalResult(v any) error {
+	q.Result = v.(int)
+	return nil
+}
+
+func (q *constQuery) Resolve(ctx context.Context, rt *runtime.Runtime, instanceID string, priority int) error {
+	q.Result = 1
+	return nil
+}
@@ -2,79 +2,133 @@ package runtime
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"io"
 	"time"
+
+	"github.com/rilldata/rill/runtime/drivers"
 )
 
-func (r *Runtime) ListFiles(ctx context.Context, instanceID, glob string) ([]string, error) {
-	repo, err := r.Repo(ctx, instanceID)
+func (r *Runtime) ListFiles(ctx context.Context, instanceID, glob string, pageSize int, pageToken string) ([]string, string, error) {
+	repo, release, err := r.Repo(ctx, instanceID)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
+	defer release()
 
-	return repo.ListRecursive(ctx, instanceID, glob)
+	return repo.ListRecursive(ctx, instanceID, glob, pageSize, pageToken)
 }
 
-func (r *Runtime) GetFile(ctx context.Context, instanceID, path string) (string, time.Time, error) {
-	repo, err := r.Repo(ctx, instanceID)
+func (r *Runtime) GetFile(ctx context.Context, instanceID, path string) (string, *drivers.RepoObjectStat, error) {
+	repo, release, err := r.Repo(ctx, instanceID)
 	if err != nil {
-		return "", time.Time{}, err
+		return "", nil, err
+	}
+	defer release()
+
+	// TODO: Could we return Stat as part of Get?
+	// Stat is called before Get, so if the file changes in between, a later PutFile with the returned
+	// version will fail with a conflict instead of overwriting the change.
+	stat, err := repo.Stat(ctx, instanceID, path)
+	if err != nil {
+		return "", nil, err
 	}
 
 	blob, err := repo.Get(ctx, instanceID, path)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return blob, stat, nil
+}
+
+func (r *Runtime) GetFileRevision(ctx context.Context, instanceID, path, revision string) (string, time.Time, error) {
+	history, release, err := r.repoHistory(ctx, instanceID)
 	if err != nil {
 		return "", time.Time{}, err
 	}
+	defer release()
 
-	// TODO: Could we return Stat as part of Get?
-	stat, err := repo.Stat(ctx, instanceID, path)
+	blob, rev, err := history.GetRevision(ctx, instanceID, path, revision)
 	if err != nil {
 		return "", time.Time{}, err
 	}
 
-	return blob, stat.LastUpdated, nil
+	return blob, rev.CreatedOn, nil
 }
 
-func (r *Runtime) PutFile(ctx context.Context, instanceID, path string, blob io.Reader, create, createOnly bool) error {
-	repo, err := r.Repo(ctx, instanceID)
+func (r *Runtime) ListFileRevisions(ctx context.Context, instanceID, path string) ([]*drivers.RepoRevision, error) {
+	history, release, err := r.repoHistory(ctx, instanceID)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	defer release()
 
-	// TODO: Handle create, createOnly
+	return history.ListRevisions(ctx, instanceID, path)
+}
 
-	err = repo.Put(ctx, instanceID, path, blob)
+func (r *Runtime) RevertFile(ctx context.Context, instanceID, path, revision string) error {
+	history, release, err := r.repoHistory(ctx, instanceID)
 	if err != nil {
 		return err
 	}
+	defer release()
 
-	return nil
+	return history.Revert(ctx, instanceID, path, revision)
 }
 
-func (r *Runtime) DeleteFile(ctx context.Context, instanceID, path string) error {
-	repo, err := r.Repo(ctx, instanceID)
+func (r *Runtime) PutFile(ctx context.Context, instanceID, path string, blob io.Reader, opts drivers.PutOptions) error {
+	repo, release, err := r.Repo(ctx, instanceID)
 	if err != nil {
 		return err
 	}
+	defer release()
 
-	err = repo.Delete(ctx, instanceID, path)
+	return repo.Put(ctx, instanceID, path, blob, opts)
+}
+
+func (r *Runtime) DeleteFile(ctx context.Context, instanceID, path string) error {
+	repo, release, err := r.Repo(ctx, instanceID)
 	if err != nil {
 		return err
 	}
+	defer release()
 
-	return nil
+	return repo.Delete(ctx, instanceID, path)
 }
 
 func (r *Runtime) RenameFile(ctx context.Context, instanceID, fromPath, toPath string) error {
-	repo, err := r.Repo(ctx, instanceID)
+	repo, release, err := r.Repo(ctx, instanceID)
 	if err != nil {
 		return err
 	}
+	defer release()
 
-	err = repo.Rename(ctx, instanceID, fromPath, toPath)
+	return repo.Rename(ctx, instanceID, fromPath, toPath)
+}
+
+// errRevisionsNotTracked is returned for history operations on repos that don't implement drivers.RepoHistoryStore
+var errRevisionsNotTracked = errors.New("does not track file revisions")
+
+// IsRevisionsNotTracked returns true if err was returned because the instance's repo doesn't track file revisions.
+func IsRevisionsNotTracked(err error) bool {
+	return errors.Is(err, errRevisionsNotTracked)
+}
+
+// repoHistory returns the instance's repo if it tracks the history of its files.
+// The returned release func must be called when the caller is done with the repo.
+func (r *Runtime) repoHistory(ctx context.Context, instanceID string) (drivers.RepoHistoryStore, func(), error) {
+	repo, release, err := r.Repo(ctx, instanceID)
 	if err != nil {
-		return err
+		return nil, nil, err
+	}
+
+	history, ok := repo.(drivers.RepoHistoryStore)
+	if !ok {
+		release()
+		return nil, nil, fmt.Errorf("repo driver '%s' %w", repo.Driver(), errRevisionsNotTracked)
 	}
 
-	return nil
+	return history, release, nil
 }
@@ -3,6 +3,7 @@ package runtime
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/rilldata/rill/runtime/drivers"
 	"go.uber.org/zap"
@@ -10,18 +11,26 @@ import (
 
 type Options struct {
 	ConnectionCacheSize int
-	MetastoreDriver     string
-	MetastoreDSN        string
-	QueryCacheSize      int
+	// ConnectionCacheIdleTTL closes cached connections that haven't been used for the duration. If 0, idle connections are kept open.
+	// Note that closing an in-memory OLAP connection discards its data.
+	ConnectionCacheIdleTTL time.Duration
+	MetastoreDriver        string
+	MetastoreDSN           string
+	QueryCacheSize         int
+	// ReconcileConcurrency is the maximum number of independent artifacts reconciled in parallel.
+	// If 0, catalog.DefaultConcurrency is used.
+	ReconcileConcurrency int
 }
 
 type Runtime struct {
-	opts         *Options
-	metastore    drivers.Connection
-	logger       *zap.Logger
-	connCache    *connectionCache
-	catalogCache *catalogCache
-	queryCache   *queryCache
+	opts          *Options
+	metastore     drivers.Connection
+	logger        *zap.Logger
+	connCache     *connectionCache
+	catalogCache  *catalogCache
+	catalogLocks  *catalogLocks
+	queryCache    *queryCache
+	reconcileSubs *reconcileSubscribers
 }
 
 func New(opts *Options, logger *zap.Logger) (*Runtime, error) {
@@ -41,14 +50,18 @@ func New(opts *Options, logger *zap.Logger) (*Runtime, error) {
 		return nil, fmt.Errorf("server metastore must be a valid registry")
 	}
 
-	return &Runtime{
-		opts:         opts,
-		metastore:    metastore,
-		logger:       logger,
-		connCache:    newConnectionCache(opts.ConnectionCacheSize, logger),
-		catalogCache: newCatalogCache(),
-		queryCache:   newQueryCache(opts.QueryCacheSize),
-	}, nil
+	rt := &Runtime{
+		opts:          opts,
+		metastore:     metastore,
+		logger:        logger,
+		connCache:     newConnectionCache(opts.ConnectionCacheSize, opts.ConnectionCacheIdleTTL, logger),
+		catalogCache:  newCatalogCache(),
+		catalogLocks:  newCatalogLocks(),
+		queryCache:    newQueryCache(opts.QueryCacheSize),
+		reconcileSubs: newReconcileSubscribers(),
+	}
+
+	return rt, nil
 }
 
 func (r *Runtime) Close() error {
@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/services/catalog"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -46,6 +48,45 @@ func (s *Server) GetCatalogEntry(ctx context.Context, req *runtimev1.GetCatalogE
 	return &runtimev1.GetCatalogEntryResponse{Entry: pb}, nil
 }
 
+// ListCatalogEntryVersions implements RuntimeService.
+func (s *Server) ListCatalogEntryVersions(ctx context.Context, req *runtimev1.ListCatalogEntryVersionsRequest) (*runtimev1.ListCatalogEntryVersionsResponse, error) {
+	versions, err := s.runtime.ListCatalogEntryVersions(ctx, req.InstanceId, req.Name)
+	if err != nil {
+		return nil, status.Error(codes.Unknown, err.Error())
+	}
+
+	pbs := make([]*runtimev1.CatalogEntryVersion, len(versions))
+	for i, v := range versions {
+		entry, err := catalogObjectToPB(v.Entry)
+		if err != nil {
+			return nil, status.Error(codes.Unknown, err.Error())
+		}
+		pbs[i] = &runtimev1.CatalogEntryVersion{
+			Version:     v.Version,
+			Operation:   runtimev1.CatalogEntryVersion_Operation(v.Operation),
+			Entry:       entry,
+			FromName:    v.FromName,
+			ContentHash: v.Entry.ContentHash,
+			CreatedOn:   timestamppb.New(v.CreatedOn),
+		}
+	}
+
+	return &runtimev1.ListCatalogEntryVersionsResponse{Versions: pbs}, nil
+}
+
+// RestoreCatalogEntryVersion implements RuntimeService.
+func (s *Server) RestoreCatalogEntryVersion(ctx context.Context, req *runtimev1.RestoreCatalogEntryVersionRequest) (*runtimev1.RestoreCatalogEntryVersionResponse, error) {
+	res, err := s.runtime.RestoreCatalogEntryVersion(ctx, req.InstanceId, req.Name, req.Version)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &runtimev1.RestoreCatalogEntryVersionResponse{
+		Errors:        res.Errors,
+		AffectedPaths: res.AffectedPaths,
+	}, nil
+}
+
 // Reconcile implements RuntimeService.
 func (s *Server) Reconcile(ctx context.Context, req *runtimev1.ReconcileRequest) (*runtimev1.ReconcileResponse, error) {
 	res, err := s.runtime.Reconcile(ctx, req.InstanceId, req.ChangedPaths, req.ForcedPaths, req.Dry, req.Strict)
@@ -59,11 +100,139 @@ func (s *Server) Reconcile(ctx context.Context, req *runtimev1.ReconcileRequest)
 	}, nil
 }
 
+// ReconcileStream implements RuntimeService.
+// Events are sent from a separate goroutine, since the catalog service emits them while holding its locks,
+// so a slow client doesn't block the reconcile (or other reconciles of the instance).
+func (s *Server) ReconcileStream(req *runtimev1.ReconcileRequest, srv runtimev1.RuntimeService_ReconcileStreamServer) error {
+	events := newReconcileEventQueue()
+	sendDone := make(chan error, 1)
+	go func() {
+		sendDone <- events.drain(func(e catalog.ReconcileEvent) error {
+			return srv.Send(&runtimev1.ReconcileStreamResponse{
+				Response: &runtimev1.ReconcileStreamResponse_Event{Event: reconcileEventToPB(e)},
+			})
+		})
+	}()
+
+	res, err := s.runtime.ReconcileStream(srv.Context(), req.InstanceId, req.ChangedPaths, req.ForcedPaths, req.Dry, req.Strict, events.push)
+	events.close()
+	sendErr := <-sendDone
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if sendErr != nil {
+		return sendErr
+	}
+
+	return srv.Send(&runtimev1.ReconcileStreamResponse{
+		Response: &runtimev1.ReconcileStreamResponse_Result{Result: &runtimev1.ReconcileResponse{
+			Errors:        res.Errors,
+			AffectedPaths: res.AffectedPaths,
+		}},
+	})
+}
+
+// reconcileEventQueue is an unbounded queue of reconcile events. push never blocks.
+type reconcileEventQueue struct {
+	lock   sync.Mutex
+	cond   *sync.Cond
+	events []catalog.ReconcileEvent
+	closed bool
+	failed bool
+}
+
+func newReconcileEventQueue() *reconcileEventQueue {
+	q := &reconcileEventQueue{}
+	q.cond = sync.NewCond(&q.lock)
+	return q
+}
+
+func (q *reconcileEventQueue) push(e catalog.ReconcileEvent) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	if q.closed || q.failed {
+		return
+	}
+	q.events = append(q.events, e)
+	q.cond.Signal()
+}
+
+// close makes drain return once the queued events have been sent.
+func (q *reconcileEventQueue) close() {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	q.closed = true
+	q.cond.Signal()
+}
+
+// drain calls send for every event in the queue until the queue is closed.
+// If send fails, it discards the remaining events and returns the error once the queue is closed.
+func (q *reconcileEventQueue) drain(send func(catalog.ReconcileEvent) error) error {
+	var sendErr error
+	for {
+		q.lock.Lock()
+		for len(q.events) == 0 && !q.closed {
+			q.cond.Wait()
+		}
+		events := q.events
+		q.events = nil
+		closed := q.closed
+		q.lock.Unlock()
+
+		for _, e := range events {
+			if sendErr != nil {
+				break
+			}
+			sendErr = send(e)
+			if sendErr != nil {
+				q.lock.Lock()
+				q.failed = true
+				q.events = nil
+				q.lock.Unlock()
+			}
+		}
+
+		if closed {
+			return sendErr
+		}
+	}
+}
+
+// WatchReconcile implements RuntimeService.
+func (s *Server) WatchReconcile(req *runtimev1.WatchReconcileRequest, srv runtimev1.RuntimeService_WatchReconcileServer) error {
+	_, err := s.runtime.FindInstance(srv.Context(), req.InstanceId)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	results, unsubscribe := s.runtime.SubscribeReconcile(req.InstanceId)
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-srv.Context().Done():
+			return nil
+		case res := <-results:
+			err := srv.Send(&runtimev1.WatchReconcileResponse{
+				Errors:        res.Errors,
+				AffectedPaths: res.AffectedPaths,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
 // PutFileAndReconcile implements RuntimeService.
 func (s *Server) PutFileAndReconcile(ctx context.Context, req *runtimev1.PutFileAndReconcileRequest) (*runtimev1.PutFileAndReconcileResponse, error) {
-	err := s.runtime.PutFile(ctx, req.InstanceId, req.Path, strings.NewReader(req.Blob), req.Create, req.CreateOnly)
+	err := s.runtime.PutFile(ctx, req.InstanceId, req.Path, strings.NewReader(req.Blob), drivers.PutOptions{
+		Create:          req.Create,
+		CreateOnly:      req.CreateOnly,
+		ExpectedVersion: req.ExpectedVersion,
+	})
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, putFileError(err)
 	}
 
 	changedPaths := []string{req.Path}
@@ -152,6 +321,34 @@ func (s *Server) TriggerSync(ctx context.Context, req *runtimev1.TriggerSyncRequ
 	return &runtimev1.TriggerSyncResponse{}, nil
 }
 
+func reconcileEventToPB(e catalog.ReconcileEvent) *runtimev1.ReconcileEvent {
+	var typ runtimev1.ReconcileEvent_Type
+	switch e.Type {
+	case catalog.ReconcileEventQueued:
+		typ = runtimev1.ReconcileEvent_TYPE_QUEUED
+	case catalog.ReconcileEventStarted:
+		typ = runtimev1.ReconcileEvent_TYPE_STARTED
+	case catalog.ReconcileEventCreated:
+		typ = runtimev1.ReconcileEvent_TYPE_CREATED
+	case catalog.ReconcileEventUpdated:
+		typ = runtimev1.ReconcileEvent_TYPE_UPDATED
+	case catalog.ReconcileEventRenamed:
+		typ = runtimev1.ReconcileEvent_TYPE_RENAMED
+	case catalog.ReconcileEventDropped:
+		typ = runtimev1.ReconcileEvent_TYPE_DROPPED
+	case catalog.ReconcileEventErrored:
+		typ = runtimev1.ReconcileEvent_TYPE_ERRORED
+	}
+
+	return &runtimev1.ReconcileEvent{
+		Type:       typ,
+		Name:       e.Name,
+		Path:       e.Path,
+		DurationMs: e.Duration.Milliseconds(),
+		Errors:     e.Errors,
+	}
+}
+
 func pbToObjectType(in runtimev1.ObjectType) drivers.ObjectType {
 	switch in {
 	case runtimev1.ObjectType_OBJECT_TYPE_UNSPECIFIED:
@@ -175,6 +372,7 @@ func catalogObjectToPB(obj *drivers.CatalogEntry) (*runtimev1.CatalogEntry, erro
 		CreatedOn:   timestamppb.New(obj.CreatedOn),
 		UpdatedOn:   timestamppb.New(obj.UpdatedOn),
 		RefreshedOn: timestamppb.New(obj.RefreshedOn),
+		Folder:      catalog.ArtifactFolder(obj.Path),
 	}
 
 	switch obj.Type {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"path/filepath"
 	"testing"
+	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/services/catalog/testutils"
@@ -17,8 +18,9 @@ func TestServer_PutFileAndReconcile(t *testing.T) {
 	srv, err := NewServer(&Options{}, rt, nil)
 	require.NoError(t, err)
 
-	cat, err := rt.Catalog(ctx, instanceID)
+	cat, release, err := rt.Catalog(ctx, instanceID)
 	require.NoError(t, err)
+	defer release()
 
 	sourcePath := "/sources/ad_bids_source.yaml"
 	csvPath := filepath.Join("../testruntime/testdata/ad_bids/data", "AdBids.csv.gz")
@@ -76,3 +78,159 @@ func TestServer_PutFileAndReconcile(t *testing.T) {
 	testutils.AssertTableAbsence(t, cat, "ad_bids_source")
 	testutils.AssertTableAbsence(t, cat, "ad_bids_new")
 }
+
+func TestServer_CatalogEntryVersions(t *testing.T) {
+	ctx := context.Background()
+	rt, instanceID := testruntime.NewInstanceWithModel(t, "ad_bids", "select 1 as a")
+	srv, err := NewServer(&Options{}, rt, nil)
+	require.NoError(t, err)
+
+	modelPath := "/models/ad_bids.sql"
+	time.Sleep(10 * time.Millisecond)
+	resp, err := srv.PutFileAndReconcile(ctx, &runtimev1.PutFileAndReconcileRequest{
+		InstanceId: instanceID,
+		Path:       modelPath,
+		Blob:       "select 2 as a",
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Errors, 0)
+
+	listResp, err := srv.ListCatalogEntryVersions(ctx, &runtimev1.ListCatalogEntryVersionsRequest{
+		InstanceId: instanceID,
+		Name:       "ad_bids",
+	})
+	require.NoError(t, err)
+	require.Len(t, listResp.Versions, 2)
+	require.Equal(t, runtimev1.CatalogEntryVersion_OPERATION_UPDATE, listResp.Versions[0].Operation)
+	require.Equal(t, "select 2 as a", listResp.Versions[0].Entry.GetModel().Sql)
+	created := listResp.Versions[1]
+	require.Equal(t, runtimev1.CatalogEntryVersion_OPERATION_CREATE, created.Operation)
+	require.Equal(t, "select 1 as a", created.Entry.GetModel().Sql)
+	require.NotEqual(t, listResp.Versions[0].ContentHash, created.ContentHash)
+
+	// roll back the update
+	restoreResp, err := srv.RestoreCatalogEntryVersion(ctx, &runtimev1.RestoreCatalogEntryVersionRequest{
+		InstanceId: instanceID,
+		Name:       "ad_bids",
+		Version:    created.Version,
+	})
+	require.NoError(t, err)
+	require.Len(t, restoreResp.Errors, 0)
+	entryResp, err := srv.GetCatalogEntry(ctx, &runtimev1.GetCatalogEntryRequest{InstanceId: instanceID, Name: "ad_bids"})
+	require.NoError(t, err)
+	require.Equal(t, "select 1 as a", entryResp.Entry.GetModel().Sql)
+
+	// restore a deleted entry
+	delResp, err := srv.DeleteFileAndReconcile(ctx, &runtimev1.DeleteFileAndReconcileRequest{
+		InstanceId: instanceID,
+		Path:       modelPath,
+	})
+	require.NoError(t, err)
+	require.Len(t, delResp.Errors, 0)
+	listResp, err = srv.ListCatalogEntryVersions(ctx, &runtimev1.ListCatalogEntryVersionsRequest{
+		InstanceId: instanceID,
+		Name:       "ad_bids",
+	})
+	require.NoError(t, err)
+	require.Len(t, listResp.Versions, 4)
+	require.Equal(t, runtimev1.CatalogEntryVersion_OPERATION_DELETE, listResp.Versions[0].Operation)
+	restoreResp, err = srv.RestoreCatalogEntryVersion(ctx, &runtimev1.RestoreCatalogEntryVersionRequest{
+		InstanceId: instanceID,
+		Name:       "ad_bids",
+		Version:    listResp.Versions[0].Version,
+	})
+	require.NoError(t, err)
+	require.Len(t, restoreResp.Errors, 0)
+	_, err = srv.GetCatalogEntry(ctx, &runtimev1.GetCatalogEntryRequest{InstanceId: instanceID, Name: "ad_bids"})
+	require.NoError(t, err)
+
+	// the artifact is restored as it was written, so its variable references are kept
+	_, err = srv.EditInstance(ctx, &runtimev1.EditInstanceRequest{InstanceId: instanceID, Variables: map[string]string{"n": "3"}})
+	require.NoError(t, err)
+	time.Sleep(10 * time.Millisecond)
+	resp, err = srv.PutFileAndReconcile(ctx, &runtimev1.PutFileAndReconcileRequest{
+		InstanceId: instanceID,
+		Path:       modelPath,
+		Blob:       "select {{ .vars.n }} as a",
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Errors, 0)
+	time.Sleep(10 * time.Millisecond)
+	resp, err = srv.PutFileAndReconcile(ctx, &runtimev1.PutFileAndReconcileRequest{
+		InstanceId: instanceID,
+		Path:       modelPath,
+		Blob:       "select 4 as a",
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Errors, 0)
+	listResp, err = srv.ListCatalogEntryVersions(ctx, &runtimev1.ListCatalogEntryVersionsRequest{
+		InstanceId: instanceID,
+		Name:       "ad_bids",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "select 3 as a", listResp.Versions[1].Entry.GetModel().Sql)
+	_, err = srv.RestoreCatalogEntryVersion(ctx, &runtimev1.RestoreCatalogEntryVersionRequest{
+		InstanceId: instanceID,
+		Name:       "ad_bids",
+		Version:    listResp.Versions[1].Version,
+	})
+	require.NoError(t, err)
+	fileResp, err := srv.GetFile(ctx, &runtimev1.GetFileRequest{InstanceId: instanceID, Path: modelPath})
+	require.NoError(t, err)
+	require.Equal(t, "select {{ .vars.n }} as a", fileResp.Blob)
+
+	// versions of other entries can't be restored through the entry
+	_, err = srv.RestoreCatalogEntryVersion(ctx, &runtimev1.RestoreCatalogEntryVersionRequest{
+		InstanceId: instanceID,
+		Name:       "other",
+		Version:    created.Version,
+	})
+	require.Error(t, err)
+}
+
+func TestServer_ReconcileStreamSlowClient(t *testing.T) {
+	ctx := context.Background()
+	rt, instanceID := testruntime.NewInstanceWithModel(t, "ad_bids", "select 1 as a")
+	srv, err := NewServer(&Options{}, rt, nil)
+	require.NoError(t, err)
+
+	modelPath := "/models/ad_bids.sql"
+	stream := &blockingReconcileStream{ctx: ctx, unblock: make(chan struct{})}
+	done := make(chan error, 1)
+	go func() {
+		done <- srv.ReconcileStream(&runtimev1.ReconcileRequest{
+			InstanceId:   instanceID,
+			ChangedPaths: []string{modelPath},
+			ForcedPaths:  []string{modelPath},
+		}, stream)
+	}()
+
+	// other reconciles aren't blocked by the client that doesn't receive events
+	resp, err := srv.Reconcile(ctx, &runtimev1.ReconcileRequest{InstanceId: instanceID})
+	require.NoError(t, err)
+	require.Len(t, resp.Errors, 0)
+
+	close(stream.unblock)
+	require.NoError(t, <-done)
+	require.Greater(t, len(stream.sent), 1)
+	require.NotNil(t, stream.sent[0].GetEvent())
+	require.NotNil(t, stream.sent[len(stream.sent)-1].GetResult())
+}
+
+// blockingReconcileStream is a ReconcileStream server stream whose Send blocks until unblock is closed
+type blockingReconcileStream struct {
+	runtimev1.RuntimeService_ReconcileStreamServer
+	ctx     context.Context
+	unblock chan struct{}
+	sent    []*runtimev1.ReconcileStreamResponse
+}
+
+func (s *blockingReconcileStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *blockingReconcileStream) Send(res *runtimev1.ReconcileStreamResponse) error {
+	<-s.unblock
+	s.sent = append(s.sent, res)
+	return nil
+}
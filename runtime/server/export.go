@@ -1,29 +1,38 @@
 package server
 
 import (
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
-	"os"
-	"path"
+	"time"
 
+	"github.com/apache/arrow/go/v9/arrow/ipc"
+	"github.com/apache/arrow/go/v9/parquet"
+	"github.com/apache/arrow/go/v9/parquet/pqarrow"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
 )
 
 // ExportTable exports a table or view as a flat file and triggers a HTTP download of it.
 // It's mounted as a REST API only, and is not available over gRPC.
+// All formats are written from the Arrow records returned by the OLAP driver, so they work for all OLAP drivers.
 //
-// TODO: This is a temporary hack that only supports DuckDB.
-// We should add a generic workflow for data export that also supports possibly very large tables.
+// TODO: We should add a generic workflow for data export that also supports possibly very large tables.
 func (s *Server) ExportTable(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
-	var exportString string
+	var write func(w io.Writer, rdr drivers.RecordReader) error
+	var ext, contentType string
 	switch pathParams["format"] {
 	case "csv":
-		exportString = "FORMAT CSV, HEADER"
+		write, ext, contentType = writeCSV, "csv", "text/csv"
 	case "parquet":
-		exportString = "FORMAT PARQUET"
+		write, ext, contentType = writeParquet, "parquet", "application/vnd.apache.parquet"
+	case "arrow":
+		write, ext, contentType = writeArrow, "arrows", "application/vnd.apache.arrow.stream"
 	default:
 		http.Error(w, fmt.Sprintf("unknown format: %s", pathParams), http.StatusBadRequest)
+		return
 	}
 
 	if pathParams["instance_id"] == "" || pathParams["table_name"] == "" {
@@ -31,37 +40,137 @@ func (s *Server) ExportTable(w http.ResponseWriter, req *http.Request, pathParam
 		return
 	}
 
-	fileName := fmt.Sprintf("%s.%s", pathParams["table_name"], pathParams["format"])
-	filePath := path.Join(os.TempDir(), fileName)
-	defer os.Remove(filePath)
-
-	// select * from the table and write to the temp file (DuckDB only)
-	olap, err := s.runtime.OLAP(req.Context(), pathParams["instance_id"])
+	olap, release, err := s.runtime.OLAP(req.Context(), pathParams["instance_id"])
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
-	err = olap.Exec(req.Context(), &drivers.Statement{
-		Query: fmt.Sprintf("COPY (SELECT * FROM %s) TO '%s' (%s)", pathParams["table_name"], filePath, exportString),
+	defer release()
+
+	rdr, err := olap.ExecuteArrow(req.Context(), &drivers.Statement{
+		Query: fmt.Sprintf("SELECT * FROM %s", drivers.QuoteTableName(pathParams["table_name"])),
 	})
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	defer rdr.Release()
 
 	// set the header to trigger download
-	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s", fileName))
-	w.Header().Set("Content-Type", req.Header.Get("Content-Type"))
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("%s.%s", pathParams["table_name"], ext)))
+	w.Header().Set("Content-Type", contentType)
 
-	// read and stream the file
-	file, err := os.Open(filePath)
+	// NOTE: Once the first record is written, errors can't change the response status, so they abort the download instead
+	err = write(w, rdr)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
+		panic(http.ErrAbortHandler)
+	}
+}
+
+// writeArrow writes the records in the Arrow IPC stream format.
+func writeArrow(w io.Writer, rdr drivers.RecordReader) error {
+	fw := ipc.NewWriter(w, ipc.WithSchema(rdr.Schema()))
+	for rdr.Next() {
+		err := fw.Write(rdr.Record())
+		if err != nil {
+			return err
+		}
 	}
-	_, err = io.Copy(w, file)
+	if err := rdr.Err(); err != nil {
+		return err
+	}
+	return fw.Close()
+}
+
+// writeParquet writes the records as a Parquet file.
+func writeParquet(w io.Writer, rdr drivers.RecordReader) error {
+	fw, err := pqarrow.NewFileWriter(rdr.Schema(), w, parquet.NewWriterProperties(), pqarrow.DefaultWriterProps())
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
+		return err
+	}
+	for rdr.Next() {
+		err := fw.Write(rdr.Record())
+		if err != nil {
+			return err
+		}
+	}
+	if err := rdr.Err(); err != nil {
+		return err
+	}
+	return fw.Close()
+}
+
+// writeCSV writes the records as CSV with a header row. Nested values are written as JSON.
+func writeCSV(w io.Writer, rdr drivers.RecordReader) error {
+	schema := rdr.ResultSchema()
+	cw := csv.NewWriter(w)
+
+	header := make([]string, len(rdr.Schema().Fields()))
+	for i, f := range rdr.Schema().Fields() {
+		header[i] = f.Name
+	}
+	err := cw.Write(header)
+	if err != nil {
+		return err
+	}
+
+	row := make([]string, len(header))
+	for rdr.Next() {
+		rec := rdr.Record()
+		for i := 0; i < int(rec.NumRows()); i++ {
+			for j, col := range rec.Columns() {
+				v, err := arrowValue(col, i, schema.Fields[j].Type, false)
+				if err != nil {
+					return err
+				}
+				row[j], err = csvValue(v, schema.Fields[j].Type)
+				if err != nil {
+					return err
+				}
+			}
+			err := cw.Write(row)
+			if err != nil {
+				return err
+			}
+		}
+		cw.Flush()
+		if err := cw.Error(); err != nil {
+			return err
+		}
+	}
+	if err := rdr.Err(); err != nil {
+		return err
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// csvValue formats a value returned by arrowValue for a CSV cell.
+func csvValue(v any, t *runtimev1.Type) (string, error) {
+	switch v := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case []byte:
+		return string(v), nil
+	case time.Time:
+		switch t.Code {
+		case runtimev1.Type_CODE_DATE:
+			return v.Format("2006-01-02"), nil
+		case runtimev1.Type_CODE_TIME:
+			return v.Format("15:04:05.999999"), nil
+		default:
+			return v.Format(time.RFC3339Nano), nil
+		}
+	case map[string]any, []any:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	default:
+		return fmt.Sprint(v), nil
 	}
 }
@@ -0,0 +1,45 @@
+package server
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/apache/arrow/go/v9/arrow/memory"
+	"github.com/apache/arrow/go/v9/parquet/file"
+	"github.com/apache/arrow/go/v9/parquet/pqarrow"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_ExportTable(t *testing.T) {
+	server, instanceId := getTableTestServerWithSql(t, `SELECT 1::int AS a, 'x,y' AS "b""b", 12345678901234.5678::DECIMAL(18,4) AS d`)
+
+	export := func(format, table string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		server.ExportTable(w, req, map[string]string{"instance_id": instanceId, "table_name": table, "format": format})
+		return w
+	}
+
+	w := export("csv", "test")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "a,\"b\"\"b\",d\n1,\"x,y\",12345678901234.5678\n", w.Body.String())
+
+	w = export("parquet", "test")
+	require.Equal(t, http.StatusOK, w.Code)
+	pf, err := file.NewParquetReader(bytes.NewReader(w.Body.Bytes()))
+	require.NoError(t, err)
+	fr, err := pqarrow.NewFileReader(pf, pqarrow.ArrowReadProperties{}, memory.NewGoAllocator())
+	require.NoError(t, err)
+	schema, err := fr.Schema()
+	require.NoError(t, err)
+	require.Len(t, schema.Fields(), 3)
+	require.Equal(t, int64(1), pf.NumRows())
+
+	// table names are quoted
+	w = export("csv", "test; DROP TABLE test")
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	w = export("csv", "test")
+	require.Equal(t, http.StatusOK, w.Code)
+}
@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"errors"
+	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
@@ -43,12 +44,16 @@ func (s *Server) GetInstance(ctx context.Context, req *runtimev1.GetInstanceRequ
 // CreateInstance implements RuntimeService.
 func (s *Server) CreateInstance(ctx context.Context, req *runtimev1.CreateInstanceRequest) (*runtimev1.CreateInstanceResponse, error) {
 	inst := &drivers.Instance{
-		ID:           req.InstanceId,
-		OLAPDriver:   req.OlapDriver,
-		OLAPDSN:      req.OlapDsn,
-		RepoDriver:   req.RepoDriver,
-		RepoDSN:      req.RepoDsn,
-		EmbedCatalog: req.EmbedCatalog,
+		ID:             req.InstanceId,
+		OLAPDriver:     req.OlapDriver,
+		OLAPDSN:        req.OlapDsn,
+		RepoDriver:     req.RepoDriver,
+		RepoDSN:        req.RepoDsn,
+		EmbedCatalog:   req.EmbedCatalog,
+		QueryTimeout:   time.Duration(req.QueryTimeoutMs) * time.Millisecond,
+		QueryMaxRows:   req.QueryMaxRows,
+		QueryMaxMemory: req.QueryMaxMemoryBytes,
+		Variables:      req.Variables,
 	}
 
 	err := s.runtime.CreateInstance(ctx, inst)
@@ -61,23 +66,94 @@ func (s *Server) CreateInstance(ctx context.Context, req *runtimev1.CreateInstan
 	}, nil
 }
 
+// EditInstance implements RuntimeService.
+func (s *Server) EditInstance(ctx context.Context, req *runtimev1.EditInstanceRequest) (*runtimev1.EditInstanceResponse, error) {
+	inst, err := s.runtime.FindInstance(ctx, req.InstanceId)
+	if err != nil {
+		if errors.Is(err, drivers.ErrNotFound) {
+			return nil, status.Error(codes.InvalidArgument, "instance not found")
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.OlapDriver != nil {
+		inst.OLAPDriver = *req.OlapDriver
+	}
+	if req.OlapDsn != nil {
+		inst.OLAPDSN = *req.OlapDsn
+	}
+	if req.RepoDriver != nil {
+		inst.RepoDriver = *req.RepoDriver
+	}
+	if req.RepoDsn != nil {
+		inst.RepoDSN = *req.RepoDsn
+	}
+	if req.EmbedCatalog != nil {
+		inst.EmbedCatalog = *req.EmbedCatalog
+	}
+	if req.QueryTimeoutMs != nil {
+		inst.QueryTimeout = time.Duration(*req.QueryTimeoutMs) * time.Millisecond
+	}
+	if req.QueryMaxRows != nil {
+		inst.QueryMaxRows = *req.QueryMaxRows
+	}
+	if req.QueryMaxMemoryBytes != nil {
+		inst.QueryMaxMemory = *req.QueryMaxMemoryBytes
+	}
+	if len(req.Variables) > 0 || len(req.UnsetVariables) > 0 {
+		vars := make(map[string]string, len(inst.Variables)+len(req.Variables))
+		for k, v := range inst.Variables {
+			vars[k] = v
+		}
+		for _, k := range req.UnsetVariables {
+			delete(vars, k)
+		}
+		for k, v := range req.Variables {
+			vars[k] = v
+		}
+		inst.Variables = vars
+	}
+
+	err = s.runtime.EditInstance(ctx, inst)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &runtimev1.EditInstanceResponse{
+		Instance: instanceToPB(inst),
+	}, nil
+}
+
 // DeleteInstance implements RuntimeService.
 func (s *Server) DeleteInstance(ctx context.Context, req *runtimev1.DeleteInstanceRequest) (*runtimev1.DeleteInstanceResponse, error) {
-	err := s.runtime.DeleteInstance(ctx, req.InstanceId)
+	res, err := s.runtime.DeleteInstance(ctx, req.InstanceId, req.DropData)
 	if err != nil {
+		if errors.Is(err, drivers.ErrNotFound) {
+			return nil, status.Error(codes.InvalidArgument, "instance not found")
+		}
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	return &runtimev1.DeleteInstanceResponse{}, nil
+	return &runtimev1.DeleteInstanceResponse{
+		ConnectionsEvicted:      int32(res.ConnectionsEvicted),
+		QueryCacheEntriesPurged: int32(res.QueryCacheEntriesPurged),
+		CatalogEntriesDeleted:   res.CatalogEntriesDeleted,
+		DroppedObjects:          res.DroppedObjects,
+		OlapDataDropped:         res.OLAPDataDropped,
+	}, nil
 }
 
 func instanceToPB(inst *drivers.Instance) *runtimev1.Instance {
 	return &runtimev1.Instance{
-		InstanceId:   inst.ID,
-		OlapDriver:   inst.OLAPDriver,
-		OlapDsn:      inst.OLAPDSN,
-		RepoDriver:   inst.RepoDriver,
-		RepoDsn:      inst.RepoDSN,
-		EmbedCatalog: inst.EmbedCatalog,
+		InstanceId:          inst.ID,
+		OlapDriver:          inst.OLAPDriver,
+		OlapDsn:             inst.OLAPDSN,
+		RepoDriver:          inst.RepoDriver,
+		RepoDsn:             inst.RepoDSN,
+		EmbedCatalog:        inst.EmbedCatalog,
+		QueryTimeoutMs:      inst.QueryTimeout.Milliseconds(),
+		QueryMaxRows:        inst.QueryMaxRows,
+		QueryMaxMemoryBytes: inst.QueryMaxMemory,
+		Variables:           inst.Variables,
 	}
 }
@@ -0,0 +1,28 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_EditInstanceVariables(t *testing.T) {
+	server, instanceID := getTestServer(t)
+
+	res, err := server.EditInstance(context.Background(), &runtimev1.EditInstanceRequest{
+		InstanceId: instanceID,
+		Variables:  map[string]string{"bucket": "prod", "prefix": ""},
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"bucket": "prod", "prefix": ""}, res.Instance.Variables)
+
+	res, err = server.EditInstance(context.Background(), &runtimev1.EditInstanceRequest{
+		InstanceId:     instanceID,
+		Variables:      map[string]string{"year": "2022"},
+		UnsetVariables: []string{"bucket"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"prefix": "", "year": "2022"}, res.Instance.Variables)
+}
@@ -1,13 +1,20 @@
 package server
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"math/big"
+	"strconv"
 	"time"
 	"unicode/utf8"
 
+	"github.com/apache/arrow/go/v9/arrow"
+	"github.com/apache/arrow/go/v9/arrow/array"
+	"github.com/apache/arrow/go/v9/arrow/ipc"
 	"github.com/marcboeker/go-duckdb"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
@@ -23,28 +30,36 @@ func (s *Server) Query(ctx context.Context, req *runtimev1.QueryRequest) (*runti
 		args[i] = arg.AsInterface()
 	}
 
-	res, err := s.query(ctx, req.InstanceId, &drivers.Statement{
+	stmt := &drivers.Statement{
 		Query:    req.Sql,
 		Args:     args,
 		DryRun:   req.DryRun,
 		Priority: int(req.Priority),
-	})
+		Timeout:  time.Duration(req.TimeoutMs) * time.Millisecond,
+	}
+	err := s.runtime.ApplyQueryLimits(ctx, req.InstanceId, stmt)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res, err := s.query(ctx, req.InstanceId, stmt)
 	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return nil, status.Error(codes.DeadlineExceeded, err.Error())
+		}
 		// TODO: Parse error to determine error code
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	defer res.Close()
 
-	// NOTE: Currently, query returns nil res for successful dry-run queries
+	// Dry-run queries only return the schema of the result
 	if req.DryRun {
-		// TODO: Return a meta object for dry-run queries
-		return &runtimev1.QueryResponse{}, nil
+		return &runtimev1.QueryResponse{Meta: res.Schema}, nil
 	}
 
-	defer res.Close()
-
 	data, err := rowsToData(res)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, queryResultError(err)
 	}
 
 	resp := &runtimev1.QueryResponse{
@@ -55,13 +70,105 @@ func (s *Server) Query(ctx context.Context, req *runtimev1.QueryRequest) (*runti
 	return resp, nil
 }
 
+// QueryArrow implements RuntimeService.
+func (s *Server) QueryArrow(req *runtimev1.QueryArrowRequest, srv runtimev1.RuntimeService_QueryArrowServer) error {
+	ctx := srv.Context()
+
+	args := make([]any, len(req.Args))
+	for i, arg := range req.Args {
+		args[i] = arg.AsInterface()
+	}
+
+	stmt := &drivers.Statement{
+		Query:    req.Sql,
+		Args:     args,
+		Priority: int(req.Priority),
+		Timeout:  time.Duration(req.TimeoutMs) * time.Millisecond,
+	}
+	err := s.runtime.ApplyQueryLimits(ctx, req.InstanceId, stmt)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	olap, release, err := s.runtime.OLAP(ctx, req.InstanceId)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	defer release()
+
+	rdr, err := olap.ExecuteArrow(ctx, stmt)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return status.Error(codes.DeadlineExceeded, err.Error())
+		}
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	defer rdr.Release()
+
+	// The IPC writer writes whole messages to buf, which we send after every record
+	buf := &bytes.Buffer{}
+	w := ipc.NewWriter(buf, ipc.WithSchema(rdr.Schema()))
+	flush := func() error {
+		if buf.Len() == 0 {
+			return nil
+		}
+		err := srv.Send(&runtimev1.QueryArrowResponse{ArrowIpc: append([]byte(nil), buf.Bytes()...)})
+		buf.Reset()
+		return err
+	}
+
+	for rdr.Next() {
+		err := w.Write(rdr.Record())
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		err = flush()
+		if err != nil {
+			return err
+		}
+	}
+	if err := rdr.Err(); err != nil {
+		return queryResultError(err)
+	}
+
+	// Close writes the schema (if no records were written) and the end-of-stream marker
+	err = w.Close()
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return flush()
+}
+
 func (s *Server) query(ctx context.Context, instanceID string, stmt *drivers.Statement) (*drivers.Result, error) {
-	olap, err := s.runtime.OLAP(ctx, instanceID)
+	olap, release, err := s.runtime.OLAP(ctx, instanceID)
 	if err != nil {
 		return nil, err
 	}
 
-	return olap.Execute(ctx, stmt)
+	res, err := olap.Execute(ctx, stmt)
+	if err != nil {
+		release()
+		return nil, err
+	}
+
+	// Hold the connection until the result is closed
+	res.SetCleanupFunc(func() error {
+		release()
+		return nil
+	})
+	return res, nil
+}
+
+// queryResultError maps an error from scanning a query result to a gRPC status.
+func queryResultError(err error) error {
+	switch {
+	case errors.Is(err, drivers.ErrMaxRowsExceeded):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
 }
 
 func rowsToData(rows *drivers.Result) ([]*structpb.Struct, error) {
@@ -89,6 +196,92 @@ func rowsToData(rows *drivers.Result) ([]*structpb.Struct, error) {
 	return data, nil
 }
 
+// arrowValue returns the value at index i of arr (created by drivers.NewRecordReader for a column of type t)
+// as the Go value that the SQL drivers scan for t. JSON-encoded maps and intervals are decoded.
+// Decimals are exact strings, unless decimalsAsFloats is true.
+func arrowValue(arr arrow.Array, i int, t *runtimev1.Type, decimalsAsFloats bool) (any, error) {
+	if arr.IsNull(i) {
+		return nil, nil
+	}
+
+	switch a := arr.(type) {
+	case *array.Boolean:
+		return a.Value(i), nil
+	case *array.Int8:
+		return a.Value(i), nil
+	case *array.Int16:
+		return a.Value(i), nil
+	case *array.Int32:
+		return a.Value(i), nil
+	case *array.Int64:
+		return a.Value(i), nil
+	case *array.Uint8:
+		return a.Value(i), nil
+	case *array.Uint16:
+		return a.Value(i), nil
+	case *array.Uint32:
+		return a.Value(i), nil
+	case *array.Uint64:
+		return a.Value(i), nil
+	case *array.Float32:
+		return a.Value(i), nil
+	case *array.Float64:
+		return a.Value(i), nil
+	case *array.Decimal128:
+		return a.Value(i).BigInt(), nil
+	case *array.Timestamp:
+		return a.Value(i).ToTime(arrow.Microsecond), nil
+	case *array.Date32:
+		return a.Value(i).ToTime(), nil
+	case *array.Time64:
+		return a.Value(i).ToTime(arrow.Microsecond), nil
+	case *array.Binary:
+		return a.Value(i), nil
+	case *array.String:
+		s := a.Value(i)
+		switch t.Code {
+		case runtimev1.Type_CODE_DECIMAL:
+			if decimalsAsFloats {
+				return strconv.ParseFloat(s, 64)
+			}
+			return s, nil
+		case runtimev1.Type_CODE_MAP, runtimev1.Type_CODE_UNSPECIFIED:
+			var v any
+			if err := json.Unmarshal([]byte(s), &v); err != nil {
+				// Not all unspecified types are JSON-encoded (see drivers.typeToArrow)
+				return s, nil
+			}
+			return v, nil
+		default:
+			return s, nil
+		}
+	case *array.List:
+		offsets := a.Offsets()[a.Data().Offset():]
+		vals := a.ListValues()
+		xs := make([]any, 0, offsets[i+1]-offsets[i])
+		for j := int(offsets[i]); j < int(offsets[i+1]); j++ {
+			x, err := arrowValue(vals, j, t.ArrayElementType, decimalsAsFloats)
+			if err != nil {
+				return nil, err
+			}
+			xs = append(xs, x)
+		}
+		return xs, nil
+	case *array.Struct:
+		m := make(map[string]any, a.NumField())
+		for j, f := range t.StructType.Fields {
+			x, err := arrowValue(a.Field(j), i, f.Type, decimalsAsFloats)
+			if err != nil {
+				return nil, err
+			}
+			m[f.Name] = x
+		}
+		return m, nil
+	default:
+		return nil, fmt.Errorf("unsupported arrow type %q", arr.DataType().Name())
+	}
+}
+
 // valToPB converts any value to a google.protobuf.Value. It's similar to
 // structpb.NewValue, but adds support for a few extra primitive types.
 func valToPB(v any) (*structpb.Value, error) {
@@ -438,8 +438,9 @@ func getColumnTestServerWithModel(t *testing.T, sql string, expectation int) (*S
 	server, err := NewServer(&Options{}, rt, nil)
 	require.NoError(t, err)
 
-	olap, err := rt.OLAP(context.Background(), instanceID)
+	olap, release, err := rt.OLAP(context.Background(), instanceID)
 	require.NoError(t, err)
+	defer release()
 
 	res, err := olap.Execute(context.Background(), &drivers.Statement{Query: "SELECT count(*) FROM test"})
 	require.NoError(t, err)
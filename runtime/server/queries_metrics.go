@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/queries"
@@ -22,6 +23,7 @@ func (s *Server) MetricsViewToplist(ctx context.Context, req *runtimev1.MetricsV
 		Offset:          req.Offset,
 		Sort:            req.Sort,
 		Filter:          req.Filter,
+		Timeout:         time.Duration(req.TimeoutMs) * time.Millisecond,
 	}
 	err := s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
 	if err != nil {
@@ -40,6 +42,7 @@ func (s *Server) MetricsViewTimeSeries(ctx context.Context, req *runtimev1.Metri
 		TimeEnd:         req.TimeEnd,
 		TimeGranularity: req.TimeGranularity,
 		Filter:          req.Filter,
+		Timeout:         time.Duration(req.TimeoutMs) * time.Millisecond,
 	}
 
 	err := s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
@@ -57,6 +60,7 @@ func (s *Server) MetricsViewTotals(ctx context.Context, req *runtimev1.MetricsVi
 		TimeStart:       req.TimeStart,
 		TimeEnd:         req.TimeEnd,
 		Filter:          req.Filter,
+		Timeout:         time.Duration(req.TimeoutMs) * time.Millisecond,
 	}
 	err := s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
 	if err != nil {
@@ -0,0 +1,40 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_Query(t *testing.T) {
+	server, instanceId := getTableTestServer(t)
+	res, err := server.Query(context.Background(), &runtimev1.QueryRequest{
+		InstanceId: instanceId,
+		Sql:        `SELECT a, "b""b", 1.25::DECIMAL(4,2) AS d, DATE '2022-01-02' AS dt, INTERVAL 1 DAY AS iv, [1, 2] AS l, {'x': 1} AS s FROM test`,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Meta.Fields, 7)
+	require.Equal(t, runtimev1.Type_CODE_DECIMAL, res.Meta.Fields[2].Type.Code)
+	require.Len(t, res.Data, 1)
+
+	row := res.Data[0].AsMap()
+	require.Equal(t, 1.0, row["a"])
+	require.Equal(t, 10.0, row[`b"b`])
+	require.Equal(t, 1.25, row["d"])
+	require.Equal(t, "2022-01-02T00:00:00Z", row["dt"])
+	require.Equal(t, map[string]any{"months": 0.0, "days": 1.0, "micros": 0.0}, row["iv"])
+	require.Equal(t, []any{1.0, 2.0}, row["l"])
+	require.Equal(t, map[string]any{"x": 1.0}, row["s"])
+
+	// dry runs only return the schema
+	res, err = server.Query(context.Background(), &runtimev1.QueryRequest{
+		InstanceId: instanceId,
+		Sql:        "SELECT a FROM test",
+		DryRun:     true,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Meta.Fields, 1)
+	require.Nil(t, res.Data)
+}
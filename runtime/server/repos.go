@@ -2,11 +2,14 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -20,29 +23,46 @@ func (s *Server) ListFiles(ctx context.Context, req *runtimev1.ListFilesRequest)
 		glob = "**"
 	}
 
-	paths, err := s.runtime.ListFiles(ctx, req.InstanceId, glob)
+	if req.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+
+	paths, next, err := s.runtime.ListFiles(ctx, req.InstanceId, glob, int(req.PageSize), req.PageToken)
 	if err != nil {
 		return nil, status.Error(codes.FailedPrecondition, err.Error())
 	}
 
-	return &runtimev1.ListFilesResponse{Paths: paths}, nil
+	return &runtimev1.ListFilesResponse{Paths: paths, NextPageToken: next}, nil
 }
 
 // GetFile implements RuntimeService.
 func (s *Server) GetFile(ctx context.Context, req *runtimev1.GetFileRequest) (*runtimev1.GetFileResponse, error) {
-	blob, lastUpdated, err := s.runtime.GetFile(ctx, req.InstanceId, req.Path)
+	if req.Revision != "" {
+		blob, createdOn, err := s.runtime.GetFileRevision(ctx, req.InstanceId, req.Path, req.Revision)
+		if err != nil {
+			return nil, fileRevisionsError(err)
+		}
+
+		return &runtimev1.GetFileResponse{Blob: blob, UpdatedOn: timestamppb.New(createdOn)}, nil
+	}
+
+	blob, stat, err := s.runtime.GetFile(ctx, req.InstanceId, req.Path)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	return &runtimev1.GetFileResponse{Blob: blob, UpdatedOn: timestamppb.New(lastUpdated)}, nil
+	return &runtimev1.GetFileResponse{Blob: blob, UpdatedOn: timestamppb.New(stat.LastUpdated), Version: stat.Version}, nil
 }
 
 // PutFile implements RuntimeService.
 func (s *Server) PutFile(ctx context.Context, req *runtimev1.PutFileRequest) (*runtimev1.PutFileResponse, error) {
-	err := s.runtime.PutFile(ctx, req.InstanceId, req.Path, strings.NewReader(req.Blob), req.Create, req.CreateOnly)
+	err := s.runtime.PutFile(ctx, req.InstanceId, req.Path, strings.NewReader(req.Blob), drivers.PutOptions{
+		Create:          req.Create,
+		CreateOnly:      req.CreateOnly,
+		ExpectedVersion: req.ExpectedVersion,
+	})
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, putFileError(err)
 	}
 
 	return &runtimev1.PutFileResponse{}, nil
@@ -68,6 +88,41 @@ func (s *Server) RenameFile(ctx context.Context, req *runtimev1.RenameFileReques
 	return &runtimev1.RenameFileResponse{}, nil
 }
 
+// ListFileRevisions implements RuntimeService.
+func (s *Server) ListFileRevisions(ctx context.Context, req *runtimev1.ListFileRevisionsRequest) (*runtimev1.ListFileRevisionsResponse, error) {
+	revs, err := s.runtime.ListFileRevisions(ctx, req.InstanceId, req.Path)
+	if err != nil {
+		return nil, fileRevisionsError(err)
+	}
+
+	pbs := make([]*runtimev1.FileRevision, len(revs))
+	for i, rev := range revs {
+		pbs[i] = &runtimev1.FileRevision{
+			Revision:    rev.ID,
+			AuthorName:  rev.AuthorName,
+			AuthorEmail: rev.AuthorEmail,
+			Message:     rev.Message,
+			CreatedOn:   timestamppb.New(rev.CreatedOn),
+		}
+	}
+
+	return &runtimev1.ListFileRevisionsResponse{Revisions: pbs}, nil
+}
+
+// RevertFile implements RuntimeService.
+func (s *Server) RevertFile(ctx context.Context, req *runtimev1.RevertFileRequest) (*runtimev1.RevertFileResponse, error) {
+	if req.Revision == "" {
+		return nil, status.Error(codes.InvalidArgument, "revision must be set")
+	}
+
+	err := s.runtime.RevertFile(ctx, req.InstanceId, req.Path, req.Revision)
+	if err != nil {
+		return nil, fileRevisionsError(err)
+	}
+
+	return &runtimev1.RevertFileResponse{}, nil
+}
+
 // UploadMultipartFile implements the same functionality as PutFile, but for multipart HTTP upload.
 // It's mounted only on as a REST API and enables upload of large files (such as data files).
 func (s *Server) UploadMultipartFile(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
@@ -88,7 +143,7 @@ func (s *Server) UploadMultipartFile(w http.ResponseWriter, req *http.Request, p
 		return
 	}
 
-	err = s.runtime.PutFile(ctx, pathParams["instance_id"], pathParams["path"], f, true, false)
+	err = s.runtime.PutFile(ctx, pathParams["instance_id"], pathParams["path"], f, drivers.PutOptions{Create: true})
 	if err != nil {
 		http.Error(w, fmt.Sprintf("failed to write file: %s", err), http.StatusBadRequest)
 		return
@@ -107,3 +162,29 @@ func (s *Server) UploadMultipartFile(w http.ResponseWriter, req *http.Request, p
 		return
 	}
 }
+
+// putFileError maps the precondition errors of a file write to gRPC status codes
+func putFileError(err error) error {
+	switch {
+	case errors.Is(err, drivers.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, drivers.ErrFileAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, drivers.ErrVersionConflict):
+		return status.Error(codes.Aborted, err.Error())
+	default:
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+}
+
+// fileRevisionsError maps the errors of the file history APIs to gRPC status codes
+func fileRevisionsError(err error) error {
+	switch {
+	case errors.Is(err, drivers.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case runtime.IsRevisionsNotTracked(err):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -2,6 +2,8 @@ package artifacts
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"regexp"
@@ -31,7 +33,8 @@ type Artifact interface {
 	Serialise(ctx context.Context, catalogObject *drivers.CatalogEntry) (string, error)
 }
 
-func Read(ctx context.Context, repoStore drivers.RepoStore, instID, filePath string) (*drivers.CatalogEntry, error) {
+// Read reads and parses the artifact at filePath. The variables in vars are interpolated into it (see ResolveVariables).
+func Read(ctx context.Context, repoStore drivers.RepoStore, instID, filePath string, vars map[string]string) (*drivers.CatalogEntry, error) {
 	extension := fileutil.FullExt(filePath)
 	artifact, ok := Artifacts[extension]
 	if !ok {
@@ -52,6 +55,11 @@ func Read(ctx context.Context, repoStore drivers.RepoStore, instID, filePath str
 		return nil, ErrInvalidFileName
 	}
 
+	err = ResolveVariables(catalog, vars)
+	if err != nil {
+		return nil, err
+	}
+
 	catalog.Path = filePath
 	return catalog, nil
 }
@@ -68,7 +76,13 @@ func Write(ctx context.Context, repoStore drivers.RepoStore, instID string, cata
 		return err
 	}
 
-	return repoStore.Put(ctx, instID, catalog.Path, strings.NewReader(blob))
+	return repoStore.Put(ctx, instID, catalog.Path, strings.NewReader(blob), drivers.PutOptions{Create: true})
+}
+
+// ContentHash returns a hash of the contents of an artifact file.
+func ContentHash(blob string) string {
+	sum := sha256.Sum256([]byte(blob))
+	return hex.EncodeToString(sum[:])
 }
 
 var regex = regexp.MustCompile("^[a-zA-Z_][a-zA-Z0-9_]*$")
@@ -10,6 +10,7 @@ import (
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/services/catalog/artifacts"
+	"github.com/rilldata/rill/runtime/services/catalog/migrator/models"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -81,6 +82,53 @@ region: us-east-2
 			},
 			"select * from A",
 		},
+		{
+			"MaterializedModel",
+			&drivers.CatalogEntry{
+				Name: "MaterializedModel",
+				Path: "models/MaterializedModel.sql",
+				Type: drivers.ObjectTypeModel,
+				Object: &runtimev1.Model{
+					Name:        "MaterializedModel",
+					Sql:         "-- a comment that isn't an option\nselect * from A",
+					Dialect:     runtimev1.Model_DIALECT_DUCKDB,
+					Materialize: true,
+				},
+			},
+			"-- @materialize: true\n-- a comment that isn't an option\nselect * from A",
+		},
+		{
+			"RillModel",
+			&drivers.CatalogEntry{
+				Name: "RillModel",
+				Path: "models/RillModel.sql",
+				Type: drivers.ObjectTypeModel,
+				Object: &runtimev1.Model{
+					Name:    "RillModel",
+					Sql:     "select * from A",
+					Dialect: runtimev1.Model_DIALECT_RILL,
+				},
+			},
+			"-- @dialect: rill\nselect * from A",
+		},
+		{
+			"IncrementalModel",
+			&drivers.CatalogEntry{
+				Name: "IncrementalModel",
+				Path: "models/IncrementalModel.sql",
+				Type: drivers.ObjectTypeModel,
+				Object: &runtimev1.Model{
+					Name:                 "IncrementalModel",
+					Sql:                  "select * from A",
+					Dialect:              runtimev1.Model_DIALECT_DUCKDB,
+					Materialize:          true,
+					Incremental:          true,
+					UniqueKey:            []string{"id", "ts"},
+					IncrementalPredicate: "ts > (select max(ts) from {{ this }})",
+				},
+			},
+			"-- @incremental: true\n-- @unique_key: id, ts\n-- @incremental_predicate: ts > (select max(ts) from {{ this }})\nselect * from A",
+		},
 		{
 			"MetricsView",
 			&drivers.CatalogEntry{
@@ -160,10 +208,13 @@ measures:
 
 	for _, tt := range catalogs {
 		t.Run(fmt.Sprintf("%s", tt.Name), func(t *testing.T) {
+			if tt.Catalog.Type == drivers.ObjectTypeModel && tt.Catalog.GetModel().Dialect == runtimev1.Model_DIALECT_RILL && !models.SupportsRillDialect() {
+				t.Skip("the Rill dialect is not supported by this build")
+			}
 			err := artifacts.Write(ctx, repoStore, "test", tt.Catalog)
 			require.NoError(t, err)
 
-			readCatalog, err := artifacts.Read(ctx, repoStore, "test", tt.Catalog.Path)
+			readCatalog, err := artifacts.Read(ctx, repoStore, "test", tt.Catalog.Path, nil)
 			require.NoError(t, err)
 			require.Equal(t, readCatalog, tt.Catalog)
 
@@ -187,6 +238,31 @@ func TestReadFailure(t *testing.T) {
   uri: data/source.csv
 `,
 		},
+		{
+			"InvalidModelOption",
+			"models/InvalidModelOption.sql",
+			"-- @materialize: maybe\nselect * from A",
+		},
+		{
+			"UnknownModelOption",
+			"models/UnknownModelOption.sql",
+			"-- @unknown: true\nselect * from A",
+		},
+		{
+			"UnknownDialect",
+			"models/UnknownDialect.sql",
+			"-- @dialect: postgres\nselect * from A",
+		},
+		{
+			"NonMaterializedIncrementalModel",
+			"models/NonMaterializedIncrementalModel.sql",
+			"-- @incremental: true\n-- @materialize: false\nselect * from A",
+		},
+		{
+			"UniqueKeyWithoutIncremental",
+			"models/UniqueKeyWithoutIncremental.sql",
+			"-- @unique_key: id\nselect * from A",
+		},
 	}
 
 	dir := t.TempDir()
@@ -207,12 +283,80 @@ func TestReadFailure(t *testing.T) {
 			err := os.WriteFile(path.Join(dir, tt.Path), []byte(tt.Raw), os.ModePerm)
 			require.NoError(t, err)
 
-			_, err = artifacts.Read(ctx, repoStore, "test", tt.Path)
+			_, err = artifacts.Read(ctx, repoStore, "test", tt.Path, nil)
 			require.Error(t, err)
 		})
 	}
 }
 
+func TestReadUnsupportedDialect(t *testing.T) {
+	if models.SupportsRillDialect() {
+		t.Skip("the Rill dialect is supported by this build")
+	}
+
+	dir := t.TempDir()
+	fileStore, err := drivers.Open("file", dir, zap.NewNop())
+	require.NoError(t, err)
+	repoStore, _ := fileStore.RepoStore()
+
+	err = os.MkdirAll(path.Join(dir, "models"), os.ModePerm)
+	require.NoError(t, err)
+	err = os.WriteFile(path.Join(dir, "models/RillModel.sql"), []byte("-- @dialect: rill\nselect * from A"), os.ModePerm)
+	require.NoError(t, err)
+
+	_, err = artifacts.Read(context.Background(), repoStore, "test", "models/RillModel.sql", nil)
+	require.ErrorContains(t, err, "not supported by this build")
+}
+
+func TestReadVariables(t *testing.T) {
+	dir := t.TempDir()
+	fileStore, err := drivers.Open("file", dir, zap.NewNop())
+	require.NoError(t, err)
+	repoStore, _ := fileStore.RepoStore()
+	ctx := context.Background()
+
+	err = os.MkdirAll(path.Join(dir, "sources"), os.ModePerm)
+	require.NoError(t, err)
+	err = os.MkdirAll(path.Join(dir, "models"), os.ModePerm)
+	require.NoError(t, err)
+
+	err = os.WriteFile(path.Join(dir, "sources/events.yaml"), []byte("type: s3\nuri: s3://{{ .vars.bucket }}/events.csv\nregion: us-east-1\n"), os.ModePerm)
+	require.NoError(t, err)
+	err = os.WriteFile(path.Join(dir, "models/recent.sql"), []byte("SELECT * FROM events WHERE year >= {{ .vars.year }}"), os.ModePerm)
+	require.NoError(t, err)
+
+	vars := map[string]string{"bucket": "prod-bucket", "year": "2022"}
+
+	source, err := artifacts.Read(ctx, repoStore, "test", "sources/events.yaml", vars)
+	require.NoError(t, err)
+	props := source.GetSource().Properties.AsMap()
+	require.Equal(t, "s3://prod-bucket/events.csv", props["path"])
+	require.Equal(t, "us-east-1", props["aws.region"])
+
+	model, err := artifacts.Read(ctx, repoStore, "test", "models/recent.sql", vars)
+	require.NoError(t, err)
+	require.Equal(t, "SELECT * FROM events WHERE year >= 2022", model.GetModel().Sql)
+
+	// Unknown variables are an error
+	_, err = artifacts.Read(ctx, repoStore, "test", "models/recent.sql", map[string]string{"bucket": "prod-bucket"})
+	require.ErrorContains(t, err, "year")
+	_, err = artifacts.Read(ctx, repoStore, "test", "sources/events.yaml", nil)
+	require.ErrorContains(t, err, "bucket")
+
+	// Other uses of braces are left as is
+	sql := "SELECT '{{ not a var }}' AS a, '{{ .vars.year' AS b -- {{ end }}\nFROM events WHERE year = {{.vars.year}}"
+	err = os.WriteFile(path.Join(dir, "models/braces.sql"), []byte(sql), os.ModePerm)
+	require.NoError(t, err)
+	model, err = artifacts.Read(ctx, repoStore, "test", "models/braces.sql", vars)
+	require.NoError(t, err)
+	require.Equal(t, "SELECT '{{ not a var }}' AS a, '{{ .vars.year' AS b -- {{ end }}\nFROM events WHERE year = 2022", model.GetModel().Sql)
+
+	// Variables can be set to an empty string
+	model, err = artifacts.Read(ctx, repoStore, "test", "models/recent.sql", map[string]string{"year": ""})
+	require.NoError(t, err)
+	require.Equal(t, "SELECT * FROM events WHERE year >= ", model.GetModel().Sql)
+}
+
 func TestSanitizedName(t *testing.T) {
 	variations := []struct {
 		fileName     string
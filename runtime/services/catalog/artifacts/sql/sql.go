@@ -3,36 +3,63 @@ package sql
 import (
 	"context"
 	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/fileutil"
 	"github.com/rilldata/rill/runtime/services/catalog/artifacts"
+	"github.com/rilldata/rill/runtime/services/catalog/migrator/models"
 )
 
 /**
  * this package contains code to map an sql file to a catalog object
+ *
+ * model options are set in a header of comments at the top of the file, like:
+ *   -- @materialize: true
+ *
+ * incremental models are materialized and only insert the rows matching their predicate on refresh:
+ *   -- @incremental: true
+ *   -- @unique_key: id
+ *   -- @incremental_predicate: event_time > (SELECT max(event_time) FROM {{ this }})
+ *
+ * models written in Rill SQL are transpiled to the dialect of the instance's OLAP
+ * (only in builds with the SQL native library, see models.SupportsRillDialect):
+ *   -- @dialect: rill
  */
 
 type artifact struct{}
 
 var ErrNotSupported = errors.New("only model supported for sql")
 
+// optionRegex matches a model option in the header of a sql file
+var optionRegex = regexp.MustCompile(`^--\s*@([a-zA-Z_]+)\s*:\s*(.*?)\s*$`)
+
 func init() {
 	artifacts.Register(".sql", &artifact{})
 }
 
 func (r *artifact) DeSerialise(ctx context.Context, filePath, blob string) (*drivers.CatalogEntry, error) {
 	name := fileutil.Stem(filePath)
+	model := &runtimev1.Model{
+		Name:    name,
+		Dialect: runtimev1.Model_DIALECT_DUCKDB,
+	}
+
+	sql, err := parseOptions(blob, model)
+	if err != nil {
+		return nil, err
+	}
+	model.Sql = sql
+
 	return &drivers.CatalogEntry{
-		Type: drivers.ObjectTypeModel,
-		Object: &runtimev1.Model{
-			Name:    name,
-			Sql:     blob,
-			Dialect: runtimev1.Model_DIALECT_DUCKDB,
-		},
-		Name: name,
-		Path: filePath,
+		Type:   drivers.ObjectTypeModel,
+		Object: model,
+		Name:   name,
+		Path:   filePath,
 	}, nil
 }
 
@@ -40,5 +67,97 @@ func (r *artifact) Serialise(ctx context.Context, catalogObject *drivers.Catalog
 	if catalogObject.Type != drivers.ObjectTypeModel {
 		return "", ErrNotSupported
 	}
-	return catalogObject.GetModel().Sql, nil
+	model := catalogObject.GetModel()
+
+	var header strings.Builder
+	if model.Dialect == runtimev1.Model_DIALECT_RILL {
+		header.WriteString("-- @dialect: rill\n")
+	}
+	if model.Incremental {
+		header.WriteString("-- @incremental: true\n")
+		if len(model.UniqueKey) > 0 {
+			header.WriteString("-- @unique_key: " + strings.Join(model.UniqueKey, ", ") + "\n")
+		}
+		if model.IncrementalPredicate != "" {
+			header.WriteString("-- @incremental_predicate: " + model.IncrementalPredicate + "\n")
+		}
+	} else if model.Materialize {
+		header.WriteString("-- @materialize: true\n")
+	}
+	return header.String() + model.Sql, nil
+}
+
+// parseOptions sets the options in the header of blob on model and returns the rest of blob.
+func parseOptions(blob string, model *runtimev1.Model) (string, error) {
+	rest := blob
+	materialize := ""
+	for rest != "" {
+		line := rest
+		next := ""
+		if i := strings.IndexByte(rest, '\n'); i >= 0 {
+			line = rest[:i]
+			next = rest[i+1:]
+		}
+
+		match := optionRegex.FindStringSubmatch(strings.TrimSpace(line))
+		if match == nil {
+			break
+		}
+
+		key, value := strings.ToLower(match[1]), match[2]
+		switch key {
+		case "materialize":
+			b, err := strconv.ParseBool(value)
+			if err != nil {
+				return "", fmt.Errorf("invalid value %q for model option %q", value, key)
+			}
+			model.Materialize = b
+			materialize = value
+		case "dialect":
+			switch strings.ToLower(value) {
+			case "duckdb":
+				model.Dialect = runtimev1.Model_DIALECT_DUCKDB
+			case "rill":
+				// the option is only accepted by builds that can transpile it
+				if !models.SupportsRillDialect() {
+					return "", fmt.Errorf("model option %q: the Rill dialect is not supported by this build", key)
+				}
+				model.Dialect = runtimev1.Model_DIALECT_RILL
+			default:
+				return "", fmt.Errorf("invalid value %q for model option %q", value, key)
+			}
+		case "incremental":
+			b, err := strconv.ParseBool(value)
+			if err != nil {
+				return "", fmt.Errorf("invalid value %q for model option %q", value, key)
+			}
+			model.Incremental = b
+		case "unique_key":
+			model.UniqueKey = nil
+			for _, col := range strings.Split(value, ",") {
+				col = strings.TrimSpace(col)
+				if col == "" {
+					return "", fmt.Errorf("invalid value %q for model option %q", value, key)
+				}
+				model.UniqueKey = append(model.UniqueKey, col)
+			}
+		case "incremental_predicate":
+			model.IncrementalPredicate = value
+		default:
+			return "", fmt.Errorf("unknown model option %q", key)
+		}
+
+		rest = next
+	}
+
+	if model.Incremental {
+		// incremental models insert into a table
+		if materialize != "" && !model.Materialize {
+			return "", fmt.Errorf("invalid value %q for model option %q: incremental models are materialized", materialize, "materialize")
+		}
+		model.Materialize = true
+	} else if len(model.UniqueKey) > 0 || model.IncrementalPredicate != "" {
+		return "", errors.New("model options \"unique_key\" and \"incremental_predicate\" require \"incremental: true\"")
+	}
+	return rest, nil
 }
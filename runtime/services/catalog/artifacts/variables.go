@@ -0,0 +1,93 @@
+package artifacts
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// ResolveVariables interpolates variables into the properties of a source or the SQL of a model.
+// Variables are referenced as {{ .vars.bucket }}; any other text is left unchanged. Referencing an unknown variable is an error.
+func ResolveVariables(entry *drivers.CatalogEntry, vars map[string]string) error {
+	switch entry.Type {
+	case drivers.ObjectTypeSource:
+		src := entry.GetSource()
+		if src.Properties == nil {
+			return nil
+		}
+		for _, val := range src.Properties.Fields {
+			err := resolveValue(val, vars)
+			if err != nil {
+				return err
+			}
+		}
+	case drivers.ObjectTypeModel:
+		model := entry.GetModel()
+		sql, err := resolveTemplate(model.Sql, vars)
+		if err != nil {
+			return err
+		}
+		model.Sql = sql
+	}
+	return nil
+}
+
+// resolveValue resolves templates in the string values of a protobuf value (in place).
+func resolveValue(val *structpb.Value, vars map[string]string) error {
+	switch v := val.Kind.(type) {
+	case *structpb.Value_StringValue:
+		s, err := resolveTemplate(v.StringValue, vars)
+		if err != nil {
+			return err
+		}
+		v.StringValue = s
+	case *structpb.Value_ListValue:
+		for _, item := range v.ListValue.Values {
+			err := resolveValue(item, vars)
+			if err != nil {
+				return err
+			}
+		}
+	case *structpb.Value_StructValue:
+		for _, item := range v.StructValue.Fields {
+			err := resolveValue(item, vars)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// varRegexp matches a variable reference like {{ .vars.bucket }}.
+var varRegexp = regexp.MustCompile(`{{\s*\.vars\.([A-Za-z_][A-Za-z0-9_]*)\s*}}`)
+
+// resolveTemplate replaces the variable references in text with their values.
+// Other text is left as is, so SQL that contains "{{" in literals or comments isn't affected.
+func resolveTemplate(text string, vars map[string]string) (string, error) {
+	// Skip text that doesn't contain variable references
+	if !strings.Contains(text, "{{") {
+		return text, nil
+	}
+
+	var err error
+	res := varRegexp.ReplaceAllStringFunc(text, func(ref string) string {
+		name := varRegexp.FindStringSubmatch(ref)[1]
+		val, ok := vars[name]
+		if !ok {
+			if err == nil {
+				err = fmt.Errorf("failed to resolve variables: variable %q is not set", name)
+			}
+			return ref
+		}
+		return val
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return res, nil
+}
@@ -2,6 +2,9 @@ package catalog
 
 import (
 	"context"
+	"errors"
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/rilldata/rill/runtime/drivers"
@@ -9,13 +12,20 @@ import (
 	"go.uber.org/zap"
 )
 
+// DefaultConcurrency is the default for Service.Concurrency
+const DefaultConcurrency = 4
+
 type Service struct {
 	Catalog drivers.CatalogStore
 	Repo    drivers.RepoStore
 	Olap    drivers.OLAPStore
 	InstID  string
+	// Variables are the instance's variables. They override the defaults in the project's rill.yaml.
+	Variables map[string]string
+	// Concurrency is the maximum number of independent migration items that Reconcile runs in parallel
+	Concurrency int
 
-	// temporary information. should this be persisted into olap?
+	// The state below is persisted in the catalog store after every reconcile (see loadState and saveState).
 	// LastMigration stores the last time migrate was run. Used to filter out repos that didnt change since this time
 	LastMigration time.Time
 	dag           *dag.DAG
@@ -25,7 +35,16 @@ type Service struct {
 	// used to get last logged name when parsing fails
 	PathToName map[string]string
 
-	logger *zap.Logger
+	// vars are the resolved variables used by the current (or last) reconcile
+	vars map[string]string
+	// stateLoaded is true once the state persisted by a previous process has been loaded
+	stateLoaded bool
+
+	// reconcileLock serializes calls to Reconcile, which mutate the state above
+	reconcileLock sync.Mutex
+	// migrationLock serializes access to the state above and the catalog store between parallel migration items
+	migrationLock sync.Mutex
+	logger        *zap.Logger
 }
 
 func NewService(
@@ -33,16 +52,20 @@ func NewService(
 	repo drivers.RepoStore,
 	olap drivers.OLAPStore,
 	instID string,
+	variables map[string]string,
 	logger *zap.Logger,
 ) *Service {
 	if logger == nil {
 		logger = zap.NewNop()
 	}
 	return &Service{
-		Catalog: catalog,
-		Repo:    repo,
-		Olap:    olap,
-		InstID:  instID,
+		Catalog:   catalog,
+		Repo:      repo,
+		Olap:      olap,
+		InstID:    instID,
+		Variables: variables,
+
+		Concurrency: DefaultConcurrency,
 
 		dag:        dag.NewDAG(),
 		NameToPath: make(map[string]string),
@@ -52,6 +75,76 @@ func NewService(
 	}
 }
 
+// FindPath returns the repo path of the artifact with the given name.
+func (s *Service) FindPath(ctx context.Context, name string) (string, bool, error) {
+	s.reconcileLock.Lock()
+	defer s.reconcileLock.Unlock()
+
+	err := s.loadState(ctx)
+	if err != nil {
+		return "", false, err
+	}
+
+	s.migrationLock.Lock()
+	defer s.migrationLock.Unlock()
+	path, ok := s.NameToPath[name]
+	return path, ok, nil
+}
+
+// loadState loads the state persisted by the last reconcile, so that a new process resumes incremental reconciles.
+// It's a no-op after the first call. The caller must hold reconcileLock.
+func (s *Service) loadState(ctx context.Context) error {
+	if s.stateLoaded {
+		return nil
+	}
+
+	state, err := s.Catalog.FindReconcileState(ctx, s.InstID)
+	if err != nil && !errors.Is(err, drivers.ErrNotFound) {
+		return err
+	}
+	if state != nil {
+		s.LastMigration = state.LastMigration
+		s.vars = state.Variables
+		for name, path := range state.NameToPath {
+			s.NameToPath[name] = path
+		}
+		for path, name := range state.PathToName {
+			s.PathToName[path] = name
+		}
+		for name, dependencies := range state.Dependencies {
+			s.dag.Add(name, dependencies)
+		}
+	}
+
+	s.stateLoaded = true
+	return nil
+}
+
+// saveState persists the state of the last reconcile. The caller must hold reconcileLock.
+func (s *Service) saveState(ctx context.Context) error {
+	state := &drivers.ReconcileState{
+		LastMigration: s.LastMigration,
+		Variables:     s.vars,
+		NameToPath:    s.NameToPath,
+		PathToName:    s.PathToName,
+		Dependencies:  make(map[string][]string),
+	}
+	for name, n := range s.dag.NameMap {
+		// nodes that are not present only exist as the dependencies of other nodes
+		if !n.Present {
+			continue
+		}
+		dependencies := make([]string, 0, len(n.Parents))
+		for parent := range n.Parents {
+			dependencies = append(dependencies, parent)
+		}
+		sort.Strings(dependencies)
+		state.Dependencies[name] = dependencies
+	}
+
+	return s.Catalog.SaveReconcileState(ctx, s.InstID, state)
+}
+
 func (s *Service) FindEntries(ctx context.Context, typ drivers.ObjectType) []*drivers.CatalogEntry {
 	return s.Catalog.FindEntries(ctx, s.InstID, typ)
 }
@@ -59,3 +152,7 @@ func (s *Service) FindEntries(ctx context.Context, typ drivers.ObjectType) []*dr
 func (s *Service) FindEntry(ctx context.Context, name string) (*drivers.CatalogEntry, bool) {
 	return s.Catalog.FindEntry(ctx, s.InstID, name)
 }
+
+func (s *Service) FindEntryVersions(ctx context.Context, name string) ([]*drivers.CatalogEntryVersion, error) {
+	return s.Catalog.FindEntryVersions(ctx, s.InstID, name)
+}
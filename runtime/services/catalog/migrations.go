@@ -4,22 +4,26 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/bmatcuk/doublestar/v4"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/compilers/rillv1beta"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/arrayutil"
 	"github.com/rilldata/rill/runtime/pkg/dag"
 	"github.com/rilldata/rill/runtime/pkg/fileutil"
 	"github.com/rilldata/rill/runtime/services/catalog/artifacts"
 	"github.com/rilldata/rill/runtime/services/catalog/migrator"
+	"github.com/rilldata/rill/runtime/services/catalog/migrator/models"
 
 	// Load migrators
 	_ "github.com/rilldata/rill/runtime/services/catalog/artifacts/sql"
 	_ "github.com/rilldata/rill/runtime/services/catalog/artifacts/yaml"
 	_ "github.com/rilldata/rill/runtime/services/catalog/migrator/metricsviews"
-	_ "github.com/rilldata/rill/runtime/services/catalog/migrator/models"
 	_ "github.com/rilldata/rill/runtime/services/catalog/migrator/sources"
 )
 
@@ -34,6 +38,13 @@ type MigrationItem struct {
 	FromPath               string
 	NormalizedDependencies []string
 	Error                  *runtimev1.ReconcileError
+	// FullRefresh is set for items in ForcedPaths. It rebuilds incremental models from scratch.
+	FullRefresh bool
+}
+
+// isRemoved returns true if the item is for a file that doesn't exist anymore.
+func (i *MigrationItem) isRemoved() bool {
+	return i.Type == MigrationDelete && i.CatalogInFile == nil && i.Error == nil
 }
 
 func (i *MigrationItem) renameFrom(from *MigrationItem) {
@@ -55,6 +66,55 @@ type ReconcileConfig struct {
 	Strict       bool
 	ChangedPaths []string
 	ForcedPaths  []string
+	// OnEvent is called with the progress of every migration item, one event at a time.
+	// It's called while reconciling, so it shouldn't block.
+	OnEvent func(ReconcileEvent)
+}
+
+func (c ReconcileConfig) emit(typ ReconcileEventType, item *MigrationItem, d time.Duration, errs []*runtimev1.ReconcileError) {
+	if c.OnEvent == nil {
+		return
+	}
+	c.OnEvent(ReconcileEvent{
+		Type:     typ,
+		Name:     item.Name,
+		Path:     item.Path,
+		Duration: d,
+		Errors:   errs,
+	})
+}
+
+// ReconcileEventType is the type of a ReconcileEvent
+type ReconcileEventType int
+
+const (
+	ReconcileEventQueued ReconcileEventType = iota + 1
+	ReconcileEventStarted
+	ReconcileEventCreated
+	ReconcileEventUpdated
+	ReconcileEventRenamed
+	ReconcileEventDropped
+	ReconcileEventErrored
+)
+
+// migrationEventTypes maps migration types to the event reported when a migration item succeeds
+var migrationEventTypes = map[int]ReconcileEventType{
+	MigrationCreate: ReconcileEventCreated,
+	MigrationRename: ReconcileEventRenamed,
+	MigrationUpdate: ReconcileEventUpdated,
+	MigrationDelete: ReconcileEventDropped,
+}
+
+// ReconcileEvent reports the progress of a migration item during a reconcile.
+// Items without changes only report an event if they fail.
+type ReconcileEvent struct {
+	Type ReconcileEventType
+	Name string
+	Path string
+	// Duration is the time it took to reconcile the item. It's only set for events that finish an item.
+	Duration time.Duration
+	// Errors is only set for ReconcileEventErrored
+	Errors []*runtimev1.ReconcileError
 }
 
 type ReconcileResult struct {
@@ -104,6 +164,32 @@ func (r *ReconcileResult) collectAffectedPaths() {
 	}
 }
 
+// repoGlob matches the paths of the code artifacts that are reconciled.
+// Artifacts can be nested in folders, but their names must be unique across folders.
+// The frontend lists artifacts with the same glob, so keep them in sync.
+const repoGlob = "{sources,models,dashboards}/**/*.{sql,yaml,yml}"
+
+// IsProjectConfigPath returns true if a repo path is the project's rill.yaml. Changes to it can affect every artifact.
+func IsProjectConfigPath(p string) bool {
+	return path.Clean("/"+p) == "/rill.yaml"
+}
+
+// IsArtifactPath returns true if a repo path is a code artifact that is tracked by Reconcile.
+func IsArtifactPath(p string) bool {
+	ok, _ := doublestar.Match(repoGlob, strings.TrimPrefix(path.Clean("/"+p), "/"))
+	return ok
+}
+
+// ArtifactFolder returns the folder of an artifact relative to the root folder of its kind,
+// e.g. "finance/daily" for "/models/finance/daily/revenue.sql". It's empty for artifacts that are not nested.
+func ArtifactFolder(p string) string {
+	parts := strings.Split(strings.TrimPrefix(path.Clean("/"+p), "/"), "/")
+	if len(parts) <= 2 {
+		return ""
+	}
+	return path.Join(parts[1 : len(parts)-1]...)
+}
+
 type ArtifactError struct {
 	Error error
 	Path  string
@@ -112,8 +198,36 @@ type ArtifactError struct {
 // TODO: support loading existing projects
 
 func (s *Service) Reconcile(ctx context.Context, conf ReconcileConfig) (*ReconcileResult, error) {
+	s.reconcileLock.Lock()
+	defer s.reconcileLock.Unlock()
+
 	result := NewReconcileResult()
 
+	err := s.loadState(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	vars, err := s.resolveVariables(ctx)
+	if err != nil {
+		// A malformed rill.yaml shouldn't block reconciling the artifacts, which are evaluated with the instance's variables instead
+		result.Errors = append(result.Errors, &runtimev1.ReconcileError{
+			Code:     runtimev1.ReconcileError_CODE_SYNTAX,
+			Message:  err.Error(),
+			FilePath: "/rill.yaml",
+		})
+	}
+	if s.vars != nil && !drivers.EqualVariables(vars, s.vars) {
+		// variables changed, so every artifact needs to be re-evaluated
+		s.LastMigration = time.Time{}
+		conf.ChangedPaths = nil
+	}
+	prevVars := s.vars
+	s.vars = vars
+	if conf.DryRun {
+		defer func() { s.vars = prevVars }()
+	}
+
 	// collect repos and create migration items
 	migrationMap, err := s.collectRepos(ctx, conf, result)
 	if err != nil {
@@ -131,11 +245,33 @@ func (s *Service) Reconcile(ctx context.Context, conf ReconcileConfig) (*Reconci
 	if !conf.DryRun {
 		// TODO: changes to the file will not be picked up if done while running migration
 		s.LastMigration = time.Now()
+		err = s.saveState(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to save reconcile state: %w", err)
+		}
 	}
 	result.collectAffectedPaths()
 	return result, nil
 }
 
+// resolveVariables returns the variables for the instance's artifacts: the defaults in rill.yaml overridden by the instance's variables.
+// If rill.yaml can't be parsed, it returns the instance's variables along with the error.
+func (s *Service) resolveVariables(ctx context.Context) (map[string]string, error) {
+	conf, err := rillv1beta.New(s.Repo, s.InstID).ProjectConfig(ctx)
+	if err != nil {
+		conf = &rillv1beta.ProjectConfig{}
+	}
+
+	vars := make(map[string]string, len(conf.Vars)+len(s.Variables))
+	for k, v := range conf.Vars {
+		vars[k] = v
+	}
+	for k, v := range s.Variables {
+		vars[k] = v
+	}
+	return vars, err
+}
+
 // convert repo paths to MigrationItem
 
 func (s *Service) collectRepos(ctx context.Context, conf ReconcileConfig, result *ReconcileResult) (map[string]*MigrationItem, error) {
@@ -150,7 +286,7 @@ func (s *Service) collectRepos(ctx context.Context, conf ReconcileConfig, result
 		}
 	} else {
 		var err error
-		repoPaths, err = s.Repo.ListRecursive(ctx, s.InstID, "{sources,models,dashboards}/*.{sql,yaml,yml}")
+		repoPaths, _, err = s.Repo.ListRecursive(ctx, s.InstID, repoGlob, 0, "")
 		if err != nil {
 			return nil, err
 		}
@@ -178,11 +314,11 @@ func (s *Service) collectRepos(ctx context.Context, conf ReconcileConfig, result
 			continue
 		}
 
-		keepNew, errPath := s.isInvalidDuplicate(migrationMap, changedPathsHint, changedPathsMap, item)
+		keepNew, errPath, otherPath := s.isInvalidDuplicate(ctx, migrationMap, changedPathsHint, changedPathsMap, item)
 		if errPath != "" {
 			result.Errors = append(result.Errors, &runtimev1.ReconcileError{
 				Code:     runtimev1.ReconcileError_CODE_UNSPECIFIED,
-				Message:  "item with same name exists",
+				Message:  fmt.Sprintf("item with same name exists in %q (names must be unique across folders)", otherPath),
 				FilePath: errPath,
 			})
 		}
@@ -235,6 +371,10 @@ func (s *Service) collectRepos(ctx context.Context, conf ReconcileConfig, result
 		}
 		// go through the children only if forced paths is false
 		children := s.dag.GetChildren(item.NormalizedName)
+		if item.FromName != "" {
+			// children of a renamed item still reference the old name
+			children = append(children, s.dag.GetChildren(strings.ToLower(item.FromName))...)
+		}
 		for _, child := range children {
 			childPath, ok := s.NameToPath[child]
 			if !ok || (changedPathsHint && changedPathsMap[childPath]) {
@@ -250,6 +390,10 @@ func (s *Service) collectRepos(ctx context.Context, conf ReconcileConfig, result
 		}
 	}
 
+	if changedPathsHint {
+		s.collectCycleItems(ctx, migrationMap, storeObjectsMap, forcedPathMap)
+	}
+
 	for _, storeObject := range storeObjectsMap {
 		lowerStoreName := strings.ToLower(storeObject.Name)
 		// ignore consumed store objects
@@ -289,6 +433,32 @@ func (s *Service) collectRepos(ctx context.Context, conf ReconcileConfig, result
 	return migrationMap, nil
 }
 
+// collectCycleItems adds the unchanged items that are part of a dependency cycle with the items in migrationMap,
+// so that every item of the cycle is marked (see markCycles), not just the changed ones.
+func (s *Service) collectCycleItems(
+	ctx context.Context,
+	migrationMap map[string]*MigrationItem,
+	storeObjectsMap map[string]*drivers.CatalogEntry,
+	forcedPathMap map[string]bool,
+) {
+	for _, cycle := range s.dependencyGraph(migrationMap).Cycles() {
+		for _, name := range cycle {
+			if _, ok := migrationMap[name]; ok {
+				continue
+			}
+			path, ok := s.NameToPath[name]
+			if !ok {
+				continue
+			}
+			item := s.getMigrationItem(ctx, path, storeObjectsMap, forcedPathMap)
+			if item == nil {
+				continue
+			}
+			migrationMap[item.NormalizedName] = item
+		}
+	}
+}
+
 func (s *Service) getMigrationItem(
 	ctx context.Context,
 	repoPath string,
@@ -296,13 +466,14 @@ func (s *Service) getMigrationItem(
 	forcedPathMap map[string]bool,
 ) *MigrationItem {
 	item := &MigrationItem{
-		Type: MigrationNoChange,
-		Path: repoPath,
+		Type:        MigrationNoChange,
+		Path:        repoPath,
+		FullRefresh: forcedPathMap[repoPath],
 	}
 
 	forceChange := forcedPathMap[repoPath]
 
-	catalog, err := artifacts.Read(ctx, s.Repo, s.InstID, repoPath)
+	catalog, err := artifacts.Read(ctx, s.Repo, s.InstID, repoPath, s.vars)
 	if err != nil {
 		if !errors.Is(err, artifacts.ErrFileRead) {
 			item.Error = &runtimev1.ReconcileError{
@@ -371,8 +542,7 @@ func (s *Service) getMigrationItem(
 		item.Type = MigrationRename
 	}
 
-	switch item.Type {
-	case MigrationCreate:
+	if item.Type == MigrationCreate {
 		if migrator.IsEqual(ctx, item.CatalogInFile, item.CatalogInStore) && !forceChange {
 			// if the actual content has not changed, mark as MigrationNoChange
 			item.Type = MigrationNoChange
@@ -380,32 +550,46 @@ func (s *Service) getMigrationItem(
 			// else mark as MigrationUpdate
 			item.Type = MigrationUpdate
 		}
+	}
 
-	case MigrationNoChange:
+	if item.Type == MigrationNoChange {
 		// if item doesn't exist in olap, mark as create
-		// happens when the catalog table is modified directly
+		// happens when the catalog table is modified directly, or when the instance was switched to a new olap store
 		ok, _ := migrator.ExistsInOlap(ctx, s.Olap, item.CatalogInFile)
 		if !ok {
 			item.Type = MigrationCreate
 		}
 	}
 
+	if item.Type == MigrationNoChange && catalogInStore.Path != "" && catalogInStore.Path != repoPath {
+		// the file was moved, e.g. to another folder. track the new path as a rename that keeps the name
+		item.FromName = catalogInStore.Name
+		item.FromPath = catalogInStore.Path
+		item.Type = MigrationRename
+	}
+
 	return item
 }
 
 // isInvalidDuplicate checks if one of the existing or a new item is invalid duplicate.
+// It returns whether to keep the new item, and if there's a duplicate, the path to report it for and the path of the other item.
 func (s *Service) isInvalidDuplicate(
+	ctx context.Context,
 	migrationMap map[string]*MigrationItem,
 	changedPathsHint bool,
 	changedPathsMap map[string]bool,
 	item *MigrationItem,
-) (bool, string) {
+) (bool, string, string) {
 	errPath := ""
+	otherPath := ""
 
 	existing, ok := migrationMap[item.NormalizedName]
 	if ok {
 		keepNew := false
-		if existing.Name != item.Name {
+		if item.isRemoved() || existing.isRemoved() {
+			// the file was moved, e.g. to another folder. keep the item for the new path
+			keepNew = existing.isRemoved()
+		} else if existing.Name != item.Name {
 			// where it is a MigrationRename with different case
 			// keep the one marked as rename
 			if item.Type == MigrationRename {
@@ -422,20 +606,29 @@ func (s *Service) isInvalidDuplicate(
 				// replace the existing with new
 				keepNew = true
 				errPath = existing.Path
+				otherPath = item.Path
 			} else {
 				errPath = item.Path
+				otherPath = existing.Path
 			}
 		}
-		return keepNew, errPath
+		return keepNew, errPath, otherPath
 	}
 
 	if changedPathsHint {
 		if existingPath, ok := s.NameToPath[item.NormalizedName]; ok && existingPath != item.Path && !changedPathsMap[existingPath] {
-			return false, item.Path
+			if item.isRemoved() {
+				// the removed file was not the one tracked for the name
+				return false, "", ""
+			}
+			// the existing file might have been moved to the item's path
+			if _, err := s.Repo.Stat(ctx, s.InstID, existingPath); err == nil {
+				return false, item.Path, existingPath
+			}
 		}
 	}
 
-	return true, errPath
+	return true, errPath, otherPath
 }
 
 // collectMigrationItems collects all valid MigrationItem
@@ -447,13 +640,10 @@ func (s *Service) collectMigrationItems(
 	visited := make(map[string]int)
 	update := make(map[string]bool)
 
-	// temporary local dag for just the items to be migrated
+	// local dag with the new dependencies of the items to be migrated
 	// this will also help in getting a dag for new items
-	// TODO: is there a better way to do this?
-	tempDag := dag.NewDAG()
-	for name, migration := range migrationMap {
-		tempDag.Add(name, migration.NormalizedDependencies)
-	}
+	graph := s.dependencyGraph(migrationMap)
+	markCycles(graph, migrationMap)
 
 	for name, item := range migrationMap {
 		if item.Type == MigrationNoChange {
@@ -476,15 +666,9 @@ func (s *Service) collectMigrationItems(
 		migrationItems = append(migrationItems, item)
 
 		// get all the children and make sure they are not present before the parent in the order
-		children := arrayutil.Dedupe(append(
-			tempDag.GetChildren(name),
-			s.dag.GetChildren(name)...,
-		))
+		children := graph.GetChildren(name)
 		if item.FromName != "" {
-			children = append(children, arrayutil.Dedupe(append(
-				tempDag.GetChildren(strings.ToLower(item.FromName)),
-				s.dag.GetChildren(strings.ToLower(item.FromName))...,
-			))...)
+			children = arrayutil.Dedupe(append(children, graph.GetChildren(strings.ToLower(item.FromName))...))
 		}
 		for _, child := range children {
 			i, ok := visited[child]
@@ -529,100 +713,368 @@ func (s *Service) collectMigrationItems(
 	return cleanedMigrationItems
 }
 
+// dependencyGraph returns the existing DAG updated with the dependencies of the items to be migrated.
+// Renamed and deleted items are only kept as the parents of the items that still reference them.
+func (s *Service) dependencyGraph(migrationMap map[string]*MigrationItem) *dag.DAG {
+	renamed := make(map[string]bool)
+	for _, item := range migrationMap {
+		if item.FromName != "" {
+			renamed[strings.ToLower(item.FromName)] = true
+		}
+	}
+
+	graph := dag.NewDAG()
+	for name, n := range s.dag.NameMap {
+		if _, ok := migrationMap[name]; ok || renamed[name] || !n.Present {
+			continue
+		}
+		parents := make([]string, 0, len(n.Parents))
+		for parent := range n.Parents {
+			parents = append(parents, parent)
+		}
+		graph.Add(name, parents)
+	}
+	for name, item := range migrationMap {
+		if item.CatalogInFile == nil {
+			continue
+		}
+		graph.Add(name, item.NormalizedDependencies)
+	}
+	return graph
+}
+
+// markCycles sets a CODE_DEPENDENCY error on every item that is part of a dependency cycle in graph.
+// Since graph includes the unchanged items, a change to a single file can complete a cycle with other files.
+func markCycles(graph *dag.DAG, migrationMap map[string]*MigrationItem) {
+	for _, cycle := range graph.Cycles() {
+		msg := fmt.Sprintf("circular dependency between %s", strings.Join(cycle, ", "))
+		if len(cycle) == 1 {
+			msg = fmt.Sprintf("%s depends on itself", cycle[0])
+		}
+		for _, name := range cycle {
+			item, ok := migrationMap[name]
+			if !ok || item.CatalogInFile == nil {
+				continue
+			}
+			item.Error = &runtimev1.ReconcileError{
+				Code:     runtimev1.ReconcileError_CODE_DEPENDENCY,
+				Message:  msg,
+				FilePath: item.Path,
+			}
+		}
+	}
+}
+
 // runMigrationItems runs various actions from MigrationItem based on MigrationItem.Type.
+// The items are run level by level, so that an item only runs after the items it depends on.
+// The items of a level run in parallel on up to s.Concurrency workers. A failed item doesn't stop the other items,
+// but items that depend on it (directly or transitively) are skipped with a dependency error.
 func (s *Service) runMigrationItems(
 	ctx context.Context,
 	conf ReconcileConfig,
 	migrations []*MigrationItem,
 	result *ReconcileResult,
 ) error {
-	for _, item := range migrations {
-		if item.Error != nil {
-			result.Errors = append(result.Errors, item.Error)
+	concurrency := s.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	// names of the items that failed, guarded by migrationLock
+	failedNames := make(map[string]bool)
+
+	levels := migrationLevels(migrations)
+	for _, level := range levels {
+		for _, item := range level {
+			if item.Type != MigrationNoChange {
+				conf.emit(ReconcileEventQueued, item, 0, nil)
+			}
 		}
+	}
 
-		var validationErrors []*runtimev1.ReconcileError
+	for _, level := range levels {
+		var (
+			wg      sync.WaitGroup
+			sem     = make(chan struct{}, concurrency)
+			stopped bool
+			stopErr error
+		)
+		for _, item := range level {
+			item := item
+			sem <- struct{}{}
+			wg.Add(1)
+			go func() {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+
+				s.migrationLock.Lock()
+				defer s.migrationLock.Unlock()
+				if stopped {
+					return
+				}
+				// in a dry run, failed items are not removed, so their dependents are still validated against the existing objects
+				if !conf.DryRun && item.Error == nil && item.CatalogInFile != nil {
+					for _, dep := range item.NormalizedDependencies {
+						if failedNames[dep] {
+							item.Error = &runtimev1.ReconcileError{
+								Code:     runtimev1.ReconcileError_CODE_DEPENDENCY,
+								Message:  fmt.Sprintf("dependency %q failed", dep),
+								FilePath: item.Path,
+							}
+							break
+						}
+					}
+				}
+				failed, stop, err := s.runMigrationItem(ctx, conf, item, result)
+				if failed {
+					failedNames[item.NormalizedName] = true
+					if item.FromName != "" {
+						failedNames[strings.ToLower(item.FromName)] = true
+					}
+				}
+				if stop {
+					stopped = true
+					stopErr = err
+				}
+			}()
+		}
+		wg.Wait()
 
-		if item.CatalogInFile != nil {
-			validationErrors = migrator.Validate(ctx, s.Olap, item.CatalogInFile)
+		if stopped {
+			return stopErr
 		}
+	}
 
-		var err error
-		failed := false
-		if len(validationErrors) > 0 {
-			// do not run migration if validation failed
-			result.Errors = append(result.Errors, validationErrors...)
-			failed = true
-		} else if !conf.DryRun {
-			if item.CatalogInStore != nil {
-				// make sure store catalog has the correct name
-				// could be different in cases like "rename with different case"
-				item.CatalogInStore.Name = item.Name
+	return nil
+}
+
+// migrationLevels splits migrations, which are ordered with parents before children, into levels.
+// The items of a level only depend on items of earlier levels.
+func migrationLevels(migrations []*MigrationItem) [][]*MigrationItem {
+	levelOf := make(map[string]int)
+	var levels [][]*MigrationItem
+	for _, item := range migrations {
+		level := 0
+		for _, dep := range item.NormalizedDependencies {
+			if l, ok := levelOf[dep]; ok && l >= level {
+				level = l + 1
 			}
-			// only run the actual migration if in dry run
-			switch item.Type {
-			case MigrationNoChange:
-				if _, ok := s.PathToName[item.NormalizedName]; !ok {
-					// this is perhaps an init. so populate cache data
-					s.PathToName[item.Path] = item.NormalizedName
-					s.NameToPath[item.NormalizedName] = item.Path
-					s.dag.Add(item.NormalizedName, item.NormalizedDependencies)
-				}
-			case MigrationCreate:
-				err = s.createInStore(ctx, item)
-				result.AddedObjects = append(result.AddedObjects, item.CatalogInFile)
-			case MigrationRename:
-				err = s.renameInStore(ctx, item)
-				result.UpdatedObjects = append(result.UpdatedObjects, item.CatalogInFile)
-			case MigrationUpdate:
-				err = s.updateInStore(ctx, item)
-				result.UpdatedObjects = append(result.UpdatedObjects, item.CatalogInFile)
-			case MigrationDelete:
-				err = s.deleteInStore(ctx, item)
-				result.DroppedObjects = append(result.DroppedObjects, item.CatalogInStore)
+		}
+		if l, ok := levelOf[item.NormalizedName]; ok && l >= level {
+			// an item can be ordered after an item with the same name, like the old name of a rename
+			level = l + 1
+		}
+
+		levelOf[item.NormalizedName] = level
+		if item.FromName != "" {
+			// children of a renamed item still reference the old name
+			levelOf[strings.ToLower(item.FromName)] = level
+		}
+
+		if level == len(levels) {
+			levels = append(levels, nil)
+		}
+		levels[level] = append(levels[level], item)
+	}
+	return levels
+}
+
+// runMigrationItem runs a single MigrationItem and records the outcome in result.
+// It must be called while holding migrationLock, which is released during long running OLAP operations.
+// It returns whether the item failed, and whether the reconcile should stop, which happens when an item fails in strict mode.
+func (s *Service) runMigrationItem(
+	ctx context.Context,
+	conf ReconcileConfig,
+	item *MigrationItem,
+	result *ReconcileResult,
+) (bool, bool, error) {
+	reportable := item.Type != MigrationNoChange
+	start := time.Now()
+	if reportable {
+		conf.emit(ReconcileEventStarted, item, 0, nil)
+	}
+
+	// errors of the item are added to result at the end, since other items can run while the lock is released
+	var errs []*runtimev1.ReconcileError
+	defer func() {
+		result.Errors = append(result.Errors, errs...)
+		if len(errs) > 0 {
+			conf.emit(ReconcileEventErrored, item, time.Since(start), errs)
+		} else if reportable {
+			conf.emit(migrationEventTypes[item.Type], item, time.Since(start), nil)
+		}
+	}()
+
+	// items that could be read but have an error (like a dependency cycle) are not migrated
+	failed := false
+	if item.Error != nil {
+		errs = append(errs, item.Error)
+		failed = item.CatalogInFile != nil
+	}
+
+	var validationErrors []*runtimev1.ReconcileError
+
+	if item.CatalogInFile != nil && !failed {
+		validationErrors = s.transpile(ctx, item)
+		if len(validationErrors) == 0 {
+			_ = s.unlocked(func() error {
+				validationErrors = migrator.Validate(ctx, s.Olap, item.CatalogInFile)
+				return nil
+			})
+		}
+	}
+
+	var err error
+	if failed {
+		if !conf.DryRun {
+			// track the item so that resolving the error re-evaluates it
+			s.NameToPath[item.NormalizedName] = item.Path
+			s.PathToName[item.Path] = item.NormalizedName
+			s.dag.Add(item.NormalizedName, item.NormalizedDependencies)
+		}
+	} else if len(validationErrors) > 0 {
+		// do not run migration if validation failed
+		errs = append(errs, validationErrors...)
+		failed = true
+	} else if !conf.DryRun {
+		if item.CatalogInStore != nil {
+			// make sure store catalog has the correct name
+			// could be different in cases like "rename with different case"
+			item.CatalogInStore.Name = item.Name
+		}
+		// only run the actual migration if in dry run
+		switch item.Type {
+		case MigrationNoChange:
+			if _, ok := s.PathToName[item.NormalizedName]; !ok {
+				// this is perhaps an init. so populate cache data
+				s.PathToName[item.Path] = item.NormalizedName
+				s.NameToPath[item.NormalizedName] = item.Path
+				s.dag.Add(item.NormalizedName, item.NormalizedDependencies)
 			}
+		case MigrationCreate:
+			err = s.createInStore(ctx, item)
+			result.AddedObjects = append(result.AddedObjects, item.CatalogInFile)
+		case MigrationRename:
+			err = s.renameInStore(ctx, item)
+			result.UpdatedObjects = append(result.UpdatedObjects, item.CatalogInFile)
+		case MigrationUpdate:
+			err = s.updateInStore(ctx, item)
+			result.UpdatedObjects = append(result.UpdatedObjects, item.CatalogInFile)
+		case MigrationDelete:
+			err = s.deleteInStore(ctx, item)
+			result.DroppedObjects = append(result.DroppedObjects, item.CatalogInStore)
 		}
+	}
+
+	if err != nil {
+		errs = append(errs, &runtimev1.ReconcileError{
+			Code:     runtimev1.ReconcileError_CODE_OLAP,
+			Message:  err.Error(),
+			FilePath: item.Path,
+		})
+		failed = true
+	}
 
+	if failed && !conf.DryRun {
+		if item.CatalogInFile != nil {
+			// track the item's dependencies, so that it's re-evaluated when they change and reported if it's part of a cycle
+			s.NameToPath[item.NormalizedName] = item.Path
+			s.PathToName[item.Path] = item.NormalizedName
+			s.dag.Add(item.NormalizedName, item.NormalizedDependencies)
+		}
+		// remove entity from catalog and OLAP if it failed validation or during migration
+		err := s.Catalog.DeleteEntry(ctx, s.InstID, item.Name)
 		if err != nil {
-			result.Errors = append(result.Errors, &runtimev1.ReconcileError{
+			// shouldn't ideally happen
+			errs = append(errs, &runtimev1.ReconcileError{
 				Code:     runtimev1.ReconcileError_CODE_OLAP,
 				Message:  err.Error(),
 				FilePath: item.Path,
 			})
-			failed = true
 		}
-
-		if failed && !conf.DryRun {
-			// remove entity from catalog and OLAP if it failed validation or during migration
-			err := s.Catalog.DeleteEntry(ctx, s.InstID, item.Name)
+		if item.CatalogInFile != nil {
+			err := s.unlocked(func() error {
+				return migrator.Delete(ctx, s.Olap, item.CatalogInFile)
+			})
 			if err != nil {
 				// shouldn't ideally happen
-				result.Errors = append(result.Errors, &runtimev1.ReconcileError{
+				errs = append(errs, &runtimev1.ReconcileError{
 					Code:     runtimev1.ReconcileError_CODE_OLAP,
 					Message:  err.Error(),
 					FilePath: item.Path,
 				})
 			}
-			if item.CatalogInFile != nil {
-				err := migrator.Delete(ctx, s.Olap, item.CatalogInFile)
-				if err != nil {
-					// shouldn't ideally happen
-					result.Errors = append(result.Errors, &runtimev1.ReconcileError{
-						Code:     runtimev1.ReconcileError_CODE_OLAP,
-						Message:  err.Error(),
-						FilePath: item.Path,
-					})
-				}
+		}
+		if conf.Strict {
+			return true, true, err
+		}
+	}
+
+	return failed, false, nil
+}
+
+// transpile transpiles the SQL of a model in the Rill dialect to the dialect of the OLAP.
+// The tables referenced by the model are resolved with the catalog, so it must run after its dependencies are migrated.
+func (s *Service) transpile(ctx context.Context, item *MigrationItem) []*runtimev1.ReconcileError {
+	if item.CatalogInFile.Type != drivers.ObjectTypeModel {
+		return nil
+	}
+	model := item.CatalogInFile.GetModel()
+	if model.Dialect != runtimev1.Model_DIALECT_RILL {
+		return nil
+	}
+
+	entries := s.Catalog.FindEntries(ctx, s.InstID, drivers.ObjectTypeUnspecified)
+	sql, err := models.Transpile(model.Sql, s.Olap.Dialect(), entries)
+	if err != nil {
+		reconcileErr := &runtimev1.ReconcileError{
+			Code:     runtimev1.ReconcileError_CODE_VALIDATION,
+			Message:  err.Error(),
+			FilePath: item.Path,
+		}
+		var transpileErr *models.TranspileError
+		if errors.As(err, &transpileErr) && transpileErr.Line > 0 {
+			// the location is relative to the SQL, which can come after model options in the file
+			offset := s.sqlLineOffset(ctx, item)
+			reconcileErr.StartLocation = &runtimev1.ReconcileError_CharLocation{
+				Line:   transpileErr.Line + offset,
+				Column: transpileErr.Column,
 			}
-			if conf.Strict {
-				return err
+			if transpileErr.EndLine > 0 {
+				reconcileErr.EndLocation = &runtimev1.ReconcileError_CharLocation{
+					Line:   transpileErr.EndLine + offset,
+					Column: transpileErr.EndColumn,
+				}
 			}
 		}
+		return []*runtimev1.ReconcileError{reconcileErr}
 	}
 
+	model.TranspiledSql = sql
 	return nil
 }
 
+// sqlLineOffset returns the number of lines before the SQL of a model in its file.
+// It returns 0 if the SQL isn't found in the file, which happens if it contains variables.
+func (s *Service) sqlLineOffset(ctx context.Context, item *MigrationItem) uint32 {
+	blob, err := s.Repo.Get(ctx, s.InstID, item.Path)
+	sql := item.CatalogInFile.GetModel().Sql
+	if err != nil || !strings.HasSuffix(blob, sql) {
+		return 0
+	}
+	return uint32(strings.Count(blob[:len(blob)-len(sql)], "\n"))
+}
+
+// unlocked runs fn without holding migrationLock, which lets other migration items run in the meantime.
+// fn must not access the service's state.
+func (s *Service) unlocked(fn func() error) error {
+	s.migrationLock.Unlock()
+	defer s.migrationLock.Lock()
+	return fn()
+}
+
 // TODO: should we remove from dag if validation fails?
 // TODO: store only valid metrics view
 
@@ -633,8 +1085,10 @@ func (s *Service) createInStore(ctx context.Context, item *MigrationItem) error
 	s.dag.Add(item.NormalizedName, item.NormalizedDependencies)
 
 	// create in olap
-	err := s.wrapMigrator(item.CatalogInFile, func() error {
-		return migrator.Create(ctx, s.Olap, s.Repo, item.CatalogInFile)
+	err := s.unlocked(func() error {
+		return s.wrapMigrator(item.CatalogInFile, func() error {
+			return migrator.Create(ctx, s.Olap, s.Repo, item.CatalogInFile)
+		})
 	})
 	if err != nil {
 		return err
@@ -666,35 +1120,54 @@ func (s *Service) renameInStore(ctx context.Context, item *MigrationItem) error
 	s.dag.Delete(fromLowerName)
 	s.dag.Add(item.NormalizedName, item.NormalizedDependencies)
 
-	// rename the item in olap
-	err := migrator.Rename(ctx, s.Olap, item.FromName, item.CatalogInFile)
-	if err != nil {
-		return err
+	// rename the item in olap (unless only its path changed)
+	if item.FromName != item.Name {
+		err := s.unlocked(func() error {
+			return migrator.Rename(ctx, s.Olap, item.FromName, item.CatalogInFile)
+		})
+		if err != nil {
+			return err
+		}
 	}
 
-	// delete the old catalog object
-	// TODO: do we need a rename here?
-	err = s.Catalog.DeleteEntry(ctx, s.InstID, item.FromName)
-	if err != nil {
-		return err
-	}
-	// update the catalog object and create it in store
+	// update the catalog object and replace the old one in store
 	catalog, err := s.updateCatalogObject(ctx, item)
 	if err != nil {
 		return err
 	}
-	return s.Catalog.CreateEntry(ctx, s.InstID, catalog)
+	return s.Catalog.RenameEntry(ctx, s.InstID, item.FromName, catalog)
 }
 
 func (s *Service) updateInStore(ctx context.Context, item *MigrationItem) error {
+	if item.CatalogInStore != nil && item.CatalogInStore.Path != item.Path {
+		// the file was moved and changed
+		delete(s.PathToName, item.CatalogInStore.Path)
+	}
 	s.NameToPath[item.NormalizedName] = item.Path
 	s.PathToName[item.Path] = item.NormalizedName
 	// add the item to DAG with new dependencies
 	s.dag.Add(item.NormalizedName, item.NormalizedDependencies)
 
+	// a view can't be replaced with a table or vice versa, so drop a model that changed between the two first
+	if item.CatalogInStore != nil && item.CatalogInStore.Type == drivers.ObjectTypeModel &&
+		item.CatalogInStore.GetModel().GetMaterialize() != item.CatalogInFile.GetModel().GetMaterialize() {
+		err := s.unlocked(func() error {
+			return migrator.Delete(ctx, s.Olap, item.CatalogInStore)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
 	// update in olap
-	err := s.wrapMigrator(item.CatalogInFile, func() error {
-		return migrator.Update(ctx, s.Olap, s.Repo, item.CatalogInFile)
+	err := s.unlocked(func() error {
+		return s.wrapMigrator(item.CatalogInFile, func() error {
+			if !item.FullRefresh && item.CatalogInStore != nil && migrator.IsEqual(ctx, item.CatalogInStore, item.CatalogInFile) {
+				// only the data of the dependencies changed
+				return migrator.Refresh(ctx, s.Olap, s.Repo, item.CatalogInFile)
+			}
+			return migrator.Update(ctx, s.Olap, s.Repo, item.CatalogInFile)
+		})
 	})
 	if err != nil {
 		return err
@@ -714,7 +1187,9 @@ func (s *Service) deleteInStore(ctx context.Context, item *MigrationItem) error
 	// delete item from dag
 	s.dag.Delete(item.NormalizedName)
 	// delete item from olap
-	err := migrator.Delete(ctx, s.Olap, item.CatalogInStore)
+	err := s.unlocked(func() error {
+		return migrator.Delete(ctx, s.Olap, item.CatalogInStore)
+	})
 	if err != nil {
 		return err
 	}
@@ -739,6 +1214,14 @@ func (s *Service) updateCatalogObject(ctx context.Context, item *MigrationItem)
 	catalogEntry.UpdatedOn = repoStat.LastUpdated
 	catalogEntry.RefreshedOn = time.Now()
 
+	// record which contents of the artifact the entry was created from
+	blob, err := s.Repo.Get(ctx, s.InstID, item.Path)
+	if err != nil {
+		return nil, err
+	}
+	catalogEntry.ContentHash = artifacts.ContentHash(blob)
+	catalogEntry.Artifact = blob
+
 	err = migrator.SetSchema(ctx, s.Olap, catalogEntry)
 	if err != nil {
 		return nil, err
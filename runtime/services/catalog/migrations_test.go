@@ -2,6 +2,7 @@ package catalog_test
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path"
 	"path/filepath"
@@ -63,7 +64,8 @@ func TestReconcile(t *testing.T) {
 			result, err := s.Reconcile(context.Background(), tt.config)
 			require.NoError(t, err)
 			testutils.AssertMigration(t, result, 2, 0, 1, 0, AdBidsAffectedPaths)
-			require.Equal(t, metricsviews.SourceNotFound, result.Errors[1].Message)
+			require.Equal(t, runtimev1.ReconcileError_CODE_DEPENDENCY, result.Errors[1].Code)
+			require.Equal(t, `dependency "adbids_model" failed`, result.Errors[1].Message)
 			testutils.AssertTable(t, s, "AdBids", AdBidsRepoPath)
 			testutils.AssertTableAbsence(t, s, "AdBids_model")
 
@@ -246,6 +248,48 @@ func TestRefreshSource(t *testing.T) {
 	}
 }
 
+func TestReconcileAfterRestart(t *testing.T) {
+	var AdBidsLimitedRepoPath = "/models/AdBids_limited.sql"
+	ctx := context.Background()
+	s, _ := initBasicService(t)
+	vars := map[string]string{"limit": "10"}
+	s.Variables = vars
+
+	testutils.CreateModel(t, s, "AdBids_limited", "select * from AdBids limit {{ .vars.limit }}", AdBidsLimitedRepoPath)
+	result, err := s.Reconcile(ctx, catalog.ReconcileConfig{})
+	require.NoError(t, err)
+	// setting the variables re-evaluates every file
+	testutils.AssertMigration(t, result, 0, 1, 1, 0, []string{AdBidsLimitedRepoPath, AdBidsDashboardRepoPath})
+
+	// a new service for the same instance resumes from the persisted state
+	s = catalog.NewService(s.Catalog, s.Repo, s.Olap, s.InstID, vars, nil)
+	p, ok, err := s.FindPath(ctx, "adbids_model")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, AdBidsModelRepoPath, p)
+	result, err = s.Reconcile(ctx, catalog.ReconcileConfig{})
+	require.NoError(t, err)
+	testutils.AssertMigration(t, result, 0, 0, 0, 0, []string{})
+
+	// the dependants of a changed file are found in the persisted DAG
+	s = catalog.NewService(s.Catalog, s.Repo, s.Olap, s.InstID, vars, nil)
+	testutils.CreateModel(t, s, "AdBids_model",
+		"select id, timestamp, publisher, domain, bid_price from AdBids where bid_price > 1", AdBidsModelRepoPath)
+	result, err = s.Reconcile(ctx, catalog.ReconcileConfig{
+		ChangedPaths: []string{AdBidsModelRepoPath},
+	})
+	require.NoError(t, err)
+	testutils.AssertMigration(t, result, 0, 0, 2, 0, AdBidsDashboardAffectedPaths)
+
+	// variables that changed while the service was down re-evaluate every file
+	s = catalog.NewService(s.Catalog, s.Repo, s.Olap, s.InstID, map[string]string{"limit": "5"}, nil)
+	result, err = s.Reconcile(ctx, catalog.ReconcileConfig{
+		ChangedPaths: []string{AdBidsModelRepoPath},
+	})
+	require.NoError(t, err)
+	testutils.AssertMigration(t, result, 0, 0, 2, 0, []string{AdBidsLimitedRepoPath, AdBidsDashboardRepoPath})
+}
+
 func TestInterdependentModel(t *testing.T) {
 	configs := []struct {
 		title  string
@@ -279,7 +323,9 @@ func TestInterdependentModel(t *testing.T) {
 			result, err = s.Reconcile(context.Background(), tt.config)
 			require.NoError(t, err)
 			testutils.AssertMigration(t, result, 3, 0, 1, 0, AdBidsAllAffectedPaths)
-			require.Equal(t, metricsviews.SourceNotFound, result.Errors[2].Message)
+			require.Equal(t, runtimev1.ReconcileError_CODE_DEPENDENCY, result.Errors[1].Code)
+			require.Equal(t, `dependency "adbids_source_model" failed`, result.Errors[1].Message)
+			require.Equal(t, `dependency "adbids_model" failed`, result.Errors[2].Message)
 			testutils.AssertTableAbsence(t, s, "AdBids_source_model")
 			testutils.AssertTableAbsence(t, s, "AdBids_model")
 
@@ -357,7 +403,7 @@ func TestModelRenameToSource(t *testing.T) {
 			require.NoError(t, err)
 			testutils.AssertMigration(t, result, 2, 0, 0, 1,
 				[]string{AdBidsModelRepoPath, AdBidsModelAsSource, AdBidsDashboardRepoPath})
-			require.Equal(t, "item with same name exists", result.Errors[0].Message)
+			require.Equal(t, `item with same name exists in "/sources/AdBids.yaml" (names must be unique across folders)`, result.Errors[0].Message)
 			testutils.AssertTable(t, s, "AdBids", AdBidsRepoPath)
 			testutils.AssertTableAbsence(t, s, "AdBids_model")
 
@@ -365,20 +411,65 @@ func TestModelRenameToSource(t *testing.T) {
 			testutils.RenameFile(t, dir, AdBidsModelAsSource, AdBidsModelRepoPath)
 			result, err = s.Reconcile(context.Background(), tt.config)
 			require.NoError(t, err)
-			errCount := 0
-			changedPaths := []string{AdBidsModelRepoPath, AdBidsDashboardRepoPath}
-			if len(tt.config.ChangedPaths) > 0 {
-				errCount = 1
-				changedPaths = append(changedPaths, AdBidsModelAsSource)
-			}
-			// TODO: fix the issue of AdBidsModelAsSource being marked as error here
-			testutils.AssertMigration(t, result, errCount, 2, 0, 0, changedPaths)
+			testutils.AssertMigration(t, result, 0, 2, 0, 0, []string{AdBidsModelRepoPath, AdBidsDashboardRepoPath})
 			testutils.AssertTable(t, s, "AdBids", AdBidsRepoPath)
 			testutils.AssertTable(t, s, "AdBids_model", AdBidsModelRepoPath)
 		})
 	}
 }
 
+func TestNestedFolders(t *testing.T) {
+	var NestedModelRepoPath = "/models/finance/daily/AdBids_nested.sql"
+	var MovedModelRepoPath = "/models/marketing/AdBids_nested.sql"
+	var DuplicateModelRepoPath = "/models/marketing/AdBids_model.sql"
+
+	configs := []struct {
+		title  string
+		config catalog.ReconcileConfig
+	}{
+		{"ReconcileAll", catalog.ReconcileConfig{}},
+		{"ReconcileSelected", catalog.ReconcileConfig{
+			ChangedPaths: []string{NestedModelRepoPath, MovedModelRepoPath, DuplicateModelRepoPath},
+		}},
+	}
+
+	for _, tt := range configs {
+		t.Run(tt.title, func(t *testing.T) {
+			s, dir := initBasicService(t)
+
+			testutils.CreateModel(t, s, "AdBids_nested", "select * from AdBids_model", NestedModelRepoPath)
+			result, err := s.Reconcile(context.Background(), tt.config)
+			require.NoError(t, err)
+			testutils.AssertMigration(t, result, 0, 1, 0, 0, []string{NestedModelRepoPath})
+			testutils.AssertTable(t, s, "AdBids_nested", NestedModelRepoPath)
+			require.Equal(t, "finance/daily", catalog.ArtifactFolder(NestedModelRepoPath))
+
+			// moving to another folder keeps the entry
+			testutils.RenameFile(t, dir, NestedModelRepoPath, MovedModelRepoPath)
+			result, err = s.Reconcile(context.Background(), tt.config)
+			require.NoError(t, err)
+			testutils.AssertMigration(t, result, 0, 0, 1, 0, []string{MovedModelRepoPath})
+			testutils.AssertTable(t, s, "AdBids_nested", MovedModelRepoPath)
+
+			// names are unique across folders
+			testutils.CreateModel(t, s, "AdBids_model", "select * from AdBids", DuplicateModelRepoPath)
+			result, err = s.Reconcile(context.Background(), tt.config)
+			require.NoError(t, err)
+			testutils.AssertMigration(t, result, 1, 0, 0, 0, []string{DuplicateModelRepoPath})
+			require.Equal(t, `item with same name exists in "/models/AdBids_model.sql" (names must be unique across folders)`, result.Errors[0].Message)
+			testutils.AssertTable(t, s, "AdBids_model", AdBidsModelRepoPath)
+
+			// removing the duplicate leaves the original untouched
+			require.NoError(t, os.Remove(path.Join(dir, DuplicateModelRepoPath)))
+			result, err = s.Reconcile(context.Background(), tt.config)
+			require.NoError(t, err)
+			testutils.AssertMigration(t, result, 0, 0, 0, 0, []string{})
+			testutils.AssertTable(t, s, "AdBids_model", AdBidsModelRepoPath)
+			testutils.AssertTable(t, s, "AdBids_nested", MovedModelRepoPath)
+		})
+	}
+}
+
 func TestModelVariations(t *testing.T) {
 	s, _ := initBasicService(t)
 
@@ -438,6 +529,433 @@ func TestModelWithMissingSource(t *testing.T) {
 		append([]string{AdBidsSourceModelRepoPath}, AdBidsAffectedPaths...))
 }
 
+func TestModelCycles(t *testing.T) {
+	var ModelARepoPath = "/models/cycle_a.sql"
+	var ModelBRepoPath = "/models/cycle_b.sql"
+
+	configs := []struct {
+		title  string
+		config catalog.ReconcileConfig
+	}{
+		{"ReconcileAll", catalog.ReconcileConfig{}},
+		{"ReconcileSelected", catalog.ReconcileConfig{
+			ChangedPaths: []string{AdBidsModelRepoPath},
+		}},
+	}
+
+	for _, tt := range configs {
+		t.Run(tt.title, func(t *testing.T) {
+			s, _ := initBasicService(t)
+
+			testutils.CreateModel(t, s, "cycle_a", "select * from AdBids_model", ModelARepoPath)
+			testutils.CreateModel(t, s, "cycle_b", "select * from cycle_a", ModelBRepoPath)
+			result, err := s.Reconcile(context.Background(), catalog.ReconcileConfig{})
+			require.NoError(t, err)
+			testutils.AssertMigration(t, result, 0, 2, 0, 0, []string{ModelARepoPath, ModelBRepoPath})
+
+			// close the cycle AdBids_model -> cycle_a -> cycle_b -> AdBids_model
+			testutils.CreateModel(t, s, "AdBids_model", "select * from cycle_b", AdBidsModelRepoPath)
+			result, err = s.Reconcile(context.Background(), tt.config)
+			require.NoError(t, err)
+			testutils.AssertMigration(t, result, 4, 0, 0, 0,
+				[]string{AdBidsModelRepoPath, ModelARepoPath, ModelBRepoPath, AdBidsDashboardRepoPath})
+			cyclePaths := make([]string, 0)
+			for _, e := range result.Errors {
+				if e.FilePath == AdBidsDashboardRepoPath {
+					require.Equal(t, `dependency "adbids_model" failed`, e.Message)
+				} else if e.Code == runtimev1.ReconcileError_CODE_DEPENDENCY {
+					require.Equal(t, "circular dependency between adbids_model, cycle_a, cycle_b", e.Message)
+					cyclePaths = append(cyclePaths, e.FilePath)
+				}
+			}
+			require.ElementsMatch(t, []string{AdBidsModelRepoPath, ModelARepoPath, ModelBRepoPath}, cyclePaths)
+			testutils.AssertTableAbsence(t, s, "AdBids_model")
+			testutils.AssertTableAbsence(t, s, "cycle_a")
+			testutils.AssertTableAbsence(t, s, "cycle_b")
+
+			// break the cycle
+			testutils.CreateModel(t, s, "AdBids_model",
+				"select id, timestamp, publisher, domain, bid_price from AdBids", AdBidsModelRepoPath)
+			result, err = s.Reconcile(context.Background(), tt.config)
+			require.NoError(t, err)
+			testutils.AssertMigration(t, result, 0, 4, 0, 0,
+				[]string{AdBidsModelRepoPath, ModelARepoPath, ModelBRepoPath, AdBidsDashboardRepoPath})
+			testutils.AssertTable(t, s, "AdBids_model", AdBidsModelRepoPath)
+			testutils.AssertTable(t, s, "cycle_a", ModelARepoPath)
+			testutils.AssertTable(t, s, "cycle_b", ModelBRepoPath)
+
+			// a model depending on itself
+			testutils.CreateModel(t, s, "cycle_b", "select * from cycle_b", ModelBRepoPath)
+			result, err = s.Reconcile(context.Background(), catalog.ReconcileConfig{})
+			require.NoError(t, err)
+			testutils.AssertMigration(t, result, 1, 0, 0, 0, []string{ModelBRepoPath})
+			require.Equal(t, runtimev1.ReconcileError_CODE_DEPENDENCY, result.Errors[0].Code)
+			require.Equal(t, "cycle_b depends on itself", result.Errors[0].Message)
+			testutils.AssertTableAbsence(t, s, "cycle_b")
+		})
+	}
+}
+
+func TestModelCycleClosedByOneFile(t *testing.T) {
+	var ModelARepoPath = "/models/cycle_a.sql"
+	var ModelBRepoPath = "/models/cycle_b.sql"
+	var ModelCRepoPath = "/models/cycle_c.sql"
+
+	s, _ := initBasicService(t)
+
+	// cycle_a depends on the missing cycle_c, so it fails and cycle_b fails with it
+	testutils.CreateModel(t, s, "cycle_a", "select * from cycle_c", ModelARepoPath)
+	testutils.CreateModel(t, s, "cycle_b", "select * from cycle_a", ModelBRepoPath)
+	result, err := s.Reconcile(context.Background(), catalog.ReconcileConfig{})
+	require.NoError(t, err)
+	testutils.AssertMigration(t, result, 2, 0, 0, 0, []string{ModelARepoPath, ModelBRepoPath})
+
+	// only the file that closes the cycle cycle_a -> cycle_c -> cycle_b -> cycle_a changes
+	testutils.CreateModel(t, s, "cycle_c", "select * from cycle_b", ModelCRepoPath)
+	result, err = s.Reconcile(context.Background(), catalog.ReconcileConfig{
+		ChangedPaths: []string{ModelCRepoPath},
+	})
+	require.NoError(t, err)
+	testutils.AssertMigration(t, result, 3, 0, 0, 0, []string{ModelARepoPath, ModelBRepoPath, ModelCRepoPath})
+	for _, e := range result.Errors {
+		require.Equal(t, runtimev1.ReconcileError_CODE_DEPENDENCY, e.Code)
+		require.Equal(t, "circular dependency between cycle_a, cycle_b, cycle_c", e.Message)
+	}
+	testutils.AssertTableAbsence(t, s, "cycle_a")
+	testutils.AssertTableAbsence(t, s, "cycle_b")
+	testutils.AssertTableAbsence(t, s, "cycle_c")
+}
+
+func TestModelChain(t *testing.T) {
+	var ModelARepoPath = "/models/chain_a.sql"
+	var ModelARenamedRepoPath = "/models/chain_a_renamed.sql"
+	var ModelBRepoPath = "/models/chain_b.sql"
+
+	configs := []struct {
+		title  string
+		config catalog.ReconcileConfig
+	}{
+		{"ReconcileAll", catalog.ReconcileConfig{}},
+		{"ReconcileSelected", catalog.ReconcileConfig{
+			ChangedPaths: []string{ModelARepoPath, ModelARenamedRepoPath},
+		}},
+	}
+
+	for _, tt := range configs {
+		t.Run(tt.title, func(t *testing.T) {
+			s, dir := initBasicService(t)
+
+			testutils.CreateModel(t, s, "chain_a", "select * from AdBids_model", ModelARepoPath)
+			testutils.CreateModel(t, s, "chain_b", "select * from chain_a", ModelBRepoPath)
+			result, err := s.Reconcile(context.Background(), catalog.ReconcileConfig{})
+			require.NoError(t, err)
+			testutils.AssertMigration(t, result, 0, 2, 0, 0, []string{ModelARepoPath, ModelBRepoPath})
+
+			// renaming the upstream model breaks the downstream model
+			testutils.RenameFile(t, dir, ModelARepoPath, ModelARenamedRepoPath)
+			result, err = s.Reconcile(context.Background(), tt.config)
+			require.NoError(t, err)
+			testutils.AssertMigration(t, result, 1, 0, 1, 0, []string{ModelARenamedRepoPath, ModelBRepoPath})
+			require.Equal(t, ModelBRepoPath, result.Errors[0].FilePath)
+			testutils.AssertTable(t, s, "chain_a_renamed", ModelARenamedRepoPath)
+			testutils.AssertTableAbsence(t, s, "chain_b")
+
+			// renaming it back fixes the downstream model
+			testutils.RenameFile(t, dir, ModelARenamedRepoPath, ModelARepoPath)
+			result, err = s.Reconcile(context.Background(), tt.config)
+			require.NoError(t, err)
+			testutils.AssertMigration(t, result, 0, 1, 1, 0, []string{ModelARepoPath, ModelBRepoPath})
+			testutils.AssertTable(t, s, "chain_a", ModelARepoPath)
+			testutils.AssertTable(t, s, "chain_b", ModelBRepoPath)
+
+			// deleting the upstream model breaks the downstream model
+			err = os.Remove(path.Join(dir, ModelARepoPath))
+			require.NoError(t, err)
+			result, err = s.Reconcile(context.Background(), tt.config)
+			require.NoError(t, err)
+			testutils.AssertMigration(t, result, 1, 0, 0, 1, []string{ModelARepoPath, ModelBRepoPath})
+			testutils.AssertTableAbsence(t, s, "chain_a")
+			testutils.AssertTableAbsence(t, s, "chain_b")
+
+			// re-adding it fixes the downstream model
+			testutils.CreateModel(t, s, "chain_a", "select * from AdBids_model", ModelARepoPath)
+			result, err = s.Reconcile(context.Background(), tt.config)
+			require.NoError(t, err)
+			testutils.AssertMigration(t, result, 0, 2, 0, 0, []string{ModelARepoPath, ModelBRepoPath})
+			testutils.AssertTable(t, s, "chain_a", ModelARepoPath)
+			testutils.AssertTable(t, s, "chain_b", ModelBRepoPath)
+		})
+	}
+}
+
+func TestMaterializedModel(t *testing.T) {
+	var AdBidsRenamedModelRepoPath = "/models/AdBids_renamed_model.sql"
+	ctx := context.Background()
+	s, dir := initBasicService(t)
+
+	isView := func(name string) bool {
+		table, err := s.Olap.InformationSchema().Lookup(ctx, name)
+		require.NoError(t, err)
+		return table.View
+	}
+
+	// materialize the model
+	testutils.CreateModel(t, s, "AdBids_model",
+		"-- @materialize: true\nselect id, timestamp, publisher, domain, bid_price from AdBids", AdBidsModelRepoPath)
+	result, err := s.Reconcile(ctx, catalog.ReconcileConfig{})
+	require.NoError(t, err)
+	testutils.AssertMigration(t, result, 0, 0, 2, 0, AdBidsDashboardAffectedPaths)
+	testutils.AssertTable(t, s, "AdBids_model", AdBidsModelRepoPath)
+	require.False(t, isView("AdBids_model"))
+	entry := testutils.AssertInCatalogStore(t, s, "AdBids_model", AdBidsModelRepoPath)
+	require.True(t, entry.GetModel().Materialize)
+	require.NotNil(t, entry.GetModel().Stats)
+	refreshedOn := entry.RefreshedOn
+
+	// refreshing the source rebuilds the model
+	time.Sleep(time.Millisecond * 10)
+	result, err = s.Reconcile(ctx, catalog.ReconcileConfig{
+		ChangedPaths: []string{AdBidsRepoPath},
+		ForcedPaths:  []string{AdBidsRepoPath},
+	})
+	require.NoError(t, err)
+	testutils.AssertMigration(t, result, 0, 0, 3, 0, AdBidsAffectedPaths)
+	entry = testutils.AssertInCatalogStore(t, s, "AdBids_model", AdBidsModelRepoPath)
+	require.True(t, entry.RefreshedOn.After(refreshedOn))
+
+	// rename the table
+	testutils.RenameFile(t, dir, AdBidsModelRepoPath, AdBidsRenamedModelRepoPath)
+	result, err = s.Reconcile(ctx, catalog.ReconcileConfig{})
+	require.NoError(t, err)
+	testutils.AssertMigration(t, result, 1, 0, 1, 0, []string{AdBidsRenamedModelRepoPath, AdBidsDashboardRepoPath})
+	testutils.AssertTable(t, s, "AdBids_renamed_model", AdBidsRenamedModelRepoPath)
+	require.False(t, isView("AdBids_renamed_model"))
+	testutils.AssertTableAbsence(t, s, "AdBids_model")
+
+	testutils.RenameFile(t, dir, AdBidsRenamedModelRepoPath, AdBidsModelRepoPath)
+	result, err = s.Reconcile(ctx, catalog.ReconcileConfig{})
+	require.NoError(t, err)
+	testutils.AssertMigration(t, result, 0, 1, 1, 0, AdBidsDashboardAffectedPaths)
+
+	// back to a view
+	testutils.CreateModel(t, s, "AdBids_model",
+		"select id, timestamp, publisher, domain, bid_price from AdBids", AdBidsModelRepoPath)
+	result, err = s.Reconcile(ctx, catalog.ReconcileConfig{})
+	require.NoError(t, err)
+	testutils.AssertMigration(t, result, 0, 0, 2, 0, AdBidsDashboardAffectedPaths)
+	testutils.AssertTable(t, s, "AdBids_model", AdBidsModelRepoPath)
+	require.True(t, isView("AdBids_model"))
+
+	// materialize again and delete the table
+	testutils.CreateModel(t, s, "AdBids_model",
+		"-- @materialize: true\nselect id, timestamp, publisher, domain, bid_price from AdBids", AdBidsModelRepoPath)
+	result, err = s.Reconcile(ctx, catalog.ReconcileConfig{})
+	require.NoError(t, err)
+	require.Empty(t, result.Errors)
+	require.False(t, isView("AdBids_model"))
+	err = os.Remove(path.Join(dir, AdBidsModelRepoPath))
+	require.NoError(t, err)
+	result, err = s.Reconcile(ctx, catalog.ReconcileConfig{})
+	require.NoError(t, err)
+	testutils.AssertMigration(t, result, 1, 0, 0, 1, AdBidsDashboardAffectedPaths)
+	testutils.AssertTableAbsence(t, s, "AdBids_model")
+}
+
+func TestIncrementalModel(t *testing.T) {
+	ctx := context.Background()
+	s, _ := initBasicService(t)
+
+	exec := func(query string) {
+		require.NoError(t, s.Olap.Exec(ctx, &drivers.Statement{Query: query}))
+	}
+	count := func(query string) int {
+		rows, err := s.Olap.Execute(ctx, &drivers.Statement{Query: query})
+		require.NoError(t, err)
+		defer rows.Close()
+		var n int
+		require.True(t, rows.Next())
+		require.NoError(t, rows.Scan(&n))
+		return n
+	}
+	refreshSource := func() {
+		result, err := s.Reconcile(ctx, catalog.ReconcileConfig{
+			ChangedPaths: []string{AdBidsRepoPath},
+			ForcedPaths:  []string{AdBidsRepoPath},
+		})
+		require.NoError(t, err)
+		testutils.AssertMigration(t, result, 0, 0, 3, 0, AdBidsAffectedPaths)
+	}
+	total := count("SELECT count(*) FROM AdBids")
+
+	// the first build is a full build
+	testutils.CreateModel(t, s, "AdBids_model",
+		"-- @incremental: true\n-- @unique_key: id\nselect id, timestamp, publisher, domain, bid_price from AdBids", AdBidsModelRepoPath)
+	result, err := s.Reconcile(ctx, catalog.ReconcileConfig{})
+	require.NoError(t, err)
+	testutils.AssertMigration(t, result, 0, 0, 2, 0, AdBidsDashboardAffectedPaths)
+	entry := testutils.AssertInCatalogStore(t, s, "AdBids_model", AdBidsModelRepoPath)
+	require.True(t, entry.GetModel().Materialize)
+	require.Equal(t, []string{"id"}, entry.GetModel().UniqueKey)
+	require.Equal(t, total, count("SELECT count(*) FROM AdBids_model"))
+
+	// refreshing the source upserts rows by unique key and keeps other rows
+	exec("UPDATE AdBids_model SET publisher = 'stale' WHERE id = 1")
+	exec("INSERT INTO AdBids_model SELECT -1, timestamp, publisher, domain, bid_price FROM AdBids_model LIMIT 1")
+	refreshSource()
+	require.Equal(t, 0, count("SELECT count(*) FROM AdBids_model WHERE publisher = 'stale'"))
+	require.Equal(t, total+1, count("SELECT count(*) FROM AdBids_model"))
+
+	// forcing the model's path rebuilds it from scratch
+	result, err = s.Reconcile(ctx, catalog.ReconcileConfig{
+		ChangedPaths: []string{AdBidsModelRepoPath},
+		ForcedPaths:  []string{AdBidsModelRepoPath},
+	})
+	require.NoError(t, err)
+	testutils.AssertMigration(t, result, 0, 0, 2, 0, AdBidsDashboardAffectedPaths)
+	require.Equal(t, total, count("SELECT count(*) FROM AdBids_model"))
+
+	// changing the model's definition also rebuilds it
+	testutils.CreateModel(t, s, "AdBids_model",
+		"-- @incremental: true\n-- @incremental_predicate: id > (select max(id) from {{ this }})\n"+
+			"select id, timestamp, publisher, domain, bid_price from AdBids", AdBidsModelRepoPath)
+	result, err = s.Reconcile(ctx, catalog.ReconcileConfig{})
+	require.NoError(t, err)
+	testutils.AssertMigration(t, result, 0, 0, 2, 0, AdBidsDashboardAffectedPaths)
+	require.Equal(t, total, count("SELECT count(*) FROM AdBids_model"))
+
+	// only rows matching the predicate are inserted
+	exec("DELETE FROM AdBids_model WHERE id > 100")
+	exec("UPDATE AdBids_model SET publisher = 'stale' WHERE id = 1")
+	refreshSource()
+	require.Equal(t, total, count("SELECT count(*) FROM AdBids_model"))
+	require.Equal(t, 1, count("SELECT count(*) FROM AdBids_model WHERE publisher = 'stale'"))
+
+	// unique key columns are quoted
+	testutils.CreateModel(t, s, "AdBids_model",
+		"-- @incremental: true\n-- @unique_key: Ad ID, \"quoted\"\n"+
+			"select id AS \"Ad ID\", 1 AS \"\"\"quoted\"\"\", timestamp, publisher, domain, bid_price from AdBids", AdBidsModelRepoPath)
+	result, err = s.Reconcile(ctx, catalog.ReconcileConfig{})
+	require.NoError(t, err)
+	require.Empty(t, result.Errors)
+	exec(`UPDATE AdBids_model SET publisher = 'stale' WHERE "Ad ID" = 1`)
+	refreshSource()
+	require.Equal(t, total, count("SELECT count(*) FROM AdBids_model"))
+	require.Equal(t, 0, count("SELECT count(*) FROM AdBids_model WHERE publisher = 'stale'"))
+}
+
+func TestParallelReconcile(t *testing.T) {
+	ctx := context.Background()
+	s, _ := initBasicService(t)
+	s.Concurrency = 3
+
+	var paths []string
+	for i := 0; i < 5; i++ {
+		source := fmt.Sprintf("Source%d", i)
+		file := AdBidsCsvPath
+		if i == 0 {
+			file = filepath.Join(TestDataPath, "missing.csv")
+		}
+		testutils.CreateSource(t, s, source, file, fmt.Sprintf("/sources/%s.yaml", source))
+		testutils.CreateModel(t, s, source+"_model", "select id, publisher from "+source, fmt.Sprintf("/models/%s_model.sql", source))
+		paths = append(paths, fmt.Sprintf("/sources/%s.yaml", source), fmt.Sprintf("/models/%s_model.sql", source))
+	}
+
+	// the failed source only fails its own model
+	result, err := s.Reconcile(ctx, catalog.ReconcileConfig{})
+	require.NoError(t, err)
+	// the failed source is still reported as added, like in a sequential reconcile
+	testutils.AssertMigration(t, result, 2, 9, 0, 0, paths)
+	require.ElementsMatch(t, []string{paths[0], paths[1]}, []string{result.Errors[0].FilePath, result.Errors[1].FilePath})
+	testutils.AssertTableAbsence(t, s, "Source0")
+	testutils.AssertTableAbsence(t, s, "Source0_model")
+	for i := 1; i < 5; i++ {
+		testutils.AssertTable(t, s, fmt.Sprintf("Source%d", i), paths[2*i])
+		testutils.AssertTable(t, s, fmt.Sprintf("Source%d_model", i), paths[2*i+1])
+	}
+
+	// the models are refreshed after their sources
+	result, err = s.Reconcile(ctx, catalog.ReconcileConfig{
+		ChangedPaths: paths[2:],
+		ForcedPaths:  []string{paths[2], paths[4], paths[6], paths[8]},
+	})
+	require.NoError(t, err)
+	testutils.AssertMigration(t, result, 0, 0, 8, 0, paths[2:])
+	for i := 1; i < 5; i++ {
+		source := testutils.AssertInCatalogStore(t, s, fmt.Sprintf("Source%d", i), paths[2*i])
+		model := testutils.AssertInCatalogStore(t, s, fmt.Sprintf("Source%d_model", i), paths[2*i+1])
+		require.True(t, model.RefreshedOn.After(source.RefreshedOn))
+	}
+}
+
+func TestReconcileFailedDependency(t *testing.T) {
+	ctx := context.Background()
+	s, _ := initBasicService(t)
+	s.Concurrency = 3
+
+	failedPath := "/sources/Failed.yaml"
+	dependentPath := "/models/Failed_model.sql"
+	independentPath := "/models/Independent_model.sql"
+	testutils.CreateSource(t, s, "Failed", filepath.Join(TestDataPath, "missing.csv"), failedPath)
+	testutils.CreateModel(t, s, "Failed_model", "select id, publisher from Failed", dependentPath)
+	testutils.CreateModel(t, s, "Independent_model", "select id, publisher from AdBids", independentPath)
+
+	// the dependent model is skipped with a dependency error, while the independent model is created
+	result, err := s.Reconcile(ctx, catalog.ReconcileConfig{})
+	require.NoError(t, err)
+	require.Len(t, result.Errors, 2)
+	errs := make(map[string]*runtimev1.ReconcileError)
+	for _, e := range result.Errors {
+		errs[e.FilePath] = e
+	}
+	require.NotEqual(t, runtimev1.ReconcileError_CODE_DEPENDENCY, errs[failedPath].Code)
+	require.Equal(t, runtimev1.ReconcileError_CODE_DEPENDENCY, errs[dependentPath].Code)
+	require.Contains(t, errs[dependentPath].Message, "failed")
+	testutils.AssertTableAbsence(t, s, "Failed")
+	testutils.AssertTableAbsence(t, s, "Failed_model")
+	testutils.AssertTable(t, s, "Independent_model", independentPath)
+}
+
+func TestReconcileEvents(t *testing.T) {
+	ctx := context.Background()
+	s, _ := initBasicService(t)
+
+	var events []catalog.ReconcileEvent
+	conf := catalog.ReconcileConfig{
+		OnEvent: func(e catalog.ReconcileEvent) {
+			events = append(events, e)
+		},
+	}
+
+	// updating the model also updates the dashboard after it
+	testutils.CreateModel(t, s, "AdBids_model", "select * from AdBids", AdBidsModelRepoPath)
+	result, err := s.Reconcile(ctx, conf)
+	require.NoError(t, err)
+	testutils.AssertMigration(t, result, 0, 0, 2, 0, AdBidsDashboardAffectedPaths)
+	require.Len(t, events, 6)
+	types := make(map[string][]catalog.ReconcileEventType)
+	for _, e := range events {
+		types[e.Path] = append(types[e.Path], e.Type)
+	}
+	expected := []catalog.ReconcileEventType{catalog.ReconcileEventQueued, catalog.ReconcileEventStarted, catalog.ReconcileEventUpdated}
+	require.Equal(t, expected, types[AdBidsModelRepoPath])
+	require.Equal(t, expected, types[AdBidsDashboardRepoPath])
+	require.Equal(t, AdBidsModelRepoPath, events[3].Path)
+	require.Equal(t, "AdBids_model", events[3].Name)
+	require.Equal(t, AdBidsDashboardRepoPath, events[5].Path)
+
+	// a failing model reports its errors
+	events = nil
+	testutils.CreateModel(t, s, "AdBids_model", "select * from missing", AdBidsModelRepoPath)
+	result, err = s.Reconcile(ctx, conf)
+	require.NoError(t, err)
+	require.Len(t, result.Errors, 2)
+	require.Len(t, events, 6)
+	require.Equal(t, catalog.ReconcileEventErrored, events[3].Type)
+	require.Equal(t, AdBidsModelRepoPath, events[3].Path)
+	require.Equal(t, []*runtimev1.ReconcileError{result.Errors[0]}, events[3].Errors)
+	require.Equal(t, catalog.ReconcileEventErrored, events[5].Type)
+}
+
 func TestReconcileMetricsView(t *testing.T) {
 	s, _ := initBasicService(t)
 
@@ -475,7 +993,7 @@ measures:
 - expression: count(*)
 - expression: avg(bid_price)
   ignore: true
-`))
+`), drivers.PutOptions{Create: true})
 	require.NoError(t, err)
 	result, err = s.Reconcile(context.Background(), catalog.ReconcileConfig{})
 	require.NoError(t, err)
@@ -505,7 +1023,7 @@ measures:
   ignore: true
 - expression: avg(bid_price)
   ignore: true
-`))
+`), drivers.PutOptions{Create: true})
 	require.NoError(t, err)
 	result, err = s.Reconcile(context.Background(), catalog.ReconcileConfig{})
 	require.NoError(t, err)
@@ -530,7 +1048,7 @@ measures:
 - expression: count(*)
 - expression: avg(bid_price)
   ignore: true
-`))
+`), drivers.PutOptions{Create: true})
 	require.NoError(t, err)
 	result, err = s.Reconcile(context.Background(), catalog.ReconcileConfig{})
 	require.NoError(t, err)
@@ -544,7 +1062,7 @@ func TestInvalidFiles(t *testing.T) {
 
 	err := s.Repo.Put(ctx, s.InstID, AdBidsRepoPath, strings.NewReader(`type: local_file
 path:
- - data/source.csv`))
+ - data/source.csv`), drivers.PutOptions{Create: true})
 	require.NoError(t, err)
 	result, err := s.Reconcile(context.Background(), catalog.ReconcileConfig{})
 	require.NoError(t, err)
@@ -559,6 +1077,24 @@ path:
 	require.Equal(t, "invalid file name", result.Errors[0].Message)
 }
 
+func TestMalformedProjectConfig(t *testing.T) {
+	var AdBidsLimitedRepoPath = "/models/AdBids_limited.sql"
+	ctx := context.Background()
+	s, _ := initBasicService(t)
+	s.Variables = map[string]string{"limit": "10"}
+
+	// the parse error is reported and the artifacts are evaluated with the instance's variables
+	err := s.Repo.Put(ctx, s.InstID, "rill.yaml", strings.NewReader("vars: [limit"), drivers.PutOptions{Create: true})
+	require.NoError(t, err)
+	testutils.CreateModel(t, s, "AdBids_limited", "select * from AdBids limit {{ .vars.limit }}", AdBidsLimitedRepoPath)
+	result, err := s.Reconcile(ctx, catalog.ReconcileConfig{})
+	require.NoError(t, err)
+	require.Len(t, result.Errors, 1)
+	require.Equal(t, "/rill.yaml", result.Errors[0].FilePath)
+	require.Contains(t, result.Errors[0].Message, "failed to parse rill.yaml")
+	testutils.AssertTable(t, s, "AdBids_limited", AdBidsLimitedRepoPath)
+}
+
 func TestReconcileDryRun(t *testing.T) {
 	s, _ := initBasicService(t)
 
@@ -667,5 +1203,5 @@ func getService(t *testing.T) (*catalog.Service, string) {
 	repo, ok := fileStore.RepoStore()
 	require.True(t, ok)
 
-	return catalog.NewService(catalogObject, repo, olap, "test", nil), dir
+	return catalog.NewService(catalogObject, repo, olap, "test", nil, nil), dir
 }
@@ -39,6 +39,12 @@ type EntityMigrator interface {
 	ExistsInOlap(ctx context.Context, olap drivers.OLAPStore, catalog *drivers.CatalogEntry) (bool, error)
 }
 
+// Refresher is implemented by migrators that can refresh an object with the latest data of its dependencies
+// without rebuilding it from scratch.
+type Refresher interface {
+	Refresh(ctx context.Context, olap drivers.OLAPStore, repo drivers.RepoStore, catalog *drivers.CatalogEntry) error
+}
+
 func Create(ctx context.Context, olap drivers.OLAPStore, repo drivers.RepoStore, catalog *drivers.CatalogEntry) error {
 	migrator, ok := getMigrator(catalog)
 	if !ok {
@@ -57,6 +63,20 @@ func Update(ctx context.Context, olap drivers.OLAPStore, repo drivers.RepoStore,
 	return migrator.Update(ctx, olap, repo, catalog)
 }
 
+// Refresh updates an object whose definition didn't change.
+// It falls back to Update for migrators that don't implement Refresher.
+func Refresh(ctx context.Context, olap drivers.OLAPStore, repo drivers.RepoStore, catalog *drivers.CatalogEntry) error {
+	migrator, ok := getMigrator(catalog)
+	if !ok {
+		// no error here. not all migrators are needed
+		return nil
+	}
+	if refresher, ok := migrator.(Refresher); ok {
+		return refresher.Refresh(ctx, olap, repo, catalog)
+	}
+	return migrator.Update(ctx, olap, repo, catalog)
+}
+
 func Rename(ctx context.Context, olap drivers.OLAPStore, from string, catalog *drivers.CatalogEntry) error {
 	migrator, ok := getMigrator(catalog)
 	if !ok {
@@ -130,10 +150,13 @@ func SetSchema(ctx context.Context, olap drivers.OLAPStore, catalog *drivers.Cat
 	switch catalog.Type {
 	case drivers.ObjectTypeTable:
 		catalog.GetTable().Schema = table.Schema
+		catalog.GetTable().Stats = table.Stats
 	case drivers.ObjectTypeSource:
 		catalog.GetSource().Schema = table.Schema
+		catalog.GetSource().Stats = table.Stats
 	case drivers.ObjectTypeModel:
 		catalog.GetModel().Schema = table.Schema
+		catalog.GetModel().Stats = table.Stats
 	}
 
 	return nil
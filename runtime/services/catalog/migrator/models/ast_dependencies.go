@@ -0,0 +1,209 @@
+//go:build rillsql
+
+package models
+
+import (
+	"strings"
+
+	"github.com/rilldata/rill/runtime/sql"
+	"github.com/rilldata/rill/runtime/sql/ast"
+	"github.com/rilldata/rill/runtime/sql/rpc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// astDependencies returns the tables referenced in the FROM clauses of a query using the AST from the SQL native library.
+// It returns false if the query can't be parsed, for example because it uses DuckDB specific syntax,
+// or if the native library can't be loaded on this system.
+func astDependencies(query string) (deps []string, ok bool) {
+	if sql.Load() != nil {
+		return nil, false
+	}
+
+	defer func() {
+		// the sql package panics on unexpected responses from the native library
+		if r := recover(); r != nil {
+			deps, ok = nil, false
+		}
+	}()
+
+	node, err := sql.Parse(sanitizeQuery(query, false), rpc.Dialect_DUCKDB, nil)
+	if err != nil {
+		return nil, false
+	}
+
+	c := &tableCollector{seen: make(map[string]bool)}
+	c.query(node, nil)
+	return c.tables, true
+}
+
+// tableCollector walks an AST and collects the table references in order of appearance
+type tableCollector struct {
+	tables []string
+	seen   map[string]bool
+}
+
+// query collects the tables of a query node. ctes are the names of the CTEs in scope.
+func (c *tableCollector) query(node *ast.SqlNodeProto, ctes map[string]bool) {
+	if node == nil {
+		return
+	}
+
+	if id := node.GetSqlIdentifierProto(); id != nil {
+		c.identifier(id, ctes)
+		return
+	}
+
+	call := node.GetSqlCallProto()
+	if call == nil {
+		c.expression(node.ProtoReflect(), ctes)
+		return
+	}
+
+	switch {
+	case call.GetSqlSelectProto() != nil:
+		sel := call.GetSqlSelectProto()
+		c.from(sel.From, ctes)
+		c.expression(sel.ProtoReflect(), ctes)
+	case call.GetSqlWithProto() != nil:
+		with := call.GetSqlWithProto()
+		scope := make(map[string]bool, len(ctes))
+		for name := range ctes {
+			scope[name] = true
+		}
+		for _, item := range with.GetWithList().GetList() {
+			withItem := item.GetSqlCallProto().GetSqlWithItemProto()
+			if withItem == nil {
+				continue
+			}
+			// a CTE can reference the CTEs before it, and itself if it's recursive
+			scope[identifierName(withItem.Name)] = true
+			c.query(withItem.Query, scope)
+		}
+		c.query(with.Body, scope)
+	case call.GetSqlOrderByProto() != nil:
+		orderBy := call.GetSqlOrderByProto()
+		c.query(orderBy.Query, ctes)
+		c.expression(orderBy.ProtoReflect(), ctes)
+	case isSetOperation(call.GetSqlBasicCallProto()):
+		for _, operand := range call.GetSqlBasicCallProto().OperandList {
+			c.query(operand, ctes)
+		}
+	default:
+		c.expression(call.ProtoReflect(), ctes)
+	}
+}
+
+// from collects the tables of a node in a FROM clause
+func (c *tableCollector) from(node *ast.SqlNodeProto, ctes map[string]bool) {
+	if node == nil {
+		return
+	}
+
+	if id := node.GetSqlIdentifierProto(); id != nil {
+		c.identifier(id, ctes)
+		return
+	}
+
+	call := node.GetSqlCallProto()
+	switch {
+	case call.GetSqlJoinProto() != nil:
+		join := call.GetSqlJoinProto()
+		c.from(join.Left, ctes)
+		c.from(join.Right, ctes)
+		c.expression(join.Condition.ProtoReflect(), ctes)
+	case call.GetSqlTableRefProto() != nil:
+		c.identifier(call.GetSqlTableRefProto().TableName, ctes)
+	case operatorKind(call.GetSqlBasicCallProto()) == ast.SqlKindProto_SqlKindProto_AS_:
+		// the other operands are the alias and its column names
+		c.from(call.GetSqlBasicCallProto().OperandList[0], ctes)
+	default:
+		// subqueries and table functions (whose arguments can contain subqueries)
+		c.query(node, ctes)
+	}
+}
+
+// expression collects the tables of the subqueries in any node that isn't a query
+func (c *tableCollector) expression(msg protoreflect.Message, ctes map[string]bool) {
+	if !msg.IsValid() {
+		return
+	}
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Kind() != protoreflect.MessageKind {
+			return true
+		}
+		visit := func(m protoreflect.Message) {
+			node, ok := m.Interface().(*ast.SqlNodeProto)
+			if ok && isQuery(node) {
+				c.query(node, ctes)
+			} else if ok && node.GetSqlIdentifierProto() != nil {
+				// identifiers outside of FROM clauses are columns
+				return
+			} else {
+				c.expression(m, ctes)
+			}
+		}
+		if fd.IsList() {
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				visit(list.Get(i).Message())
+			}
+		} else if !fd.IsMap() {
+			visit(v.Message())
+		}
+		return true
+	})
+}
+
+func (c *tableCollector) identifier(id *ast.SqlIdentifierProto, ctes map[string]bool) {
+	name := identifierName(id)
+	if name == "" || ctes[name] || c.seen[name] {
+		return
+	}
+	c.seen[name] = true
+	c.tables = append(c.tables, name)
+}
+
+// identifierName returns the unqualified, lower case name of an identifier
+func identifierName(id *ast.SqlIdentifierProto) string {
+	names := id.GetNames()
+	if len(names) == 0 {
+		return ""
+	}
+	return strings.ToLower(names[len(names)-1])
+}
+
+func isQuery(node *ast.SqlNodeProto) bool {
+	call := node.GetSqlCallProto()
+	return call.GetSqlSelectProto() != nil || call.GetSqlWithProto() != nil || call.GetSqlOrderByProto() != nil ||
+		isSetOperation(call.GetSqlBasicCallProto())
+}
+
+func isSetOperation(call *ast.SqlBasicCallProto) bool {
+	switch operatorKind(call) {
+	case ast.SqlKindProto_SqlKindProto_UNION_, ast.SqlKindProto_SqlKindProto_EXCEPT_, ast.SqlKindProto_SqlKindProto_INTERSECT_:
+		return true
+	}
+	return false
+}
+
+// operatorKind returns the kind of a call's operator. The kind is set on the innermost message of the operator's oneof hierarchy.
+func operatorKind(call *ast.SqlBasicCallProto) ast.SqlKindProto {
+	if call == nil || call.Operator == nil {
+		return ast.SqlKindProto_SqlKindProto_OTHER_
+	}
+	kind := ast.SqlKindProto_SqlKindProto_OTHER_
+	var find func(m protoreflect.Message)
+	find = func(m protoreflect.Message) {
+		m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+			switch {
+			case fd.Kind() == protoreflect.EnumKind && fd.Name() == "kind":
+				kind = ast.SqlKindProto(v.Enum())
+			case fd.Kind() == protoreflect.MessageKind && fd.ContainingOneof() != nil:
+				find(v.Message())
+			}
+			return true
+		})
+	}
+	find(call.Operator.ProtoReflect())
+	return kind
+}
@@ -0,0 +1,8 @@
+//go:build !rillsql
+
+package models
+
+// astDependencies is only available in builds with the SQL native library (build tag rillsql).
+func astDependencies(query string) ([]string, bool) {
+	return nil, false
+}
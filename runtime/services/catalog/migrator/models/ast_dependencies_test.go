@@ -0,0 +1,45 @@
+//go:build rillsql
+
+package models
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Test_astDependencies covers queries that regexDependencies gets wrong. It needs the SQL native library.
+func Test_astDependencies(t *testing.T) {
+	depTests := []parseTest{
+		{
+			"select * from (select id from tbl1) t join tbl2 on t.id = tbl2.id",
+			[]string{"tbl1", "tbl2"},
+		},
+		{
+			`select * from "Quoted Table" where id in (select id from tbl2)`,
+			[]string{"quoted table", "tbl2"},
+		},
+		{
+			"select 'from tbl1' as note, extract(year from ts) as y from main.tbl2",
+			[]string{"tbl2"},
+		},
+		{
+			"with cte as (select * from tbl1) select * from cte union all select * from tbl2",
+			[]string{"tbl1", "tbl2"},
+		},
+	}
+
+	for i, tt := range depTests {
+		t.Run(fmt.Sprintf("Dependencies_%d", i), func(t *testing.T) {
+			deps, ok := astDependencies(tt.query)
+			require.True(t, ok)
+			require.ElementsMatch(t, tt.tables, deps)
+		})
+	}
+
+	// queries the parser doesn't support fall back to regular expressions
+	_, ok := astDependencies("select * from 'data/AdBids.csv'")
+	require.False(t, ok)
+	require.Empty(t, ExtractDependencies("select * from 'data/AdBids.csv'"))
+}
@@ -2,6 +2,7 @@ package models
 
 import (
 	"regexp"
+	"strings"
 )
 
 /**
@@ -10,6 +11,8 @@ import (
 
 var tableNameRegex = regexp.MustCompile(`(?im)(?:from|join)\s+([a-zA-z0-9_.]+|"[a-zA-z0-9\\.\\-_/:\s]+")`)
 
+var cteNameRegex = regexp.MustCompile(`(?i)(?:\bwith\s+(?:recursive\s+)?|,)\s*([a-zA-Z0-9_]+)\s+as\s*\(`)
+
 func ExtractTableNames(query string) []string {
 	subMatches := tableNameRegex.FindAllStringSubmatch(query, -1)
 	var tableNames []string
@@ -23,3 +26,31 @@ func ExtractTableNames(query string) []string {
 	}
 	return tableNames
 }
+
+// ExtractDependencies returns the table names referenced by a query, excluding the names of its CTEs.
+// The names are the tables, models and sources the query depends on.
+// It uses the AST of the query when the SQL native library is available (see astDependencies),
+// and falls back to matching the query with regular expressions.
+func ExtractDependencies(query string) []string {
+	if deps, ok := astDependencies(query); ok {
+		return deps
+	}
+	return regexDependencies(query)
+}
+
+// regexDependencies implements ExtractDependencies with regular expressions.
+// It can be fooled by table names in subqueries, strings and comments.
+func regexDependencies(query string) []string {
+	ctes := make(map[string]bool)
+	for _, subMatch := range cteNameRegex.FindAllStringSubmatch(query, -1) {
+		ctes[strings.ToLower(subMatch[1])] = true
+	}
+
+	var deps []string
+	for _, name := range ExtractTableNames(query) {
+		if !ctes[strings.ToLower(name)] {
+			deps = append(deps, name)
+		}
+	}
+	return deps
+}
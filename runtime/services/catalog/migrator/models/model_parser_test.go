@@ -220,3 +220,32 @@ func Test_extractJoins(t *testing.T) {
 		})
 	}
 }
+
+func Test_extractDependencies(t *testing.T) {
+	depTests := []parseTest{
+		{
+			"select * from tbl1 join tbl2 on tbl1.id = tbl2.id",
+			[]string{"tbl1", "tbl2"},
+		},
+		{`
+WITH cte1 AS (select * from tbl1),
+Cte2 as(select * from cte1)
+select * from CTE2 join tbl2 on tbl2.id = cte2.id
+`, []string{"tbl1", "tbl2"}},
+		{
+			"with recursive r as (select 1 as n union all select n + 1 from r where n < 10) select * from r",
+			nil,
+		},
+		// a CTE can shadow a model with the same name
+		{
+			"with foo as (select * from bar) select * from foo",
+			[]string{"bar"},
+		},
+	}
+
+	for i, tt := range depTests {
+		t.Run(fmt.Sprintf("Dependencies_%d", i), func(t *testing.T) {
+			require.ElementsMatch(t, ExtractDependencies(tt.query), tt.tables)
+		})
+	}
+}
@@ -19,25 +19,93 @@ func init() {
 type modelMigrator struct{}
 
 func (m *modelMigrator) Create(ctx context.Context, olap drivers.OLAPStore, repo drivers.RepoStore, catalogObj *drivers.CatalogEntry) error {
+	model := catalogObj.GetModel()
 	return olap.Exec(ctx, &drivers.Statement{
 		Query: fmt.Sprintf(
-			"CREATE OR REPLACE VIEW %s AS (%s)",
+			"CREATE OR REPLACE %s %s AS (%s)",
+			objectKind(model.Materialize),
 			catalogObj.Name,
-			sanitizeQuery(catalogObj.GetModel().Sql, false),
+			sanitizeQuery(modelSQL(model), false),
 		),
 		Priority: 100,
 	})
 }
 
 func (m *modelMigrator) Update(ctx context.Context, olap drivers.OLAPStore, repo drivers.RepoStore, catalogObj *drivers.CatalogEntry) error {
+	// a materialized model is rebuilt, which also refreshes it with the latest data of its dependencies
 	return m.Create(ctx, olap, repo, catalogObj)
 }
 
+// Refresh implements migrator.Refresher. Incremental models insert the rows matching their predicate,
+// replacing existing rows with the same unique key. Other models are rebuilt.
+func (m *modelMigrator) Refresh(ctx context.Context, olap drivers.OLAPStore, repo drivers.RepoStore, catalogObj *drivers.CatalogEntry) error {
+	model := catalogObj.GetModel()
+	if !model.Incremental {
+		return m.Update(ctx, olap, repo, catalogObj)
+	}
+
+	// the first build of an incremental model is a full build
+	exists, err := m.ExistsInOlap(ctx, olap, catalogObj)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return m.Create(ctx, olap, repo, catalogObj)
+	}
+
+	newRows := sanitizeQuery(modelSQL(model), false)
+	if model.IncrementalPredicate != "" {
+		predicate := thisRegex.ReplaceAllLiteralString(model.IncrementalPredicate, safeName(catalogObj.Name))
+		newRows = fmt.Sprintf("SELECT * FROM (%s) WHERE %s", newRows, predicate)
+	}
+
+	name := safeName(catalogObj.Name)
+	return olap.WithConnection(ctx, 100, func(ctx, ensuredCtx context.Context) error {
+		exec := func(ctx context.Context, query string) error {
+			return olap.Exec(ctx, &drivers.Statement{Query: query, Priority: 100})
+		}
+
+		if len(model.UniqueKey) == 0 {
+			return exec(ctx, fmt.Sprintf("INSERT INTO %s %s", name, newRows))
+		}
+
+		// DuckDB doesn't support MERGE, so stage the new rows and replace the existing rows with the same key
+		staging := safeName(fmt.Sprintf("__rill_incremental_%s", catalogObj.Name))
+		err := exec(ctx, fmt.Sprintf("CREATE OR REPLACE TEMPORARY TABLE %s AS (%s)", staging, newRows))
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = exec(ensuredCtx, fmt.Sprintf("DROP TABLE IF EXISTS %s", staging))
+		}()
+
+		conds := make([]string, len(model.UniqueKey))
+		for i, col := range model.UniqueKey {
+			conds[i] = fmt.Sprintf("%s.%s = %s.%s", name, safeName(col), staging, safeName(col))
+		}
+
+		err = exec(ctx, "BEGIN TRANSACTION")
+		if err != nil {
+			return err
+		}
+		err = exec(ctx, fmt.Sprintf("DELETE FROM %s USING %s WHERE %s", name, staging, strings.Join(conds, " AND ")))
+		if err == nil {
+			err = exec(ctx, fmt.Sprintf("INSERT INTO %s SELECT * FROM %s", name, staging))
+		}
+		if err != nil {
+			_ = exec(ensuredCtx, "ROLLBACK")
+			return err
+		}
+		return exec(ctx, "COMMIT")
+	})
+}
+
 func (m *modelMigrator) Rename(ctx context.Context, olap drivers.OLAPStore, from string, catalogObj *drivers.CatalogEntry) error {
+	kind := objectKind(catalogObj.GetModel().Materialize)
 	if strings.EqualFold(from, catalogObj.Name) {
 		tempName := fmt.Sprintf("__rill_temp_%s", from)
 		err := olap.Exec(ctx, &drivers.Statement{
-			Query:    fmt.Sprintf("ALTER VIEW %s RENAME TO %s", from, tempName),
+			Query:    fmt.Sprintf("ALTER %s %s RENAME TO %s", kind, from, tempName),
 			Priority: 100,
 		})
 		if err != nil {
@@ -47,25 +115,37 @@ func (m *modelMigrator) Rename(ctx context.Context, olap drivers.OLAPStore, from
 	}
 
 	return olap.Exec(ctx, &drivers.Statement{
-		Query:    fmt.Sprintf("ALTER VIEW %s RENAME TO %s", from, catalogObj.Name),
+		Query:    fmt.Sprintf("ALTER %s %s RENAME TO %s", kind, from, catalogObj.Name),
 		Priority: 100,
 	})
 }
 
 func (m *modelMigrator) Delete(ctx context.Context, olap drivers.OLAPStore, catalogObj *drivers.CatalogEntry) error {
-	return olap.Exec(ctx, &drivers.Statement{
-		Query:    fmt.Sprintf("DROP VIEW IF EXISTS %s", catalogObj.Name),
+	materialize := catalogObj.GetModel().Materialize
+	err := olap.Exec(ctx, &drivers.Statement{
+		Query:    fmt.Sprintf("DROP %s IF EXISTS %s", objectKind(materialize), catalogObj.Name),
 		Priority: 100,
 	})
+	if err != nil && materialize {
+		// the model might have failed to change from a view to a table
+		viewErr := olap.Exec(ctx, &drivers.Statement{
+			Query:    fmt.Sprintf("DROP VIEW IF EXISTS %s", catalogObj.Name),
+			Priority: 100,
+		})
+		if viewErr == nil {
+			return nil
+		}
+	}
+	return err
 }
 
 func (m *modelMigrator) GetDependencies(ctx context.Context, olap drivers.OLAPStore, catalog *drivers.CatalogEntry) []string {
-	return ExtractTableNames(catalog.GetModel().Sql)
+	return ExtractDependencies(catalog.GetModel().Sql)
 }
 
 func (m *modelMigrator) Validate(ctx context.Context, olap drivers.OLAPStore, catalog *drivers.CatalogEntry) []*runtimev1.ReconcileError {
 	err := olap.Exec(ctx, &drivers.Statement{
-		Query:    catalog.GetModel().Sql,
+		Query:    modelSQL(catalog.GetModel()),
 		Priority: 100,
 		DryRun:   true,
 	})
@@ -77,6 +157,10 @@ func (m *modelMigrator) Validate(ctx context.Context, olap drivers.OLAPStore, ca
 
 func (m *modelMigrator) IsEqual(ctx context.Context, cat1, cat2 *drivers.CatalogEntry) bool {
 	return cat1.GetModel().Dialect == cat2.GetModel().Dialect &&
+		cat1.GetModel().Materialize == cat2.GetModel().Materialize &&
+		cat1.GetModel().Incremental == cat2.GetModel().Incremental &&
+		strings.Join(cat1.GetModel().UniqueKey, ",") == strings.Join(cat2.GetModel().UniqueKey, ",") &&
+		cat1.GetModel().IncrementalPredicate == cat2.GetModel().IncrementalPredicate &&
 		// TODO: handle same queries but different text
 		sanitizeQuery(cat1.GetModel().Sql, true) == sanitizeQuery(cat2.GetModel().Sql, true)
 }
@@ -91,6 +175,30 @@ func (m *modelMigrator) ExistsInOlap(ctx context.Context, olap drivers.OLAPStore
 	return true, nil
 }
 
+// modelSQL returns the query that builds a model in the OLAP, which is transpiled for models in the Rill dialect
+func modelSQL(model *runtimev1.Model) string {
+	if model.Dialect == runtimev1.Model_DIALECT_RILL {
+		return model.TranspiledSql
+	}
+	return model.Sql
+}
+
+// objectKind returns the kind of OLAP object a model is built as
+func objectKind(materialize bool) string {
+	if materialize {
+		return "TABLE"
+	}
+	return "VIEW"
+}
+
+// safeName quotes an identifier for use in SQL
+func safeName(name string) string {
+	return fmt.Sprintf("\"%s\"", strings.ReplaceAll(name, "\"", "\"\""))
+}
+
+// thisRegex matches references to the model's own table in an incremental predicate
+var thisRegex = regexp.MustCompile(`\{\{\s*this\s*\}\}`)
+
 var (
 	QueryCommentRegex     = regexp.MustCompile(`(?m)--.*$`)
 	MultipleSpacesRegex   = regexp.MustCompile(`\s\s+`)
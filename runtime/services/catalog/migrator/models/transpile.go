@@ -0,0 +1,41 @@
+//go:build rillsql
+
+package models
+
+import (
+	"fmt"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/sql"
+	"github.com/rilldata/rill/runtime/sql/rpc"
+)
+
+// SupportsRillDialect returns true if models in the Rill dialect can be transpiled, which needs the SQL native library to load.
+func SupportsRillDialect() bool {
+	return sql.Load() == nil
+}
+
+// Transpile transpiles a query in Rill SQL to dialect. catalog is used to resolve the tables referenced by the query.
+// Errors in the query are returned as a *TranspileError.
+func Transpile(query string, dialect drivers.Dialect, catalog []*drivers.CatalogEntry) (string, error) {
+	err := sql.Load()
+	if err != nil {
+		return "", fmt.Errorf("models in the Rill dialect are not supported on this system: %w", err)
+	}
+
+	var target rpc.Dialect
+	switch dialect {
+	case drivers.DialectDuckDB:
+		target = rpc.Dialect_DUCKDB
+	case drivers.DialectDruid:
+		target = rpc.Dialect_DRUID
+	default:
+		return "", fmt.Errorf("cannot transpile to dialect %s", dialect)
+	}
+
+	res, err := sql.Transpile(query, target, catalog)
+	if err != nil {
+		return "", newTranspileError(err.Error())
+	}
+	return res, nil
+}
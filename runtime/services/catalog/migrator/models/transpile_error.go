@@ -0,0 +1,46 @@
+package models
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// TranspileError is an error in a model's SQL reported by the transpiler.
+// Lines and columns start at 1, and are 0 if the error doesn't have a location.
+type TranspileError struct {
+	Message   string
+	Line      uint32
+	Column    uint32
+	EndLine   uint32
+	EndColumn uint32
+}
+
+func (e *TranspileError) Error() string {
+	return e.Message
+}
+
+// locationRegex matches the locations in Calcite's error messages, like "From line 1, column 15 to line 1, column 17"
+var locationRegex = regexp.MustCompile(`(?i)line (\d+), column (\d+)`)
+
+// newTranspileError parses the location of an error from the transpiler's message.
+func newTranspileError(msg string) *TranspileError {
+	// only keep the first line of stack traces
+	msg = strings.TrimSpace(strings.SplitN(msg, "\n", 2)[0])
+	e := &TranspileError{Message: msg}
+
+	locs := locationRegex.FindAllStringSubmatch(msg, 2)
+	if len(locs) > 0 {
+		e.Line, e.Column = parseLocation(locs[0])
+	}
+	if len(locs) > 1 {
+		e.EndLine, e.EndColumn = parseLocation(locs[1])
+	}
+	return e
+}
+
+func parseLocation(match []string) (uint32, uint32) {
+	line, _ := strconv.ParseUint(match[1], 10, 32)
+	col, _ := strconv.ParseUint(match[2], 10, 32)
+	return uint32(line), uint32(col)
+}
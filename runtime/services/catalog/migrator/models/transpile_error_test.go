@@ -0,0 +1,19 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_newTranspileError(t *testing.T) {
+	err := newTranspileError("From line 2, column 15 to line 2, column 20: Object 'foo' not found\n\tat org.apache.calcite...")
+	require.Equal(t, "From line 2, column 15 to line 2, column 20: Object 'foo' not found", err.Message)
+	require.Equal(t, []uint32{2, 15, 2, 20}, []uint32{err.Line, err.Column, err.EndLine, err.EndColumn})
+
+	err = newTranspileError(`Encountered "from" at line 3, column 1.`)
+	require.Equal(t, []uint32{3, 1, 0, 0}, []uint32{err.Line, err.Column, err.EndLine, err.EndColumn})
+
+	err = newTranspileError("unexpected error")
+	require.Equal(t, []uint32{0, 0, 0, 0}, []uint32{err.Line, err.Column, err.EndLine, err.EndColumn})
+}
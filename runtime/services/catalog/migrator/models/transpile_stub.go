@@ -0,0 +1,19 @@
+//go:build !rillsql
+
+package models
+
+import (
+	"errors"
+
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+// SupportsRillDialect returns false, since models in the Rill dialect need the SQL native library (build tag rillsql).
+func SupportsRillDialect() bool {
+	return false
+}
+
+// Transpile is only available in builds with the SQL native library (build tag rillsql).
+func Transpile(query string, dialect drivers.Dialect, catalog []*drivers.CatalogEntry) (string, error) {
+	return "", errors.New("models in the Rill dialect are not supported by this build (it lacks the SQL native library)")
+}
@@ -31,6 +31,9 @@ func (m *sourceMigrator) Create(ctx context.Context, olap drivers.OLAPStore, rep
 		RepoDriver: repo.Driver(),
 		RepoDSN:    repo.DSN(),
 	}
+	if local, ok := repo.(drivers.RepoLocalStore); ok {
+		env.RepoRoot = local.Root()
+	}
 
 	return olap.Ingest(ctx, env, source)
 }
@@ -150,7 +150,9 @@ func AssertMigration(
 
 func RenameFile(t *testing.T, dir, from, to string) {
 	time.Sleep(time.Millisecond * 10)
-	err := os.Rename(path.Join(dir, from), path.Join(dir, to))
+	err := os.MkdirAll(path.Dir(path.Join(dir, to)), 0o777)
+	require.NoError(t, err)
+	err = os.Rename(path.Join(dir, from), path.Join(dir, to))
 	require.NoError(t, err)
 	err = os.Chtimes(path.Join(dir, to), time.Now(), time.Now())
 	require.NoError(t, err)
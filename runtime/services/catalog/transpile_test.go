@@ -0,0 +1,39 @@
+//go:build rillsql
+
+package catalog_test
+
+import (
+	"context"
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/services/catalog"
+	"github.com/rilldata/rill/runtime/services/catalog/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRillDialectModel needs the SQL native library.
+func TestRillDialectModel(t *testing.T) {
+	var RillModelRepoPath = "/models/rill_model.sql"
+	ctx := context.Background()
+	s, _ := initBasicService(t)
+
+	// the model is transpiled to DuckDB SQL
+	testutils.CreateModel(t, s, "rill_model", "-- @dialect: rill\nselect id, publisher from AdBids", RillModelRepoPath)
+	result, err := s.Reconcile(ctx, catalog.ReconcileConfig{})
+	require.NoError(t, err)
+	testutils.AssertMigration(t, result, 0, 1, 0, 0, []string{RillModelRepoPath})
+	testutils.AssertTable(t, s, "rill_model", RillModelRepoPath)
+	entry := testutils.AssertInCatalogStore(t, s, "rill_model", RillModelRepoPath)
+	require.Equal(t, runtimev1.Model_DIALECT_RILL, entry.GetModel().Dialect)
+	require.NotEmpty(t, entry.GetModel().TranspiledSql)
+
+	// errors are reported at their location in the file
+	testutils.CreateModel(t, s, "rill_model", "-- @dialect: rill\nselect id,\nfrom AdBids", RillModelRepoPath)
+	result, err = s.Reconcile(ctx, catalog.ReconcileConfig{})
+	require.NoError(t, err)
+	require.Len(t, result.Errors, 1)
+	require.Equal(t, runtimev1.ReconcileError_CODE_VALIDATION, result.Errors[0].Code)
+	require.Equal(t, uint32(3), result.Errors[0].StartLocation.GetLine())
+	testutils.AssertTableAbsence(t, s, "rill_model")
+}
@@ -188,25 +188,26 @@ func (i *Isolate) eventLoop() {
 // See getLibSQL
 var (
 	libsql     sharedlibrary.Library
+	libsqlErr  error
 	libsqlOnce sync.Once
 )
 
-// Returns a lazily-loaded reference to the SQL dynamic library
-func getLibSQL() sharedlibrary.Library {
-	// Lazily load libsql on first call
+// Load loads the SQL dynamic library if it isn't loaded yet. It returns an error if the library can't be loaded
+// (for example if the system can't load the embedded library), in which case the other functions in this package panic.
+// Callers that can do without the library should call Load first and fall back if it fails.
+func Load() error {
 	libsqlOnce.Do(func() {
 		// libraryFS and libraryPath are set in the platform-specific `deps_OS_ARCH` files in this package
-		lib, err := sharedlibrary.OpenEmbed(libraryFS, libraryPath)
-		if err != nil {
-			panic(err)
-		}
-		libsql = lib
+		libsql, libsqlErr = sharedlibrary.OpenEmbed(libraryFS, libraryPath)
 	})
+	return libsqlErr
+}
 
-	// If first call panic'ed, also panic on following calls (unlikely scenario)
-	if libsql == nil {
-		panic("libsql not loaded")
+// Returns a lazily-loaded reference to the SQL dynamic library
+func getLibSQL() sharedlibrary.Library {
+	err := Load()
+	if err != nil {
+		panic(fmt.Errorf("libsql not loaded: %w", err))
 	}
-
 	return libsql
 }
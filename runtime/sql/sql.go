@@ -29,7 +29,10 @@ func Transpile(sql string, dialect rpc.Dialect, catalog []*drivers.CatalogEntry)
 	}
 
 	if res.Error != nil {
-		return "", errors.New(res.Error.StackTrace)
+		if res.Error.Message == "" {
+			return "", errors.New(res.Error.StackTrace)
+		}
+		return "", errors.New(res.Error.Message)
 	}
 
 	tr := res.GetTranspileResponse()
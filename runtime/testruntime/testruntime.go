@@ -10,6 +10,7 @@ import (
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 
 	// Load database drivers for testing.
 	_ "github.com/rilldata/rill/runtime/drivers/duckdb"
@@ -35,7 +36,7 @@ func New(t TestingT) *runtime.Runtime {
 		MetastoreDSN:   fmt.Sprintf("file:%s?mode=memory&cache=shared", t.Name()),
 		QueryCacheSize: 10000,
 	}
-	rt, err := runtime.New(opts, nil)
+	rt, err := runtime.New(opts, zap.NewNop())
 	require.NoError(t, err)
 
 	return rt
@@ -67,7 +68,7 @@ func NewInstanceWithModel(t TestingT, name, sql string) (*runtime.Runtime, strin
 	rt, instanceID := NewInstance(t)
 
 	path := filepath.Join("models", name+".sql")
-	err := rt.PutFile(context.Background(), instanceID, path, strings.NewReader(sql), true, false)
+	err := rt.PutFile(context.Background(), instanceID, path, strings.NewReader(sql), drivers.PutOptions{Create: true})
 	require.NoError(t, err)
 
 	res, err := rt.Reconcile(context.Background(), instanceID, nil, nil, false, false)
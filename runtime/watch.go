@@ -0,0 +1,123 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/services/catalog"
+	"go.uber.org/zap"
+)
+
+// WatchRepo watches the instance's repo for changes to code artifacts and reconciles them as they happen.
+// It blocks until ctx is cancelled. Subscribe to the reconcile results with SubscribeReconcile.
+// The watched repo's connection is held (and so isn't closed by the connection cache's idle TTL) until the watch ends,
+// while the connections used to reconcile changes are released after every reconcile.
+func (r *Runtime) WatchRepo(ctx context.Context, instanceID string) error {
+	repo, release, err := r.Repo(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	watcher, ok := repo.(drivers.RepoWatcher)
+	if !ok {
+		return fmt.Errorf("repo driver '%s' does not support watching for changes", repo.Driver())
+	}
+
+	changes, err := watcher.Watch(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+
+	for paths := range changes {
+		// Skip changes to files that aren't code artifacts (such as data files).
+		// Changes to rill.yaml can affect every artifact (through variables), so they trigger a full reconcile.
+		changedPaths := make([]string, 0, len(paths))
+		fullReconcile := false
+		for _, p := range paths {
+			if catalog.IsArtifactPath(p) {
+				changedPaths = append(changedPaths, p)
+			} else if catalog.IsProjectConfigPath(p) {
+				fullReconcile = true
+			}
+		}
+		if fullReconcile {
+			changedPaths = nil
+		} else if len(changedPaths) == 0 {
+			continue
+		}
+
+		res, err := r.Reconcile(ctx, instanceID, changedPaths, nil, false, false)
+		if err != nil {
+			if ctx.Err() != nil {
+				break
+			}
+			r.logger.Error("failed to reconcile changed files", zap.String("instance_id", instanceID), zap.Strings("paths", changedPaths), zap.Error(err))
+			continue
+		}
+
+		for _, path := range res.AffectedPaths {
+			r.logger.Info(fmt.Sprintf("Reconciled: %s", path))
+		}
+		for _, merr := range res.Errors {
+			r.logger.Error(fmt.Sprintf("%s: %s", merr.FilePath, merr.Message))
+		}
+	}
+
+	return nil
+}
+
+// SubscribeReconcile returns a channel that receives the result of every reconcile that runs for the instance.
+// Slow subscribers may miss results. The returned function must be called to unsubscribe.
+func (r *Runtime) SubscribeReconcile(instanceID string) (<-chan *catalog.ReconcileResult, func()) {
+	return r.reconcileSubs.subscribe(instanceID)
+}
+
+// reconcileSubscribers fans out reconcile results to subscribers
+type reconcileSubscribers struct {
+	lock sync.Mutex
+	subs map[string]map[chan *catalog.ReconcileResult]bool
+}
+
+func newReconcileSubscribers() *reconcileSubscribers {
+	return &reconcileSubscribers{
+		subs: make(map[string]map[chan *catalog.ReconcileResult]bool),
+	}
+}
+
+func (s *reconcileSubscribers) subscribe(instanceID string) (chan *catalog.ReconcileResult, func()) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	ch := make(chan *catalog.ReconcileResult, 10)
+	if s.subs[instanceID] == nil {
+		s.subs[instanceID] = make(map[chan *catalog.ReconcileResult]bool)
+	}
+	s.subs[instanceID][ch] = true
+
+	unsubscribe := func() {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		delete(s.subs[instanceID], ch)
+		if len(s.subs[instanceID]) == 0 {
+			delete(s.subs, instanceID)
+		}
+	}
+
+	return ch, unsubscribe
+}
+
+func (s *reconcileSubscribers) publish(instanceID string, res *catalog.ReconcileResult) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for ch := range s.subs[instanceID] {
+		// Don't block on slow subscribers
+		select {
+		case ch <- res:
+		default:
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package runtime_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/services/catalog"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatchRepo(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rt, instanceID := testruntime.NewInstanceWithModel(t, "foo", "SELECT 1 AS a")
+
+	results, unsubscribe := rt.SubscribeReconcile(instanceID)
+	defer unsubscribe()
+
+	done := make(chan error)
+	go func() {
+		done <- rt.WatchRepo(ctx, instanceID)
+	}()
+
+	repo, release, err := rt.Repo(ctx, instanceID)
+	require.NoError(t, err)
+	defer release()
+
+	// Wait for the watcher to start, then edit files directly in the repo (bypassing reconcile).
+	// Changes to non-artifact files should not trigger a reconcile.
+	require.Eventually(t, func() bool {
+		err := repo.Put(ctx, instanceID, "/data/ignored.csv", strings.NewReader("a\n1"), drivers.PutOptions{Create: true})
+		require.NoError(t, err)
+		err = repo.Put(ctx, instanceID, "/models/bar.sql", strings.NewReader("SELECT a FROM foo"), drivers.PutOptions{Create: true})
+		require.NoError(t, err)
+
+		select {
+		case res := <-results:
+			require.Empty(t, res.Errors)
+			require.Equal(t, []string{"/models/bar.sql"}, res.AffectedPaths)
+			return true
+		case <-time.After(time.Second):
+			return false
+		}
+	}, 10*time.Second, 10*time.Millisecond)
+
+	entry, err := rt.GetCatalogEntry(ctx, instanceID, "bar")
+	require.NoError(t, err)
+	require.Equal(t, "/models/bar.sql", entry.Path)
+
+	// Moving a directory (including out of the repo) reconciles the files that were in it
+	time.Sleep(10 * time.Millisecond)
+	err = repo.Put(ctx, instanceID, "/models/finance/baz.sql", strings.NewReader("SELECT a FROM foo"), drivers.PutOptions{Create: true})
+	require.NoError(t, err)
+	awaitAffected(t, results, "/models/finance/baz.sql")
+
+	inst, err := rt.FindInstance(ctx, instanceID)
+	require.NoError(t, err)
+	time.Sleep(10 * time.Millisecond)
+	require.NoError(t, os.Rename(filepath.Join(inst.RepoDSN, "models/finance"), filepath.Join(inst.RepoDSN, "models/sales")))
+	awaitAffected(t, results, "/models/sales/baz.sql")
+	entry, err = rt.GetCatalogEntry(ctx, instanceID, "baz")
+	require.NoError(t, err)
+	require.Equal(t, "/models/sales/baz.sql", entry.Path)
+
+	time.Sleep(10 * time.Millisecond)
+	require.NoError(t, os.Rename(filepath.Join(inst.RepoDSN, "models/sales"), filepath.Join(t.TempDir(), "sales")))
+	awaitAffected(t, results, "/models/sales/baz.sql")
+	_, err = rt.GetCatalogEntry(ctx, instanceID, "baz")
+	require.Error(t, err)
+
+	cancel()
+	require.NoError(t, <-done)
+}
+
+// awaitAffected waits for reconciles that together affected the given paths
+func awaitAffected(t *testing.T, results <-chan *catalog.ReconcileResult, paths ...string) {
+	affected := make(map[string]bool)
+	timeout := time.After(10 * time.Second)
+	for !containsAll(affected, paths) {
+		select {
+		case res := <-results:
+			require.Empty(t, res.Errors)
+			for _, p := range res.AffectedPaths {
+				affected[p] = true
+			}
+		case <-timeout:
+			t.Fatalf("timed out waiting for reconcile of %v, got %v", paths, affected)
+		}
+	}
+}
+
+func containsAll(set map[string]bool, xs []string) bool {
+	for _, x := range xs {
+		if !set[x] {
+			return false
+		}
+	}
+	return true
+}
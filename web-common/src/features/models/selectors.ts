@@ -4,15 +4,16 @@ export function useModelNames(instanceId: string) {
   return useRuntimeServiceListFiles(
     instanceId,
     {
-      glob: "{sources,models,dashboards}/*.{yaml,sql}",
+      glob: "{sources,models,dashboards}/**/*.{sql,yaml,yml}",
     },
     {
       query: {
         // refetchInterval: 1000,
         select: (data) =>
           data.paths
-            ?.filter((path) => path.includes("models/"))
-            .map((path) => path.replace("/models/", "").replace(".sql", ""))
+            ?.filter((path) => path.startsWith("/models/"))
+            // models can be nested in folders, but their names are unique across folders
+            .map((path) => path.split("/").pop().replace(/\.sql$/, ""))
             // sort alphabetically case-insensitive
             .sort((a, b) =>
               a.localeCompare(b, undefined, { sensitivity: "base" })
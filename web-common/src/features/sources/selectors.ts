@@ -13,15 +13,16 @@ export function useSourceNames(instanceId: string) {
   return useRuntimeServiceListFiles(
     instanceId,
     {
-      glob: "{sources,models,dashboards}/*.{yaml,sql}",
+      glob: "{sources,models,dashboards}/**/*.{sql,yaml,yml}",
     },
     {
       query: {
         // refetchInterval: 1000,
         select: (data) =>
           data.paths
-            ?.filter((path) => path.includes("sources/"))
-            .map((path) => path.replace("/sources/", "").replace(".yaml", ""))
+            ?.filter((path) => path.startsWith("/sources/"))
+            // sources can be nested in folders, but their names are unique across folders
+            .map((path) => path.split("/").pop().replace(/\.ya?ml$/, ""))
             // sort alphabetically case-insensitive
             .sort((a, b) =>
               a.localeCompare(b, undefined, { sensitivity: "base" })
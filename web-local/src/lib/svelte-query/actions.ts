@@ -36,7 +36,7 @@ export function useAllNames(instanceId: string) {
   return useRuntimeServiceListFiles(
     instanceId,
     {
-      glob: "{sources,models,dashboards}/*.{yaml,sql}",
+      glob: "{sources,models,dashboards}/**/*.{sql,yaml,yml}",
     },
     {
       query: {
@@ -146,6 +146,8 @@ export const useCreateDashboardFromSource = <
       instanceId: data.instanceId,
       path: getFilePathFromNameAndType(data.newModelName, EntityType.Model),
       blob: `select * from ${data.sourceName}`,
+      create: true,
+      createOnly: true,
     });
 
     // second, create dashboard from model
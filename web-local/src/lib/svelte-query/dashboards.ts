@@ -9,17 +9,16 @@ export function useDashboardNames(repoId: string) {
   return useRuntimeServiceListFiles(
     repoId,
     {
-      glob: "{sources,models,dashboards}/*.{yaml,sql}",
+      glob: "{sources,models,dashboards}/**/*.{sql,yaml,yml}",
     },
     {
       query: {
         // refetchInterval: 1000,
         select: (data) =>
           data.paths
-            ?.filter((path) => path.includes("dashboards/"))
-            .map((path) =>
-              path.replace("/dashboards/", "").replace(".yaml", "")
-            )
+            ?.filter((path) => path.startsWith("/dashboards/"))
+            // dashboards can be nested in folders, but their names are unique across folders
+            .map((path) => path.split("/").pop().replace(/\.ya?ml$/, ""))
             // sort alphabetically case-insensitive
             .sort((a, b) =>
               a.localeCompare(b, undefined, { sensitivity: "base" })
@@ -10,7 +10,7 @@ export async function getArtifactErrors(
   instanceId: string
 ): Promise<V1ReconcileResponse> {
   const files = await runtimeServiceListFiles(instanceId, {
-    glob: "{sources,models,dashboards}/*.{yaml,sql}",
+    glob: "{sources,models,dashboards}/**/*.{sql,yaml,yml}",
   });
   const catalogs = await runtimeServiceListCatalogEntries(instanceId);
   const catalogsMap = getMapFromArray(